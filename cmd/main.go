@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/logic"
 	"github.com/nhtuan0700/GoLoad/internal/wiring"
 	"github.com/spf13/cobra"
 )
@@ -16,6 +18,7 @@ var (
 
 const (
 	flagConfigFilePath = "config-file-path"
+	flagAccountName    = "account-name"
 )
 
 func server() *cobra.Command {
@@ -42,12 +45,127 @@ func server() *cobra.Command {
 	return command
 }
 
+func apiServer() *cobra.Command {
+	command := &cobra.Command{
+		Use:  "api-server",
+		Long: "Start the gRPC + HTTP server of GoLoad, download tasks are executed by download-worker",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			app, cleanup, err := wiring.InitializeAPIServer(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			return app.Start()
+		},
+	}
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file.")
+	return command
+}
+
+func downloadWorker() *cobra.Command {
+	command := &cobra.Command{
+		Use:  "download-worker",
+		Long: "Start the message queue consumer of GoLoad that executes download tasks",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			app, cleanup, err := wiring.InitializeDownloadWorker(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			return app.Start()
+		},
+	}
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file.")
+	return command
+}
+
+func cron() *cobra.Command {
+	command := &cobra.Command{
+		Use:  "cron",
+		Long: "Start the cronjobs of GoLoad, only one instance of it should be running",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			app, cleanup, err := wiring.InitializeCron(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			return app.Start()
+		},
+	}
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file.")
+	return command
+}
+
+func bootstrapAdmin() *cobra.Command {
+	command := &cobra.Command{
+		Use:  "bootstrap-admin",
+		Long: "Give the admin role to an existing account of GoLoad, further admins can then be granted through the API",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			configFilePath, err := cmd.Flags().GetString(flagConfigFilePath)
+			if err != nil {
+				return err
+			}
+
+			accountName, err := cmd.Flags().GetString(flagAccountName)
+			if err != nil {
+				return err
+			}
+
+			adminLogic, cleanup, err := wiring.InitializeAdminLogic(configs.ConfigFilePath(configFilePath))
+			if err != nil {
+				return err
+			}
+
+			defer cleanup()
+
+			output, err := adminLogic.BootstrapAdmin(context.Background(), logic.BootstrapAdminParams{
+				AccountName: accountName,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("account %s (id %d) is now an admin\n", output.Account.GetAccountName(), output.Account.GetId())
+			return nil
+		},
+	}
+	command.Flags().String(flagConfigFilePath, "", "If provided, will use the provided config file.")
+	command.Flags().String(flagAccountName, "", "Name of the account to give the admin role to.")
+	_ = command.MarkFlagRequired(flagAccountName)
+	return command
+}
+
 func main() {
 	rootCommand := &cobra.Command{
 		Version: fmt.Sprintf("%s-%s", version, commitHash),
 	}
 	rootCommand.AddCommand(
 		server(),
+		apiServer(),
+		downloadWorker(),
+		cron(),
+		bootstrapAdmin(),
 	)
 
 	if err := rootCommand.Execute(); err != nil {
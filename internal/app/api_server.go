@@ -0,0 +1,44 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/handler/grpc"
+	"github.com/nhtuan0700/GoLoad/internal/handler/http"
+	"go.uber.org/zap"
+)
+
+// APIServer only serves the gRPC and HTTP APIs, download tasks it creates are executed by download workers
+type APIServer struct {
+	grpcServer grpc.Server
+	httpServer http.Server
+	logger     *zap.Logger
+}
+
+func NewAPIServer(
+	grpcServer grpc.Server,
+	httpServer http.Server,
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (*APIServer, error) {
+	// Download tasks would be produced to a queue no download worker can consume
+	if mqConfig.Type == configs.MQTypeInMemory {
+		return nil, fmt.Errorf("mq type %s is only supported by standalone-server", mqConfig.Type)
+	}
+
+	return &APIServer{
+		grpcServer: grpcServer,
+		httpServer: httpServer,
+		logger:     logger,
+	}, nil
+}
+
+func (s APIServer) Start() error {
+	lifecycle := newLifecycle(s.logger, syscall.SIGINT, syscall.SIGTERM)
+	lifecycle.add("grpc server", s.grpcServer.Start)
+	lifecycle.add("http server", s.httpServer.Start)
+	return lifecycle.run(context.Background())
+}
@@ -0,0 +1,31 @@
+package app
+
+import (
+	"context"
+	"syscall"
+
+	"github.com/nhtuan0700/GoLoad/internal/handler/jobs"
+	"go.uber.org/zap"
+)
+
+// Cron runs the periodic jobs, only one replica of it should be deployed
+type Cron struct {
+	rootJob jobs.Root
+	logger  *zap.Logger
+}
+
+func NewCron(
+	rootJob jobs.Root,
+	logger *zap.Logger,
+) *Cron {
+	return &Cron{
+		rootJob: rootJob,
+		logger:  logger,
+	}
+}
+
+func (c Cron) Start() error {
+	lifecycle := newLifecycle(c.logger, syscall.SIGINT, syscall.SIGTERM)
+	lifecycle.add("job scheduler", c.rootJob.Start)
+	return lifecycle.run(context.Background())
+}
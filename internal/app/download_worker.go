@@ -0,0 +1,40 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/handler/consumers"
+	"go.uber.org/zap"
+)
+
+// DownloadWorker consumes the message queue and executes download tasks, it can be scaled independently of the
+// api server
+type DownloadWorker struct {
+	rootConsumer consumers.Root
+	logger       *zap.Logger
+}
+
+func NewDownloadWorker(
+	rootConsumer consumers.Root,
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (*DownloadWorker, error) {
+	// Download tasks are produced by api servers running in other processes, which the queue does not reach
+	if mqConfig.Type == configs.MQTypeInMemory {
+		return nil, fmt.Errorf("mq type %s is only supported by standalone-server", mqConfig.Type)
+	}
+
+	return &DownloadWorker{
+		rootConsumer: rootConsumer,
+		logger:       logger,
+	}, nil
+}
+
+func (w DownloadWorker) Start() error {
+	lifecycle := newLifecycle(w.logger, syscall.SIGINT, syscall.SIGTERM)
+	lifecycle.add("message queue consumer", w.rootConsumer.Start)
+	return lifecycle.run(context.Background())
+}
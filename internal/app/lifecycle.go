@@ -0,0 +1,77 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+
+	"go.uber.org/zap"
+)
+
+type component struct {
+	name  string
+	start func(ctx context.Context) error
+}
+
+// lifecycle runs components until the process receives one of the stop signals or any component fails, then
+// cancels the root context so every other component stops accepting new work and drains what is in flight
+type lifecycle struct {
+	components []component
+	signals    []os.Signal
+	logger     *zap.Logger
+}
+
+func newLifecycle(logger *zap.Logger, signals ...os.Signal) *lifecycle {
+	return &lifecycle{
+		signals: signals,
+		logger:  logger,
+	}
+}
+
+func (l *lifecycle) add(name string, start func(ctx context.Context) error) {
+	l.components = append(l.components, component{name: name, start: start})
+}
+
+// run blocks until all components have stopped, it returns the first error a component failed with
+func (l *lifecycle) run(ctx context.Context) error {
+	ctx, stopNotify := signal.NotifyContext(ctx, l.signals...)
+	defer stopNotify()
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	waitGroup := sync.WaitGroup{}
+	for _, c := range l.components {
+		waitGroup.Add(1)
+		go func(c component) {
+			defer waitGroup.Done()
+			logger := l.logger.With(zap.String("component", c.name))
+
+			err := c.start(ctx)
+			if err == nil && ctx.Err() == nil {
+				err = errors.New("stopped unexpectedly")
+			}
+
+			if err != nil {
+				logger.With(zap.Error(err)).Error("component failed, stopping all components")
+				cancel(fmt.Errorf("%s: %w", c.name, err))
+				return
+			}
+
+			logger.Info("component stopped")
+		}(c)
+	}
+
+	<-ctx.Done()
+	l.logger.Info("stopping all components")
+	waitGroup.Wait()
+
+	if err := context.Cause(ctx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	return nil
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/nhtuan0700/GoLoad/internal/handler/consumers"
 	"github.com/nhtuan0700/GoLoad/internal/handler/grpc"
 	"github.com/nhtuan0700/GoLoad/internal/handler/http"
-	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/nhtuan0700/GoLoad/internal/handler/jobs"
 	"go.uber.org/zap"
 )
 
@@ -15,6 +15,7 @@ type Server struct {
 	grpcServer   grpc.Server
 	httpServer   http.Server
 	rootConsumer consumers.Root
+	rootJob      jobs.Root
 	logger       *zap.Logger
 }
 
@@ -22,32 +23,23 @@ func NewServer(
 	grpcServer grpc.Server,
 	httpServer http.Server,
 	rootConsumer consumers.Root,
+	rootJob jobs.Root,
 	logger *zap.Logger,
 ) *Server {
 	return &Server{
 		grpcServer:   grpcServer,
 		httpServer:   httpServer,
 		rootConsumer: rootConsumer,
+		rootJob:      rootJob,
 		logger:       logger,
 	}
 }
 
 func (s Server) Start() error {
-	go func() {
-		err := s.grpcServer.Start(context.Background())
-		s.logger.With(zap.Error(err)).Info("grpc server stopped")
-	}()
-
-	go func() {
-		err := s.httpServer.Start(context.Background())
-		s.logger.With(zap.Error(err)).Info("http server stopped")
-	}()
-
-	go func() {
-		err := s.rootConsumer.Start(context.Background())
-		s.logger.With(zap.Error(err)).Info("message queue consumer stopped")
-	}()
-
-	utils.BlockUntilSignal(syscall.SIGINT, syscall.SIGTERM)
-	return nil
+	lifecycle := newLifecycle(s.logger, syscall.SIGINT, syscall.SIGTERM)
+	lifecycle.add("grpc server", s.grpcServer.Start)
+	lifecycle.add("http server", s.httpServer.Start)
+	lifecycle.add("message queue consumer", s.rootConsumer.Start)
+	lifecycle.add("job scheduler", s.rootJob.Start)
+	return lifecycle.run(context.Background())
 }
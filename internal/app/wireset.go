@@ -4,4 +4,7 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewServer,
+	NewAPIServer,
+	NewDownloadWorker,
+	NewCron,
 )
@@ -1,14 +1,89 @@
 package configs
 
-import "time"
+import (
+	"encoding/base64"
+	"time"
+)
 
-type Hash struct {
+type HashAlgorithm string
+
+const (
+	HashAlgorithmBcrypt   HashAlgorithm = "bcrypt"
+	HashAlgorithmArgon2id HashAlgorithm = "argon2id"
+	HashAlgorithmScrypt   HashAlgorithm = "scrypt"
+)
+
+type BcryptHash struct {
 	Cost int `yaml:"cost"`
 }
 
+type Argon2idHash struct {
+	MemoryKiB   uint32 `yaml:"memory_kib"`
+	Iterations  uint32 `yaml:"iterations"`
+	Parallelism uint8  `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   uint32 `yaml:"key_length"`
+}
+
+type ScryptHash struct {
+	// CostLog2 is the base 2 logarithm of the cpu and memory cost parameter N
+	CostLog2    uint8  `yaml:"cost_log2"`
+	BlockSize   int    `yaml:"block_size"`
+	Parallelism int    `yaml:"parallelism"`
+	SaltLength  uint32 `yaml:"salt_length"`
+	KeyLength   int    `yaml:"key_length"`
+}
+
+// Hash selects how new passwords are hashed, existing hashes of every algorithm are still verified and are upgraded
+// to the configured algorithm and parameters when their account signs in
+type Hash struct {
+	Algorithm HashAlgorithm `yaml:"algorithm"`
+	Bcrypt    BcryptHash    `yaml:"bcrypt"`
+	Argon2id  Argon2idHash  `yaml:"argon2id"`
+	Scrypt    ScryptHash    `yaml:"scrypt"`
+}
+
+type PasswordPolicy struct {
+	// MinLength and MaxLength are counted in characters
+	MinLength int `yaml:"min_length"`
+	MaxLength int `yaml:"max_length"`
+	// BreachListFile is a local file of known breached passwords rejected for new passwords, one per line, it is
+	// loaded into memory at startup. Empty to not check passwords against a breach list
+	BreachListFile string `yaml:"breach_list_file"`
+	// BreachListFormat is plain for passwords in clear text, or sha1 for upper or lower case hex encoded sha1 hashes
+	// optionally followed by a colon and a count, like the lists of haveibeenpwned.com
+	BreachListFormat string `yaml:"breach_list_format"`
+}
+
+type TokenSigningKey struct {
+	// EncryptionKey is a base64 encoded AES-256 key used to encrypt the private keys and the totp secrets stored in
+	// the database, totp cannot be enrolled without it
+	EncryptionKey string `yaml:"encryption_key"`
+	// RotationInterval is how long a generated key is used to sign tokens before a new one is generated
+	RotationInterval string `yaml:"rotation_interval"`
+	// Overlap is how long a rotated key is still accepted after the last token it signed expired
+	Overlap string `yaml:"overlap"`
+	// PrivateKeyFileList disables generation and rotation when not empty, the first key signs tokens
+	// and the following ones are only accepted to verify tokens
+	PrivateKeyFileList []string `yaml:"private_key_file_list"`
+}
+
+func (t TokenSigningKey) GetEncryptionKeyBytes() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(t.EncryptionKey)
+}
+
+func (t TokenSigningKey) GetRotationIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(t.RotationInterval)
+}
+
+func (t TokenSigningKey) GetOverlapDuration() (time.Duration, error) {
+	return time.ParseDuration(t.Overlap)
+}
+
 type Token struct {
-	ExpiresIn                   string `yaml:"expires_in"`
-	RegenerateTokenBeforeExpiry string `yaml:"regenerate_token_before_expiry"`
+	ExpiresIn                   string          `yaml:"expires_in"`
+	RegenerateTokenBeforeExpiry string          `yaml:"regenerate_token_before_expiry"`
+	SigningKey                  TokenSigningKey `yaml:"signing_key"`
 }
 
 func (t Token) GetExpiresInDuration() (time.Duration, error) {
@@ -19,7 +94,103 @@ func (t Token) GetRegenerateTokenBeforeExpiry() (time.Duration, error) {
 	return time.ParseDuration(t.RegenerateTokenBeforeExpiry)
 }
 
+type RefreshToken struct {
+	// ExpiresIn is the lifetime of a session since it was created or last refreshed
+	ExpiresIn string `yaml:"expires_in"`
+	// ReuseGracePeriod is how long the previous refresh token of a session keeps working after it was rotated, so
+	// requests refreshing the session at the same time with the same refresh token do not log the user out
+	ReuseGracePeriod string `yaml:"reuse_grace_period"`
+}
+
+func (r RefreshToken) GetExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(r.ExpiresIn)
+}
+
+// GetReuseGracePeriodDuration returns 0 when no grace period is configured, the previous refresh token is then
+// rejected right away
+func (r RefreshToken) GetReuseGracePeriodDuration() (time.Duration, error) {
+	if r.ReuseGracePeriod == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(r.ReuseGracePeriod)
+}
+
+type PasswordReset struct {
+	// ExpiresIn is how long a password reset token can be used after it was requested
+	ExpiresIn string `yaml:"expires_in"`
+	// URL is the page sent in the notification, the reset token is added to it as the token query parameter
+	URL string `yaml:"url"`
+}
+
+func (p PasswordReset) GetExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(p.ExpiresIn)
+}
+
+type LoginLockout struct {
+	// MaxFailedAttemptsPerAccountName and MaxFailedAttemptsPerIP are the failed logins allowed in the window
+	// before a lockout, every further failure doubles the lockout duration
+	MaxFailedAttemptsPerAccountName uint64 `yaml:"max_failed_attempts_per_account_name"`
+	MaxFailedAttemptsPerIP          uint64 `yaml:"max_failed_attempts_per_ip"`
+	// Window is how long failed logins are counted since the first one, it should be longer than MaxLockout
+	// so the lockout keeps growing for a persistent attacker
+	Window      string `yaml:"window"`
+	BaseLockout string `yaml:"base_lockout"`
+	MaxLockout  string `yaml:"max_lockout"`
+}
+
+func (l LoginLockout) GetWindowDuration() (time.Duration, error) {
+	return time.ParseDuration(l.Window)
+}
+
+func (l LoginLockout) GetBaseLockoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.BaseLockout)
+}
+
+func (l LoginLockout) GetMaxLockoutDuration() (time.Duration, error) {
+	return time.ParseDuration(l.MaxLockout)
+}
+
+type TOTP struct {
+	// Issuer is shown next to the account name in authenticator apps
+	Issuer string `yaml:"issuer"`
+	// ChallengeExpiresIn is how long the code of an account with totp can be entered after its password was checked
+	ChallengeExpiresIn string `yaml:"challenge_expires_in"`
+}
+
+func (t TOTP) GetChallengeExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(t.ChallengeExpiresIn)
+}
+
+type OIDC struct {
+	// Enabled turns on single sign-on through an openid connect identity provider
+	Enabled      bool   `yaml:"enabled"`
+	IssuerURL    string `yaml:"issuer_url"`
+	ClientID     string `yaml:"client_id"`
+	ClientSecret string `yaml:"client_secret"`
+	// RedirectURL is the callback of the http server, it must be registered at the identity provider
+	RedirectURL string `yaml:"redirect_url"`
+	// ScopeList is requested in addition to the openid scope
+	ScopeList []string `yaml:"scope_list"`
+	// LoginExpiresIn is how long a login can take at the identity provider before its state is rejected
+	LoginExpiresIn string `yaml:"login_expires_in"`
+	// AutoProvision creates an account for a subject signing in for the first time
+	AutoProvision bool `yaml:"auto_provision"`
+	// PostLoginRedirectURL is where the http server sends browsers once their session is started
+	PostLoginRedirectURL string `yaml:"post_login_redirect_url"`
+}
+
+func (o OIDC) GetLoginExpiresInDuration() (time.Duration, error) {
+	return time.ParseDuration(o.LoginExpiresIn)
+}
+
 type Auth struct {
-	Hash  Hash  `yaml:"hash"`
-	Token Token `yaml:"token"`
+	Hash           Hash           `yaml:"hash"`
+	PasswordPolicy PasswordPolicy `yaml:"password_policy"`
+	Token          Token          `yaml:"token"`
+	RefreshToken   RefreshToken   `yaml:"refresh_token"`
+	PasswordReset  PasswordReset  `yaml:"password_reset"`
+	LoginLockout   LoginLockout   `yaml:"login_lockout"`
+	TOTP           TOTP           `yaml:"totp"`
+	OIDC           OIDC           `yaml:"oidc"`
 }
@@ -19,6 +19,8 @@ type Config struct {
 	HTTP     HTTP     `yaml:"http"`
 	MQ       MQ       `yaml:"mq"`
 	Download Download `yaml:"download"`
+	Cron     Cron     `yaml:"cron"`
+	Notifier Notifier `yaml:"notifier"`
 }
 
 func NewConfig(filepath ConfigFilePath) (Config, error) {
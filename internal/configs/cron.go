@@ -0,0 +1,16 @@
+package configs
+
+import "time"
+
+type CronJob struct {
+	Interval string `yaml:"interval"`
+}
+
+func (c CronJob) GetIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(c.Interval)
+}
+
+type Cron struct {
+	ExpireDownloadTasks   CronJob `yaml:"expire_download_tasks"`
+	RotateTokenSigningKey CronJob `yaml:"rotate_token_signing_key"`
+}
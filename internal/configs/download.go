@@ -1,5 +1,11 @@
 package configs
 
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
 type DownloadMode string
 
 const (
@@ -7,11 +13,33 @@ const (
 	DownloadModelS3   DownloadMode = "s3"
 )
 
+type ArchiveExtraction struct {
+	MaxEntryCount   int    `yaml:"max_entry_count"`
+	MaxExpandedSize string `yaml:"max_expanded_size"`
+}
+
+func (a ArchiveExtraction) GetMaxExpandedSizeInBytes() (uint64, error) {
+	return humanize.ParseBytes(a.MaxExpandedSize)
+}
+
 type Download struct {
-	Mode              DownloadMode `yaml:"mode"`
-	Bucket            string       `yaml:"bucket"`
-	Address           string       `yaml:"address"`
-	Username          string       `yaml:"username"`
-	Password          string       `yaml:"password"`
-	DownloadDirectory string       `yaml:"download_directory"`
+	Mode              DownloadMode      `yaml:"mode"`
+	Bucket            string            `yaml:"bucket"`
+	Address           string            `yaml:"address"`
+	Username          string            `yaml:"username"`
+	Password          string            `yaml:"password"`
+	DownloadDirectory string            `yaml:"download_directory"`
+	DefaultRetention  string            `yaml:"default_retention"`
+	ArchiveExtraction ArchiveExtraction `yaml:"archive_extraction"`
+	PostProcessing    PostProcessing    `yaml:"post_processing"`
+}
+
+// GetDefaultRetentionDuration returns 0 when no default retention is configured,
+// meaning downloaded files never expire unless the account or the task says otherwise
+func (d Download) GetDefaultRetentionDuration() (time.Duration, error) {
+	if d.DefaultRetention == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(d.DefaultRetention)
 }
@@ -1,6 +1,10 @@
 package configs
 
-import "github.com/dustin/go-humanize"
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
 
 type GetDownloadTaskFile struct {
 	ResponseBufferSize string `yaml:"response_buffer_size"`
@@ -10,7 +14,28 @@ func (g GetDownloadTaskFile) GetResponseBufferSizeInBytes() (uint64, error) {
 	return humanize.ParseBytes(g.ResponseBufferSize)
 }
 
+type RateLimitRule struct {
+	// RequestsPerSecond is the rate the bucket refills at, it may be below 1 for expensive methods
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             uint64  `yaml:"burst"`
+}
+
+type RateLimit struct {
+	Enabled bool          `yaml:"enabled"`
+	Default RateLimitRule `yaml:"default"`
+	// MethodList overrides the default rule, it is keyed by method name such as CreateSession
+	MethodList map[string]RateLimitRule `yaml:"method_list"`
+}
+
 type GRPC struct {
-	Address             string              `yaml:"address"`
+	Address string `yaml:"address"`
+	// ShutdownTimeout is how long in-flight calls are waited for when the server stops, streams still open after that
+	// are closed
+	ShutdownTimeout     string              `yaml:"shutdown_timeout"`
 	GetDownloadTaskFile GetDownloadTaskFile `yaml:"get_download_task_file"`
+	RateLimit           RateLimit           `yaml:"rate_limit"`
+}
+
+func (g GRPC) GetShutdownTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(g.ShutdownTimeout)
 }
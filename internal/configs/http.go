@@ -1,5 +1,14 @@
 package configs
 
+import "time"
+
 type HTTP struct {
 	Address string `yaml:"address"`
+	// ShutdownTimeout is how long in-flight requests are waited for when the server stops, connections still open
+	// after that are closed
+	ShutdownTimeout string `yaml:"shutdown_timeout"`
+}
+
+func (h HTTP) GetShutdownTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(h.ShutdownTimeout)
 }
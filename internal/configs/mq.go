@@ -1,6 +1,106 @@
 package configs
 
+import "time"
+
+type MQType string
+
+const (
+	MQTypeKafka MQType = "kafka"
+	// MQTypeInMemory only delivers messages inside of the process producing them, messages are lost when it stops.
+	// It only works with standalone-server, where the producing api and the consuming worker share a process
+	MQTypeInMemory MQType = "in_memory"
+	// MQTypeDatabase stores messages in a table of the database, so no message queue server is needed
+	MQTypeDatabase MQType = "database"
+	// MQTypeNATS uses streams and durable consumers of NATS JetStream
+	MQTypeNATS     MQType = "nats"
+	MQTypeRabbitMQ MQType = "rabbitmq"
+)
+
+type MQKafka struct {
+	// WorkerCount is how many messages of each partition are handled concurrently, offsets are only committed up to
+	// the lowest message still being handled. With more than one worker messages of a partition are not handled in
+	// order anymore
+	WorkerCount int `yaml:"worker_count"`
+}
+
+type MQInMemory struct {
+	// BufferSize is how many messages of a queue are held before producing blocks
+	BufferSize int `yaml:"buffer_size"`
+}
+
+type MQDatabase struct {
+	// PollInterval is how long consumers wait before looking for messages again once a queue is empty
+	PollInterval string `yaml:"poll_interval"`
+	// VisibilityTimeout is how long a message is hidden from other consumers while it is handled, it is delivered
+	// again if it is neither acked nor nacked by then, for example because the consumer crashed
+	VisibilityTimeout string `yaml:"visibility_timeout"`
+}
+
+func (m MQDatabase) GetPollIntervalDuration() (time.Duration, error) {
+	return time.ParseDuration(m.PollInterval)
+}
+
+func (m MQDatabase) GetVisibilityTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(m.VisibilityTimeout)
+}
+
+type MQNATS struct {
+	Addresses []string `yaml:"addresses"`
+	// StreamName is the JetStream stream holding the messages of every queue, queue names are used as subjects
+	// under it, for example GOLOAD.download_task_created
+	StreamName string `yaml:"stream_name"`
+	// AckWait is how long the server waits for a consumer to ack or nack a message before delivering it again
+	AckWait string `yaml:"ack_wait"`
+}
+
+func (m MQNATS) GetAckWaitDuration() (time.Duration, error) {
+	return time.ParseDuration(m.AckWait)
+}
+
+type MQRabbitMQ struct {
+	Address string `yaml:"address"`
+	// PrefetchCount is how many unacked messages of a queue the server sends to a consumer at once
+	PrefetchCount int `yaml:"prefetch_count"`
+}
+
 type MQ struct {
+	Type MQType `yaml:"type"`
+	// Addresses is only used by kafka, ClientID also names the durable consumers of nats
 	Addresses []string `yaml:"addresses"`
 	ClientID  string   `yaml:"client_id"`
+	// MaxDeliveryCount is how many times a message is delivered before it is moved to the dead letter queue when its
+	// handler keeps failing, RetryDelay is how long a failed message waits before it is delivered again
+	MaxDeliveryCount int    `yaml:"max_delivery_count"`
+	RetryDelay       string `yaml:"retry_delay"`
+	// DrainTimeout is how long messages being handled are waited for when the consumer stops, or when the kafka
+	// consumer group rebalances, their handlers are canceled after that
+	DrainTimeout string `yaml:"drain_timeout"`
+	// PublishLifecycleEvents produces an event to its own queue for every status transition of download tasks, it
+	// cannot be enabled with the in_memory and database types, whose queues need a subscriber not to pile up
+	PublishLifecycleEvents bool `yaml:"publish_lifecycle_events"`
+	// ProducedSchemaVersion is the schema version events are produced with, 1 encodes them as json and 2 as
+	// protobuf. Consumers decode both, so it should only be increased once every consumer is deployed
+	ProducedSchemaVersion int        `yaml:"produced_schema_version"`
+	Kafka                 MQKafka    `yaml:"kafka"`
+	InMemory              MQInMemory `yaml:"in_memory"`
+	Database              MQDatabase `yaml:"database"`
+	NATS                  MQNATS     `yaml:"nats"`
+	RabbitMQ              MQRabbitMQ `yaml:"rabbitmq"`
+}
+
+// GetProducedSchemaVersion returns 1 when no produced schema version is configured
+func (m MQ) GetProducedSchemaVersion() int {
+	if m.ProducedSchemaVersion == 0 {
+		return 1
+	}
+
+	return m.ProducedSchemaVersion
+}
+
+func (m MQ) GetRetryDelayDuration() (time.Duration, error) {
+	return time.ParseDuration(m.RetryDelay)
+}
+
+func (m MQ) GetDrainTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(m.DrainTimeout)
 }
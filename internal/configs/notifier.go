@@ -0,0 +1,31 @@
+package configs
+
+import "time"
+
+type NotifierType string
+
+const (
+	// NotifierTypeLog only logs the notifications, it is meant for development without a mail server
+	NotifierTypeLog  NotifierType = "log"
+	NotifierTypeSMTP NotifierType = "smtp"
+)
+
+type SMTP struct {
+	Host string `yaml:"host"`
+	Port int    `yaml:"port"`
+	// Username is empty to send mails without authentication, as with the local test server
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+	// Timeout bounds sending a mail, from connecting to the mail server until it accepted the mail
+	Timeout string `yaml:"timeout"`
+}
+
+func (s SMTP) GetTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(s.Timeout)
+}
+
+type Notifier struct {
+	Type NotifierType `yaml:"type"`
+	SMTP SMTP         `yaml:"smtp"`
+}
@@ -0,0 +1,40 @@
+package configs
+
+import (
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+type Webhook struct {
+	URL     string `yaml:"url"`
+	Timeout string `yaml:"timeout"`
+}
+
+func (w Webhook) GetTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(w.Timeout)
+}
+
+type MalwareScanning struct {
+	// Network is either tcp or unix, matching the TCPSocket and LocalSocket options of clamd
+	Network   string `yaml:"network"`
+	Address   string `yaml:"address"`
+	Timeout   string `yaml:"timeout"`
+	ChunkSize string `yaml:"chunk_size"`
+	// QuarantineOnScanError quarantines files that could not be scanned instead of serving them unscanned
+	QuarantineOnScanError bool `yaml:"quarantine_on_scan_error"`
+}
+
+func (m MalwareScanning) GetTimeoutDuration() (time.Duration, error) {
+	return time.ParseDuration(m.Timeout)
+}
+
+func (m MalwareScanning) GetChunkSizeInBytes() (uint64, error) {
+	return humanize.ParseBytes(m.ChunkSize)
+}
+
+type PostProcessing struct {
+	DefaultPostProcessorList []string        `yaml:"default_post_processor_list"`
+	Webhook                  Webhook         `yaml:"webhook"`
+	MalwareScanning          MalwareScanning `yaml:"malware_scanning"`
+}
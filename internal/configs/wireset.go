@@ -12,4 +12,6 @@ var WireSet = wire.NewSet(
 	wire.FieldsOf(new(Config), "HTTP"),
 	wire.FieldsOf(new(Config), "MQ"),
 	wire.FieldsOf(new(Config), "Download"),
+	wire.FieldsOf(new(Config), "Cron"),
+	wire.FieldsOf(new(Config), "Notifier"),
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/nhtuan0700/GoLoad/internal/configs"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
 	"github.com/redis/go-redis/v9"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,6 +25,10 @@ type Client interface {
 	Get(ctx context.Context, key string) (any, error)
 	AddToSet(ctx context.Context, key string, data ...any) error
 	IsDataInSet(ctx context.Context, key string, data any) (bool, error)
+	RemoveFromSet(ctx context.Context, key string, data ...any) error
+	// Increment adds one to the counter at key and returns its new value, ttl is only set when the counter is created
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	Delete(ctx context.Context, key string) error
 }
 
 func NewClient(
@@ -116,28 +121,100 @@ func (c *redisClient) IsDataInSet(ctx context.Context, key string, data any) (bo
 	return result, err
 }
 
+func (c *redisClient) RemoveFromSet(ctx context.Context, key string, data ...any) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("key", key)).
+		With(zap.Any("data", data))
+
+	if err := c.redisClient.SRem(ctx, key, data...).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove data from set inside cache")
+		return status.Error(codes.Internal, "failed to remove data from set inside cache")
+	}
+
+	return nil
+}
+
+func (c *redisClient) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("key", key)).
+		With(zap.Duration("ttl", ttl))
+
+	pipeline := c.redisClient.TxPipeline()
+	incrCmd := pipeline.Incr(ctx, key)
+	pipeline.ExpireNX(ctx, key, ttl)
+	if _, err := pipeline.Exec(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment counter inside cache")
+		return 0, status.Error(codes.Internal, "failed to increment counter inside cache")
+	}
+
+	return incrCmd.Val(), nil
+}
+
+func (c *redisClient) Delete(ctx context.Context, key string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("key", key))
+
+	if err := c.redisClient.Del(ctx, key).Err(); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete data from cache")
+		return status.Error(codes.Internal, "failed to delete data from cache")
+	}
+
+	return nil
+}
+
 type inMemoryClient struct {
-	cache      map[string]any
-	cacheMutex *sync.Mutex
-	logger     *zap.Logger
+	cache map[string]any
+	// expiresAtMap holds the expiry of keys set with a ttl, expired keys are removed when they are read
+	expiresAtMap map[string]time.Time
+	cacheMutex   *sync.Mutex
+	logger       *zap.Logger
 }
 
 func NewInMemoryClient(
 	logger *zap.Logger,
 ) Client {
 	return &inMemoryClient{
-		logger: logger,
+		cache:        make(map[string]any),
+		expiresAtMap: make(map[string]time.Time),
+		cacheMutex:   new(sync.Mutex),
+		logger:       logger,
 	}
 }
 
-func (c *inMemoryClient) Set(_ context.Context, key string, data any, _ time.Duration) error {
+func (c *inMemoryClient) setExpiry(key string, ttl time.Duration) {
+	if ttl <= 0 {
+		delete(c.expiresAtMap, key)
+		return
+	}
+
+	c.expiresAtMap[key] = time.Now().Add(ttl)
+}
+
+// getEntry must be called with the mutex held
+func (c *inMemoryClient) getEntry(key string) (any, bool) {
+	if expiresAt, ok := c.expiresAtMap[key]; ok && !expiresAt.After(time.Now()) {
+		delete(c.cache, key)
+		delete(c.expiresAtMap, key)
+		return nil, false
+	}
+
+	data, ok := c.cache[key]
+	return data, ok
+}
+
+func (c *inMemoryClient) Set(_ context.Context, key string, data any, ttl time.Duration) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
 	c.cache[key] = data
+	c.setExpiry(key, ttl)
 	return nil
 }
 
 func (c *inMemoryClient) Get(_ context.Context, key string) (any, error) {
-	data, ok := c.cache[key]
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
 
+	data, ok := c.getEntry(key)
 	if !ok {
 		return nil, ErrCacheMiss
 	}
@@ -145,12 +222,41 @@ func (c *inMemoryClient) Get(_ context.Context, key string) (any, error) {
 	return data, nil
 }
 
+func (c *inMemoryClient) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	data, ok := c.getEntry(key)
+	if !ok {
+		c.cache[key] = int64(1)
+		c.setExpiry(key, ttl)
+		return 1, nil
+	}
+
+	counter, ok := data.(int64)
+	if !ok {
+		return 0, status.Error(codes.Internal, "cache entry is not a counter")
+	}
+
+	c.cache[key] = counter + 1
+	return counter + 1, nil
+}
+
+func (c *inMemoryClient) Delete(_ context.Context, key string) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	delete(c.cache, key)
+	delete(c.expiresAtMap, key)
+	return nil
+}
+
 func (c *inMemoryClient) AddToSet(_ context.Context, key string, data ...any) error {
 	c.cacheMutex.Lock()
 	defer c.cacheMutex.Unlock()
 
 	set := c.getSet(key)
-	set = append(set, data)
+	set = append(set, data...)
 	c.cache[key] = set
 
 	return nil
@@ -170,8 +276,24 @@ func (c *inMemoryClient) IsDataInSet(_ context.Context, key string, data any) (b
 	return false, nil
 }
 
+func (c *inMemoryClient) RemoveFromSet(_ context.Context, key string, data ...any) error {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+
+	set := c.getSet(key)
+	remainingSet := make([]any, 0, len(set))
+	for i := range set {
+		if !lo.Contains(data, set[i]) {
+			remainingSet = append(remainingSet, set[i])
+		}
+	}
+	c.cache[key] = remainingSet
+
+	return nil
+}
+
 func (c *inMemoryClient) getSet(key string) []any {
-	setValue, ok := c.cache[key]
+	setValue, ok := c.getEntry(key)
 	if !ok {
 		return make([]any, 0)
 	}
@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+// LoginAttempt counts failed logins and keeps lockouts, subject is what the logins are counted by such as an
+// account name or a client ip
+type LoginAttempt interface {
+	// IncrementFailedCount returns the number of failed logins of the subject within window since its first failure
+	IncrementFailedCount(ctx context.Context, subject string, window time.Duration) (uint64, error)
+	ResetFailedCount(ctx context.Context, subject string) error
+	SetLockedUntil(ctx context.Context, subject string, lockedUntil time.Time) error
+	// GetLockedUntil returns the zero time if the subject is not locked out
+	GetLockedUntil(ctx context.Context, subject string) (time.Time, error)
+}
+
+type loginAttempt struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewLoginAttempt(
+	client Client,
+	logger *zap.Logger,
+) LoginAttempt {
+	return &loginAttempt{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l loginAttempt) getFailedCountCacheKey(subject string) string {
+	return fmt.Sprintf("login_failed_count:%s", subject)
+}
+
+func (l loginAttempt) getLockedUntilCacheKey(subject string) string {
+	return fmt.Sprintf("login_locked_until:%s", subject)
+}
+
+func (l loginAttempt) IncrementFailedCount(ctx context.Context, subject string, window time.Duration) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("subject", subject))
+
+	failedCount, err := l.client.Increment(ctx, l.getFailedCountCacheKey(subject), window)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to increment failed login count in cache")
+		return 0, err
+	}
+
+	return uint64(failedCount), nil
+}
+
+func (l loginAttempt) ResetFailedCount(ctx context.Context, subject string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("subject", subject))
+
+	if err := l.client.Delete(ctx, l.getFailedCountCacheKey(subject)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to reset failed login count in cache")
+		return err
+	}
+
+	return nil
+}
+
+func (l loginAttempt) SetLockedUntil(ctx context.Context, subject string, lockedUntil time.Time) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("subject", subject))
+
+	ttl := time.Until(lockedUntil)
+	if ttl <= 0 {
+		return nil
+	}
+
+	err := l.client.Set(ctx, l.getLockedUntilCacheKey(subject), strconv.FormatInt(lockedUntil.Unix(), 10), ttl)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set login lockout into cache")
+		return err
+	}
+
+	return nil
+}
+
+func (l loginAttempt) GetLockedUntil(ctx context.Context, subject string) (time.Time, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.String("subject", subject))
+
+	cacheEntry, err := l.client.Get(ctx, l.getLockedUntilCacheKey(subject))
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return time.Time{}, nil
+		}
+		logger.With(zap.Error(err)).Error("failed to get login lockout from cache")
+		return time.Time{}, err
+	}
+
+	lockedUntilUnix, err := strconv.ParseInt(fmt.Sprint(cacheEntry), 10, 64)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unexpected login lockout cache entry")
+		return time.Time{}, nil
+	}
+
+	return time.Unix(lockedUntilUnix, 0), nil
+}
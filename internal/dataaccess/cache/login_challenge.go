@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+// LoginChallenge keeps the account of a login whose password was checked but which still requires a second factor,
+// challenges are identified by the hash of their token
+type LoginChallenge interface {
+	Set(ctx context.Context, challengeTokenHash string, accountID uint64, ttl time.Duration) error
+	// Get returns ErrCacheMiss if the challenge expired or was already used
+	Get(ctx context.Context, challengeTokenHash string) (uint64, error)
+	Delete(ctx context.Context, challengeTokenHash string) error
+}
+
+type loginChallenge struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewLoginChallenge(
+	client Client,
+	logger *zap.Logger,
+) LoginChallenge {
+	return &loginChallenge{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (l loginChallenge) getLoginChallengeCacheKey(challengeTokenHash string) string {
+	return fmt.Sprintf("login_challenge:%s", challengeTokenHash)
+}
+
+func (l loginChallenge) Set(ctx context.Context, challengeTokenHash string, accountID uint64, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, l.logger).With(zap.Uint64("account_id", accountID))
+
+	err := l.client.Set(ctx, l.getLoginChallengeCacheKey(challengeTokenHash), strconv.FormatUint(accountID, 10), ttl)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to set login challenge into cache")
+		return err
+	}
+
+	return nil
+}
+
+func (l loginChallenge) Get(ctx context.Context, challengeTokenHash string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	cacheEntry, err := l.client.Get(ctx, l.getLoginChallengeCacheKey(challengeTokenHash))
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			logger.With(zap.Error(err)).Error("failed to get login challenge from cache")
+		}
+		return 0, err
+	}
+
+	accountID, err := strconv.ParseUint(fmt.Sprint(cacheEntry), 10, 64)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("unexpected login challenge cache entry")
+		return 0, ErrCacheMiss
+	}
+
+	return accountID, nil
+}
+
+func (l loginChallenge) Delete(ctx context.Context, challengeTokenHash string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	if err := l.client.Delete(ctx, l.getLoginChallengeCacheKey(challengeTokenHash)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete login challenge from cache")
+		return err
+	}
+
+	return nil
+}
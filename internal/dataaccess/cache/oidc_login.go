@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+// OIDCLoginState is kept between redirecting a browser to the identity provider and its callback
+type OIDCLoginState struct {
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+}
+
+// OIDCLogin keeps the state of openid connect logins that are in progress, keyed by their state parameter
+type OIDCLogin interface {
+	Set(ctx context.Context, state string, loginState OIDCLoginState, ttl time.Duration) error
+	// Get returns ErrCacheMiss if the login expired or was already completed
+	Get(ctx context.Context, state string) (OIDCLoginState, error)
+	Delete(ctx context.Context, state string) error
+}
+
+type oidcLogin struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewOIDCLogin(
+	client Client,
+	logger *zap.Logger,
+) OIDCLogin {
+	return &oidcLogin{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (o oidcLogin) getOIDCLoginCacheKey(state string) string {
+	return fmt.Sprintf("oidc_login:%s", state)
+}
+
+func (o oidcLogin) Set(ctx context.Context, state string, loginState OIDCLoginState, ttl time.Duration) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	loginStateJSON, err := json.Marshal(loginState)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal oidc login state")
+		return err
+	}
+
+	if err = o.client.Set(ctx, o.getOIDCLoginCacheKey(state), string(loginStateJSON), ttl); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set oidc login state into cache")
+		return err
+	}
+
+	return nil
+}
+
+func (o oidcLogin) Get(ctx context.Context, state string) (OIDCLoginState, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	cacheEntry, err := o.client.Get(ctx, o.getOIDCLoginCacheKey(state))
+	if err != nil {
+		if !errors.Is(err, ErrCacheMiss) {
+			logger.With(zap.Error(err)).Error("failed to get oidc login state from cache")
+		}
+		return OIDCLoginState{}, err
+	}
+
+	var loginState OIDCLoginState
+	if err = json.Unmarshal([]byte(fmt.Sprint(cacheEntry)), &loginState); err != nil {
+		logger.With(zap.Error(err)).Error("unexpected oidc login state cache entry")
+		return OIDCLoginState{}, ErrCacheMiss
+	}
+
+	return loginState, nil
+}
+
+func (o oidcLogin) Delete(ctx context.Context, state string) error {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if err := o.client.Delete(ctx, o.getOIDCLoginCacheKey(state)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete oidc login state from cache")
+		return err
+	}
+
+	return nil
+}
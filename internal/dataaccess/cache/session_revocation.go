@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	sessionRevocationCacheTTL = 10 * time.Minute
+
+	sessionRevocationCacheValueActive  = "active"
+	sessionRevocationCacheValueRevoked = "revoked"
+)
+
+// SessionRevocation caches whether a session is revoked, so validating a token does not hit the database every time
+type SessionRevocation interface {
+	Get(ctx context.Context, sessionID uint64) (bool, error)
+	Set(ctx context.Context, sessionID uint64, revoked bool) error
+}
+
+type sessionRevocation struct {
+	client Client
+	logger *zap.Logger
+}
+
+func NewSessionRevocation(
+	client Client,
+	logger *zap.Logger,
+) SessionRevocation {
+	return &sessionRevocation{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (s sessionRevocation) getSessionRevocationCacheKey(sessionID uint64) string {
+	return fmt.Sprintf("session_revocation:%d", sessionID)
+}
+
+func (s sessionRevocation) Get(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", sessionID))
+
+	cacheEntry, err := s.client.Get(ctx, s.getSessionRevocationCacheKey(sessionID))
+	if err != nil {
+		if errors.Is(err, ErrCacheMiss) {
+			return false, ErrCacheMiss
+		}
+		logger.With(zap.Error(err)).Error("failed to get session revocation cache")
+		return false, err
+	}
+
+	switch cacheEntry {
+	case sessionRevocationCacheValueActive:
+		return false, nil
+	case sessionRevocationCacheValueRevoked:
+		return true, nil
+	default:
+		logger.Error("unexpected session revocation cache entry")
+		return false, ErrCacheMiss
+	}
+}
+
+func (s sessionRevocation) Set(ctx context.Context, sessionID uint64, revoked bool) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", sessionID))
+
+	cacheValue := sessionRevocationCacheValueActive
+	if revoked {
+		cacheValue = sessionRevocationCacheValueRevoked
+	}
+
+	if err := s.client.Set(ctx, s.getSessionRevocationCacheKey(sessionID), cacheValue, sessionRevocationCacheTTL); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set session revocation into cache")
+		return err
+	}
+
+	return nil
+}
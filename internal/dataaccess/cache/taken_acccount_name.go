@@ -14,6 +14,8 @@ const (
 type TakeAccountName interface {
 	Add(ctx context.Context, accountName string) error
 	Has(ctx context.Context, accountName string) (bool, error)
+	// Remove makes the name of a deleted account available again
+	Remove(ctx context.Context, accountName string) error
 }
 
 type takenAccountName struct {
@@ -53,3 +55,14 @@ func (c *takenAccountName) Has(ctx context.Context, accountName string) (bool, e
 
 	return ok, err
 }
+
+func (c *takenAccountName) Remove(ctx context.Context, accountName string) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("account_name", accountName))
+
+	if err := c.client.RemoveFromSet(ctx, setKeyNameTakenAccountName, accountName); err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove account name from set in cache")
+		return err
+	}
+
+	return nil
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/nhtuan0700/GoLoad/internal/utils"
 	"go.uber.org/zap"
 )
 
+const (
+	// tokenPublicKeyCacheTTL bounds how long a retired public key can still be read from the cache
+	tokenPublicKeyCacheTTL = 10 * time.Minute
+)
+
 type TokenPublicKeyCache interface {
 	Get(ctx context.Context, id uint64) (string, error)
 	Set(ctx context.Context, id uint64, data string) error
@@ -59,7 +65,7 @@ func (c *tokenPublicKeyCache) Set(ctx context.Context, id uint64, data string) e
 	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.Uint64("id", id))
 
 	cacheKey := c.getTokenPublicKeyCacheKey(id)
-	if err := c.client.Set(ctx, cacheKey, data, 0); err != nil {
+	if err := c.client.Set(ctx, cacheKey, data, tokenPublicKeyCacheTTL); err != nil {
 		logger.With(zap.Error(err)).Error("failed to insert token public key into cache")
 		return err
 	}
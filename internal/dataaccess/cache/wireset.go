@@ -6,4 +6,8 @@ var WireSet = wire.NewSet(
 	NewClient,
 	NewTakenAccountName,
 	NewTokenPublicKeyCache,
+	NewSessionRevocation,
+	NewLoginAttempt,
+	NewLoginChallenge,
+	NewOIDCLogin,
 )
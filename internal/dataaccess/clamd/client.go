@@ -0,0 +1,183 @@
+package clamd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The z prefix makes clamd use null terminated commands and replies
+	commandInstream = "zINSTREAM\x00"
+
+	replySuffixOK    = "OK"
+	replySuffixFound = "FOUND"
+	replySuffixError = "ERROR"
+)
+
+var (
+	ErrUnexpectedReply = errors.New("unexpected reply from clamd")
+	// ErrNotConfigured is returned by ScanStream when download.post_processing.malware_scanning.address is empty
+	ErrNotConfigured = errors.New("clamd is not configured")
+)
+
+type ScanResult struct {
+	Infected  bool
+	Signature string
+}
+
+type Client interface {
+	// ScanStream streams the data to clamd with the INSTREAM command
+	ScanStream(ctx context.Context, reader io.Reader) (ScanResult, error)
+}
+
+type client struct {
+	network          string
+	address          string
+	timeout          time.Duration
+	chunkSizeInBytes uint64
+	logger           *zap.Logger
+}
+
+// NewClient does not parse the rest of the malware scanning config when no address is configured, malware scanning
+// is optional
+func NewClient(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (Client, error) {
+	malwareScanningConfig := downloadConfig.PostProcessing.MalwareScanning
+	if malwareScanningConfig.Address == "" {
+		return &client{
+			logger: logger,
+		}, nil
+	}
+
+	timeout, err := malwareScanningConfig.GetTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse malware_scanning.timeout: %w", err)
+	}
+
+	chunkSizeInBytes, err := malwareScanningConfig.GetChunkSizeInBytes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse malware_scanning.chunk_size: %w", err)
+	}
+
+	// Reads into an empty chunk never reach the end of the stream
+	if chunkSizeInBytes == 0 {
+		return nil, errors.New("malware_scanning.chunk_size must be greater than 0")
+	}
+
+	return &client{
+		network:          malwareScanningConfig.Network,
+		address:          malwareScanningConfig.Address,
+		timeout:          timeout,
+		chunkSizeInBytes: chunkSizeInBytes,
+		logger:           logger,
+	}, nil
+}
+
+func (c client) sendChunks(connection net.Conn, reader io.Reader) error {
+	if _, err := connection.Write([]byte(commandInstream)); err != nil {
+		return err
+	}
+
+	// Each chunk is prefixed with its length as a 4 bytes unsigned integer in network byte order
+	dataBuffer := make([]byte, 4+c.chunkSizeInBytes)
+	for {
+		readByteCount, readErr := reader.Read(dataBuffer[4:])
+		if readByteCount > 0 {
+			binary.BigEndian.PutUint32(dataBuffer[:4], uint32(readByteCount))
+			if _, err := connection.Write(dataBuffer[:4+readByteCount]); err != nil {
+				return err
+			}
+		}
+
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+			return readErr
+		}
+	}
+
+	// A zero length chunk marks the end of the stream
+	_, err := connection.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+func parseReply(reply string) (ScanResult, error) {
+	// Replies look like "stream: OK", "stream: Eicar-Signature FOUND" or "INSTREAM size limit exceeded. ERROR"
+	reply = strings.TrimSpace(strings.TrimSuffix(reply, "\x00"))
+	switch {
+	case strings.HasSuffix(reply, replySuffixOK):
+		return ScanResult{}, nil
+
+	case strings.HasSuffix(reply, replySuffixFound):
+		signature := strings.TrimSuffix(reply, replySuffixFound)
+		signature = strings.TrimSpace(signature[strings.Index(signature, ":")+1:])
+		return ScanResult{
+			Infected:  true,
+			Signature: signature,
+		}, nil
+
+	case strings.HasSuffix(reply, replySuffixError):
+		return ScanResult{}, fmt.Errorf("clamd failed to scan: %s", reply)
+
+	default:
+		return ScanResult{}, fmt.Errorf("%w: %s", ErrUnexpectedReply, reply)
+	}
+}
+
+func (c client) ScanStream(ctx context.Context, reader io.Reader) (ScanResult, error) {
+	if c.address == "" {
+		return ScanResult{}, ErrNotConfigured
+	}
+
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("network", c.network)).
+		With(zap.String("address", c.address))
+
+	dialer := net.Dialer{Timeout: c.timeout}
+	connection, err := dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to connect to clamd")
+		return ScanResult{}, status.Error(codes.Unavailable, "failed to connect to clamd")
+	}
+	defer connection.Close()
+
+	if err = connection.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to set clamd connection deadline")
+		return ScanResult{}, status.Error(codes.Internal, "failed to set clamd connection deadline")
+	}
+
+	if err = c.sendChunks(connection, reader); err != nil {
+		logger.With(zap.Error(err)).Error("failed to stream data to clamd")
+		return ScanResult{}, status.Error(codes.Internal, "failed to stream data to clamd")
+	}
+
+	reply, err := bufio.NewReader(connection).ReadString(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.With(zap.Error(err)).Error("failed to read clamd reply")
+		return ScanResult{}, status.Error(codes.Internal, "failed to read clamd reply")
+	}
+
+	scanResult, err := parseReply(reply)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to scan stream")
+		return ScanResult{}, err
+	}
+
+	return scanResult, nil
+}
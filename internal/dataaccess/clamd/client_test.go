@@ -0,0 +1,158 @@
+package clamd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"go.uber.org/zap"
+)
+
+// startFakeClamd accepts one connection, reads an INSTREAM command and replies with reply, or closes the connection
+// without replying if reply is empty. The streamed data is sent to the returned channel
+func startFakeClamd(t *testing.T, reply string) (string, <-chan []byte) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	streamedDataChannel := make(chan []byte, 1)
+	go func() {
+		connection, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer connection.Close()
+
+		reader := bufio.NewReader(connection)
+		command, err := reader.ReadString(0)
+		if err != nil || command != commandInstream {
+			t.Errorf("expected command %q, got %q", commandInstream, command)
+			return
+		}
+
+		var streamedData bytes.Buffer
+		for {
+			var chunkLength uint32
+			if err = binary.Read(reader, binary.BigEndian, &chunkLength); err != nil {
+				t.Errorf("failed to read chunk length: %v", err)
+				return
+			}
+
+			if chunkLength == 0 {
+				break
+			}
+
+			if _, err = io.CopyN(&streamedData, reader, int64(chunkLength)); err != nil {
+				t.Errorf("failed to read chunk: %v", err)
+				return
+			}
+		}
+
+		streamedDataChannel <- streamedData.Bytes()
+		if reply != "" {
+			_, _ = connection.Write([]byte(reply + "\x00"))
+		}
+	}()
+
+	return listener.Addr().String(), streamedDataChannel
+}
+
+func newTestClient(t *testing.T, address string) Client {
+	client, err := NewClient(configs.Download{
+		PostProcessing: configs.PostProcessing{
+			MalwareScanning: configs.MalwareScanning{
+				Network:   "tcp",
+				Address:   address,
+				Timeout:   "5s",
+				ChunkSize: "4B",
+			},
+		},
+	}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create clamd client: %v", err)
+	}
+
+	return client
+}
+
+func TestClientScanStream(t *testing.T) {
+	testCaseList := []struct {
+		name               string
+		reply              string
+		expectedScanResult ScanResult
+		expectedErr        bool
+	}{
+		{
+			name:  "ok",
+			reply: "stream: OK",
+		},
+		{
+			name:               "found",
+			reply:              "stream: Eicar-Signature FOUND",
+			expectedScanResult: ScanResult{Infected: true, Signature: "Eicar-Signature"},
+		},
+		{
+			name:        "error",
+			reply:       "INSTREAM size limit exceeded. ERROR",
+			expectedErr: true,
+		},
+		{
+			name:        "dropped connection",
+			expectedErr: true,
+		},
+	}
+
+	for _, testCase := range testCaseList {
+		t.Run(testCase.name, func(t *testing.T) {
+			address, streamedDataChannel := startFakeClamd(t, testCase.reply)
+			data := "data streamed in more than one chunk"
+
+			scanResult, err := newTestClient(t, address).ScanStream(context.Background(), strings.NewReader(data))
+			if testCase.expectedErr != (err != nil) {
+				t.Fatalf("expected error %v, got %v", testCase.expectedErr, err)
+			}
+
+			if scanResult != testCase.expectedScanResult {
+				t.Errorf("expected scan result %+v, got %+v", testCase.expectedScanResult, scanResult)
+			}
+
+			if streamedData := <-streamedDataChannel; string(streamedData) != data {
+				t.Errorf("expected streamed data %q, got %q", data, streamedData)
+			}
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	client, err := NewClient(configs.Download{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("expected no error without malware scanning config, got %v", err)
+	}
+
+	if _, err = client.ScanStream(context.Background(), strings.NewReader("data")); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("expected %v, got %v", ErrNotConfigured, err)
+	}
+
+	_, err = NewClient(configs.Download{
+		PostProcessing: configs.PostProcessing{
+			MalwareScanning: configs.MalwareScanning{
+				Network:   "tcp",
+				Address:   "127.0.0.1:3310",
+				Timeout:   "5s",
+				ChunkSize: "0B",
+			},
+		},
+	}, zap.NewNop())
+	if err == nil {
+		t.Error("expected an error with a chunk size of 0")
+	}
+}
@@ -0,0 +1,7 @@
+package clamd
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewClient,
+)
@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
@@ -17,19 +19,66 @@ var (
 )
 
 const (
-	ColNameAccountID          = "id"
-	ColNameAccountAccountName = "account_name"
+	ColNameAccountID                    = "id"
+	ColNameAccountAccountName           = "account_name"
+	ColNameAccountDownloadTaskRetention = "download_task_retention"
+	ColNameAccountPostProcessorList     = "post_processor_list"
+	ColNameAccountRole                  = "role"
+	ColNameAccountDisabledAt            = "disabled_at"
+	ColNameAccountEmail                 = "email"
 )
 
 type Account struct {
 	ID   uint64 `db:"id" goqu:"skipinsert,skipupdate"`
 	Name string `db:"account_name"`
+	// DownloadTaskRetention is in seconds, nil means the configured default retention is used
+	DownloadTaskRetention *uint64 `db:"download_task_retention"`
+	// PostProcessorList holds a list of post processor names, nil data means the configured default list is used
+	PostProcessorList JSON   `db:"post_processor_list"`
+	Role              string `db:"role"`
+	// DisabledAt is nil for accounts that can sign in
+	DisabledAt *time.Time `db:"disabled_at"`
+	// Email is where notifications such as password resets are sent, nil if the account did not set one
+	Email *string `db:"email"`
+}
+
+// AccountFilter matches accounts satisfying every set field, empty fields are ignored
+type AccountFilter struct {
+	AccountNameContains string
+	Role                string
+	Disabled            *bool
+}
+
+func (f AccountFilter) toExpressionList() []goqu.Expression {
+	expressionList := make([]goqu.Expression, 0)
+	if f.AccountNameContains != "" {
+		escapedAccountNameContains := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(f.AccountNameContains)
+		expressionList = append(expressionList, goqu.C(ColNameAccountAccountName).Like("%"+escapedAccountNameContains+"%"))
+	}
+
+	if f.Role != "" {
+		expressionList = append(expressionList, goqu.C(ColNameAccountRole).Eq(f.Role))
+	}
+
+	if f.Disabled != nil {
+		if *f.Disabled {
+			expressionList = append(expressionList, goqu.C(ColNameAccountDisabledAt).IsNotNull())
+		} else {
+			expressionList = append(expressionList, goqu.C(ColNameAccountDisabledAt).IsNull())
+		}
+	}
+
+	return expressionList
 }
 
 type AccountDataAccessor interface {
 	CreateAccount(ctx context.Context, account Account) (uint64, error)
 	GetAccountByID(ctx context.Context, id uint64) (Account, error)
 	GetAccountByAccountName(ctx context.Context, accountName string) (Account, error)
+	UpdateAccount(ctx context.Context, account Account) error
+	GetAccountListByFilter(ctx context.Context, filter AccountFilter, limit uint64, offset uint64) ([]Account, uint64, error)
+	GetAccountCount(ctx context.Context, filter AccountFilter) (uint64, error)
+	DeleteAccount(ctx context.Context, id uint64) error
 	WithDatabase(database Database) AccountDataAccessor
 }
 
@@ -55,6 +104,8 @@ func (a *accountDataAccessor) CreateAccount(ctx context.Context, account Account
 		Insert(TableNameAccount).
 		Rows(goqu.Record{
 			ColNameAccountAccountName: account.Name,
+			ColNameAccountRole:        account.Role,
+			ColNameAccountEmail:       account.Email,
 		}).
 		Executor().
 		ExecContext(ctx)
@@ -116,6 +167,89 @@ func (a *accountDataAccessor) GetAccountByAccountName(ctx context.Context, accou
 	return account, nil
 }
 
+func (a *accountDataAccessor) UpdateAccount(ctx context.Context, account Account) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("account", account))
+
+	_, err := a.database.
+		Update(TableNameAccount).
+		Set(account).
+		Where(goqu.C(ColNameAccountID).Eq(account.ID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account")
+		return status.Error(codes.Internal, "failed to update account")
+	}
+
+	return nil
+}
+
+func (a *accountDataAccessor) GetAccountListByFilter(
+	ctx context.Context,
+	filter AccountFilter,
+	limit uint64,
+	offset uint64,
+) ([]Account, uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Any("filter", filter)).
+		With(zap.Uint64("limit", limit)).
+		With(zap.Uint64("offset", offset))
+
+	expressionList := filter.toExpressionList()
+
+	var accountList []Account
+	if err := a.database.
+		Select().
+		From(TableNameAccount).
+		Where(expressionList...).
+		Order(goqu.C(ColNameAccountID).Asc()).
+		Limit(uint(limit)).
+		Offset(uint(offset)).
+		Executor().
+		ScanStructsContext(ctx, &accountList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account list by filter")
+		return nil, 0, status.Error(codes.Internal, "failed to get account list by filter")
+	}
+
+	count, err := a.GetAccountCount(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return accountList, count, nil
+}
+
+func (a *accountDataAccessor) GetAccountCount(ctx context.Context, filter AccountFilter) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("filter", filter))
+
+	count, err := a.database.
+		From(TableNameAccount).
+		Where(filter.toExpressionList()...).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get count of account by filter")
+		return 0, status.Error(codes.Internal, "failed to get count of account by filter")
+	}
+
+	return uint64(count), nil
+}
+
+func (a *accountDataAccessor) DeleteAccount(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	_, err := a.database.
+		Delete(TableNameAccount).
+		Where(goqu.C(ColNameAccountID).Eq(id)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account")
+		return status.Error(codes.Internal, "failed to delete account")
+	}
+
+	return nil
+}
+
 func (a *accountDataAccessor) WithDatabase(database Database) AccountDataAccessor {
 	return &accountDataAccessor{
 		database: database,
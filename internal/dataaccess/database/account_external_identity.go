@@ -0,0 +1,136 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameAccountExternalIdentity = goqu.T("account_external_identities")
+
+	ErrAccountExternalIdentityNotFound = status.Error(codes.NotFound, "account external identity not found")
+)
+
+const (
+	ColNameAccountExternalIdentityID          = "id"
+	ColNameAccountExternalIdentityOfAccountID = "of_account_id"
+	ColNameAccountExternalIdentityIssuer      = "issuer"
+	ColNameAccountExternalIdentitySubject     = "subject"
+	ColNameAccountExternalIdentityCreatedAt   = "created_at"
+)
+
+// AccountExternalIdentity maps a subject of an openid connect identity provider to the account it signs in to
+type AccountExternalIdentity struct {
+	ID          uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64    `db:"of_account_id"`
+	Issuer      string    `db:"issuer"`
+	Subject     string    `db:"subject"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+type AccountExternalIdentityDataAccessor interface {
+	CreateAccountExternalIdentity(ctx context.Context, accountExternalIdentity AccountExternalIdentity) (uint64, error)
+	GetAccountExternalIdentityByIssuerAndSubject(ctx context.Context, issuer, subject string) (AccountExternalIdentity, error)
+	DeleteAccountExternalIdentityListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountExternalIdentityDataAccessor
+}
+
+type accountExternalIdentityDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountExternalIdentityDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountExternalIdentityDataAccessor {
+	return &accountExternalIdentityDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a *accountExternalIdentityDataAccessor) CreateAccountExternalIdentity(
+	ctx context.Context,
+	accountExternalIdentity AccountExternalIdentity,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Uint64("of_account_id", accountExternalIdentity.OfAccountID)).
+		With(zap.String("issuer", accountExternalIdentity.Issuer))
+
+	result, err := a.database.
+		Insert(TableNameAccountExternalIdentity).
+		Rows(accountExternalIdentity).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account external identity")
+		return 0, status.Error(codes.Internal, "failed to create account external identity")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (a *accountExternalIdentityDataAccessor) GetAccountExternalIdentityByIssuerAndSubject(
+	ctx context.Context,
+	issuer, subject string,
+) (AccountExternalIdentity, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("issuer", issuer))
+
+	var accountExternalIdentity AccountExternalIdentity
+	found, err := a.database.
+		From(TableNameAccountExternalIdentity).
+		Where(goqu.Ex{
+			ColNameAccountExternalIdentityIssuer:  issuer,
+			ColNameAccountExternalIdentitySubject: subject,
+		}).
+		ScanStructContext(ctx, &accountExternalIdentity)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account external identity")
+		return AccountExternalIdentity{}, status.Error(codes.Internal, "failed to get account external identity")
+	}
+
+	if !found {
+		return AccountExternalIdentity{}, ErrAccountExternalIdentityNotFound
+	}
+
+	return accountExternalIdentity, nil
+}
+
+func (a *accountExternalIdentityDataAccessor) DeleteAccountExternalIdentityListByAccount(
+	ctx context.Context,
+	accountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := a.database.
+		Delete(TableNameAccountExternalIdentity).
+		Where(goqu.C(ColNameAccountExternalIdentityOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account external identity list by account")
+		return status.Error(codes.Internal, "failed to delete account external identity list by account")
+	}
+
+	return nil
+}
+
+func (a *accountExternalIdentityDataAccessor) WithDatabase(database Database) AccountExternalIdentityDataAccessor {
+	return &accountExternalIdentityDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
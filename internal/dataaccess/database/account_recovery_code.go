@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameAccountRecoveryCode = goqu.T("account_recovery_codes")
+
+	ErrAccountRecoveryCodeNotFound = status.Error(codes.NotFound, "account recovery code not found")
+)
+
+const (
+	ColNameAccountRecoveryCodeID          = "id"
+	ColNameAccountRecoveryCodeOfAccountID = "of_account_id"
+	ColNameAccountRecoveryCodeCodeHash    = "code_hash"
+	ColNameAccountRecoveryCodeUsedAt      = "used_at"
+)
+
+type AccountRecoveryCode struct {
+	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	// CodeHash is the hex encoded sha256 of the recovery code, the code itself is only shown once
+	CodeHash string `db:"code_hash" goqu:"skipupdate"`
+	// UsedAt is nil for codes that were not used, a code can only be used once
+	UsedAt *time.Time `db:"used_at"`
+}
+
+type AccountRecoveryCodeDataAccessor interface {
+	CreateAccountRecoveryCodeList(ctx context.Context, accountRecoveryCodeList []AccountRecoveryCode) error
+	UpdateAccountRecoveryCode(ctx context.Context, accountRecoveryCode AccountRecoveryCode) error
+	GetAccountRecoveryCodeWithXLock(ctx context.Context, ofAccountID uint64, codeHash string) (AccountRecoveryCode, error)
+	DeleteAccountRecoveryCodeListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) AccountRecoveryCodeDataAccessor
+}
+
+type accountRecoveryCodeDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountRecoveryCodeDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a *accountRecoveryCodeDataAccessor) CreateAccountRecoveryCodeList(
+	ctx context.Context,
+	accountRecoveryCodeList []AccountRecoveryCode,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	if len(accountRecoveryCodeList) == 0 {
+		return nil
+	}
+
+	rowList := make([]any, 0, len(accountRecoveryCodeList))
+	for _, accountRecoveryCode := range accountRecoveryCodeList {
+		rowList = append(rowList, accountRecoveryCode)
+	}
+
+	_, err := a.database.
+		Insert(TableNameAccountRecoveryCode).
+		Rows(rowList...).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account recovery code list")
+		return status.Error(codes.Internal, "failed to create account recovery code list")
+	}
+
+	return nil
+}
+
+func (a *accountRecoveryCodeDataAccessor) UpdateAccountRecoveryCode(
+	ctx context.Context,
+	accountRecoveryCode AccountRecoveryCode,
+) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", accountRecoveryCode.ID))
+
+	_, err := a.database.
+		Update(TableNameAccountRecoveryCode).
+		Set(accountRecoveryCode).
+		Where(goqu.Ex{ColNameAccountRecoveryCodeID: accountRecoveryCode.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account recovery code")
+		return status.Error(codes.Internal, "failed to update account recovery code")
+	}
+
+	return nil
+}
+
+func (a *accountRecoveryCodeDataAccessor) GetAccountRecoveryCodeWithXLock(
+	ctx context.Context,
+	ofAccountID uint64,
+	codeHash string,
+) (AccountRecoveryCode, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", ofAccountID))
+
+	var accountRecoveryCode AccountRecoveryCode
+	found, err := a.database.
+		From(TableNameAccountRecoveryCode).
+		Where(goqu.Ex{
+			ColNameAccountRecoveryCodeOfAccountID: ofAccountID,
+			ColNameAccountRecoveryCodeCodeHash:    codeHash,
+		}).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &accountRecoveryCode)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account recovery code")
+		return AccountRecoveryCode{}, status.Error(codes.Internal, "failed to get account recovery code")
+	}
+
+	if !found {
+		return AccountRecoveryCode{}, ErrAccountRecoveryCodeNotFound
+	}
+
+	return accountRecoveryCode, nil
+}
+
+func (a *accountRecoveryCodeDataAccessor) DeleteAccountRecoveryCodeListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := a.database.
+		Delete(TableNameAccountRecoveryCode).
+		Where(goqu.C(ColNameAccountRecoveryCodeOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account recovery code list by account")
+		return status.Error(codes.Internal, "failed to delete account recovery code list by account")
+	}
+
+	return nil
+}
+
+func (a *accountRecoveryCodeDataAccessor) WithDatabase(database Database) AccountRecoveryCodeDataAccessor {
+	return &accountRecoveryCodeDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
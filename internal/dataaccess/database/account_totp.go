@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameAccountTOTP = goqu.T("account_totps")
+
+	ErrAccountTOTPNotFound = status.Error(codes.NotFound, "account totp not found")
+)
+
+const (
+	ColNameAccountTOTPOfAccountID     = "of_account_id"
+	ColNameAccountTOTPEncryptedSecret = "encrypted_secret"
+	ColNameAccountTOTPCreatedAt       = "created_at"
+	ColNameAccountTOTPConfirmedAt     = "confirmed_at"
+	ColNameAccountTOTPLastUsedStep    = "last_used_step"
+)
+
+type AccountTOTP struct {
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	// EncryptedSecret is the base64 encoded secret sealed with the configured encryption key
+	EncryptedSecret string    `db:"encrypted_secret"`
+	CreatedAt       time.Time `db:"created_at"`
+	// ConfirmedAt is nil while the enrollment is not confirmed with a code, logins only require confirmed totps
+	ConfirmedAt *time.Time `db:"confirmed_at"`
+	// LastUsedStep is the time step of the last accepted code, a code cannot be accepted twice
+	LastUsedStep uint64 `db:"last_used_step"`
+}
+
+type AccountTOTPDataAccessor interface {
+	CreateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error
+	UpdateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error
+	GetAccountTOTP(ctx context.Context, ofAccountID uint64) (AccountTOTP, error)
+	GetAccountTOTPWithXLock(ctx context.Context, ofAccountID uint64) (AccountTOTP, error)
+	DeleteAccountTOTP(ctx context.Context, ofAccountID uint64) error
+	WithDatabase(database Database) AccountTOTPDataAccessor
+}
+
+type accountTOTPDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAccountTOTPDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a *accountTOTPDataAccessor) CreateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", accountTOTP.OfAccountID))
+
+	_, err := a.database.
+		Insert(TableNameAccountTOTP).
+		Rows(accountTOTP).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create account totp")
+		return status.Error(codes.Internal, "failed to create account totp")
+	}
+
+	return nil
+}
+
+func (a *accountTOTPDataAccessor) UpdateAccountTOTP(ctx context.Context, accountTOTP AccountTOTP) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", accountTOTP.OfAccountID))
+
+	_, err := a.database.
+		Update(TableNameAccountTOTP).
+		Set(accountTOTP).
+		Where(goqu.Ex{ColNameAccountTOTPOfAccountID: accountTOTP.OfAccountID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update account totp")
+		return status.Error(codes.Internal, "failed to update account totp")
+	}
+
+	return nil
+}
+
+func (a *accountTOTPDataAccessor) getAccountTOTP(ctx context.Context, ofAccountID uint64, xLock bool) (AccountTOTP, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", ofAccountID))
+
+	query := a.database.
+		From(TableNameAccountTOTP).
+		Where(goqu.Ex{ColNameAccountTOTPOfAccountID: ofAccountID})
+	if xLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	var accountTOTP AccountTOTP
+	found, err := query.ScanStructContext(ctx, &accountTOTP)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get account totp")
+		return AccountTOTP{}, status.Error(codes.Internal, "failed to get account totp")
+	}
+
+	if !found {
+		return AccountTOTP{}, ErrAccountTOTPNotFound
+	}
+
+	return accountTOTP, nil
+}
+
+func (a *accountTOTPDataAccessor) GetAccountTOTP(ctx context.Context, ofAccountID uint64) (AccountTOTP, error) {
+	return a.getAccountTOTP(ctx, ofAccountID, false)
+}
+
+func (a *accountTOTPDataAccessor) GetAccountTOTPWithXLock(ctx context.Context, ofAccountID uint64) (AccountTOTP, error) {
+	return a.getAccountTOTP(ctx, ofAccountID, true)
+}
+
+func (a *accountTOTPDataAccessor) DeleteAccountTOTP(ctx context.Context, ofAccountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", ofAccountID))
+
+	_, err := a.database.
+		Delete(TableNameAccountTOTP).
+		Where(goqu.C(ColNameAccountTOTPOfAccountID).Eq(ofAccountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete account totp")
+		return status.Error(codes.Internal, "failed to delete account totp")
+	}
+
+	return nil
+}
+
+func (a *accountTOTPDataAccessor) WithDatabase(database Database) AccountTOTPDataAccessor {
+	return &accountTOTPDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameAPIKey = goqu.T("api_keys")
+
+	ErrAPIKeyNotFound = status.Error(codes.NotFound, "api key not found")
+)
+
+const (
+	ColNameAPIKeyID          = "id"
+	ColNameAPIKeyOfAccountID = "of_account_id"
+	ColNameAPIKeyName        = "name"
+	ColNameAPIKeyKeyHash     = "key_hash"
+	ColNameAPIKeyKeyPrefix   = "key_prefix"
+	ColNameAPIKeyScopeList   = "scope_list"
+	ColNameAPIKeyCreatedAt   = "created_at"
+	ColNameAPIKeyLastUsedAt  = "last_used_at"
+	ColNameAPIKeyExpiresAt   = "expires_at"
+	ColNameAPIKeyRevokedAt   = "revoked_at"
+)
+
+type APIKey struct {
+	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	Name        string `db:"name"`
+	// KeyHash is the hex encoded sha256 of the key, the key itself is never stored
+	KeyHash string `db:"key_hash"`
+	// KeyPrefix is the beginning of the key, kept so users can tell their keys apart
+	KeyPrefix string `db:"key_prefix"`
+	// ScopeList holds a list of scope names
+	ScopeList  JSON       `db:"scope_list"`
+	CreatedAt  time.Time  `db:"created_at" goqu:"skipupdate"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	// ExpiresAt is nil for keys that never expire
+	ExpiresAt *time.Time `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+type APIKeyDataAccessor interface {
+	CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error)
+	UpdateAPIKey(ctx context.Context, apiKey APIKey) error
+	UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error
+	GetAPIKey(ctx context.Context, id uint64) (APIKey, error)
+	GetAPIKeyByKeyHash(ctx context.Context, keyHash string) (APIKey, error)
+	GetUnrevokedAPIKeyListByAccount(ctx context.Context, accountID uint64) ([]APIKey, error)
+	DeleteAPIKeyListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) APIKeyDataAccessor
+}
+
+type apiKeyDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAPIKeyDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a *apiKeyDataAccessor) CreateAPIKey(ctx context.Context, apiKey APIKey) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("of_account_id", apiKey.OfAccountID))
+
+	result, err := a.database.
+		Insert(TableNameAPIKey).
+		Rows(apiKey).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create api key")
+		return 0, status.Error(codes.Internal, "failed to create api key")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (a *apiKeyDataAccessor) UpdateAPIKey(ctx context.Context, apiKey APIKey) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", apiKey.ID))
+
+	_, err := a.database.
+		Update(TableNameAPIKey).
+		Set(apiKey).
+		Where(goqu.Ex{ColNameAPIKeyID: apiKey.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update api key")
+		return status.Error(codes.Internal, "failed to update api key")
+	}
+
+	return nil
+}
+
+// UpdateAPIKeyLastUsedAt only updates the last used timestamp, so it cannot overwrite a concurrent revocation
+func (a *apiKeyDataAccessor) UpdateAPIKeyLastUsedAt(ctx context.Context, id uint64, lastUsedAt time.Time) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	_, err := a.database.
+		Update(TableNameAPIKey).
+		Set(goqu.Record{ColNameAPIKeyLastUsedAt: lastUsedAt}).
+		Where(goqu.Ex{ColNameAPIKeyID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update api key last used at")
+		return status.Error(codes.Internal, "failed to update api key last used at")
+	}
+
+	return nil
+}
+
+func (a *apiKeyDataAccessor) GetAPIKey(ctx context.Context, id uint64) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("id", id))
+
+	var apiKey APIKey
+	found, err := a.database.
+		From(TableNameAPIKey).
+		Where(goqu.Ex{ColNameAPIKeyID: id}).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key")
+		return APIKey{}, status.Error(codes.Internal, "failed to get api key")
+	}
+
+	if !found {
+		logger.Warn("api key not found")
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+func (a *apiKeyDataAccessor) GetAPIKeyByKeyHash(ctx context.Context, keyHash string) (APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger)
+
+	var apiKey APIKey
+	found, err := a.database.
+		From(TableNameAPIKey).
+		Where(goqu.Ex{ColNameAPIKeyKeyHash: keyHash}).
+		ScanStructContext(ctx, &apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key by key hash")
+		return APIKey{}, status.Error(codes.Internal, "failed to get api key by key hash")
+	}
+
+	if !found {
+		return APIKey{}, ErrAPIKeyNotFound
+	}
+
+	return apiKey, nil
+}
+
+func (a *apiKeyDataAccessor) GetUnrevokedAPIKeyListByAccount(ctx context.Context, accountID uint64) ([]APIKey, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	var apiKeyList []APIKey
+	if err := a.database.
+		Select().
+		From(TableNameAPIKey).
+		Where(
+			goqu.C(ColNameAPIKeyOfAccountID).Eq(accountID),
+			goqu.C(ColNameAPIKeyRevokedAt).IsNull(),
+		).
+		Order(goqu.C(ColNameAPIKeyID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &apiKeyList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key list by account")
+		return nil, status.Error(codes.Internal, "failed to get api key list by account")
+	}
+
+	return apiKeyList, nil
+}
+
+func (a *apiKeyDataAccessor) DeleteAPIKeyListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := a.database.
+		Delete(TableNameAPIKey).
+		Where(goqu.C(ColNameAPIKeyOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete api key list by account")
+		return status.Error(codes.Internal, "failed to delete api key list by account")
+	}
+
+	return nil
+}
+
+func (a *apiKeyDataAccessor) WithDatabase(database Database) APIKeyDataAccessor {
+	return &apiKeyDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -0,0 +1,151 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameAuditLog = goqu.T("audit_logs")
+)
+
+const (
+	ColNameAuditLogID          = "id"
+	ColNameAuditLogOfAccountID = "of_account_id"
+	ColNameAuditLogAction      = "action"
+	ColNameAuditLogTargetType  = "target_type"
+	ColNameAuditLogTargetID    = "target_id"
+	ColNameAuditLogMetadata    = "metadata"
+	ColNameAuditLogCreatedAt   = "created_at"
+)
+
+type AuditLog struct {
+	ID uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	// OfAccountID is the account that performed the action
+	OfAccountID uint64    `db:"of_account_id"`
+	Action      string    `db:"action"`
+	TargetType  string    `db:"target_type"`
+	TargetID    uint64    `db:"target_id"`
+	Metadata    JSON      `db:"metadata"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// AuditLogFilter matches audit logs satisfying every set field, empty fields are ignored
+type AuditLogFilter struct {
+	OfAccountID *uint64
+	TargetType  string
+	TargetID    *uint64
+}
+
+func (f AuditLogFilter) toExpressionList() []goqu.Expression {
+	expressionList := make([]goqu.Expression, 0)
+	if f.OfAccountID != nil {
+		expressionList = append(expressionList, goqu.C(ColNameAuditLogOfAccountID).Eq(*f.OfAccountID))
+	}
+
+	if f.TargetType != "" {
+		expressionList = append(expressionList, goqu.C(ColNameAuditLogTargetType).Eq(f.TargetType))
+	}
+
+	if f.TargetID != nil {
+		expressionList = append(expressionList, goqu.C(ColNameAuditLogTargetID).Eq(*f.TargetID))
+	}
+
+	return expressionList
+}
+
+type AuditLogDataAccessor interface {
+	CreateAuditLog(ctx context.Context, auditLog AuditLog) (uint64, error)
+	// GetAuditLogListByFilter returns the most recent audit logs first
+	GetAuditLogListByFilter(ctx context.Context, filter AuditLogFilter, limit uint64, offset uint64) ([]AuditLog, uint64, error)
+	WithDatabase(database Database) AuditLogDataAccessor
+}
+
+type auditLogDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewAuditLogDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) AuditLogDataAccessor {
+	return &auditLogDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (a *auditLogDataAccessor) CreateAuditLog(ctx context.Context, auditLog AuditLog) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Any("audit_log", auditLog))
+
+	result, err := a.database.
+		Insert(TableNameAuditLog).
+		Rows(auditLog).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create audit log")
+		return 0, status.Error(codes.Internal, "failed to create audit log")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (a *auditLogDataAccessor) GetAuditLogListByFilter(
+	ctx context.Context,
+	filter AuditLogFilter,
+	limit uint64,
+	offset uint64,
+) ([]AuditLog, uint64, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.Any("filter", filter)).
+		With(zap.Uint64("limit", limit)).
+		With(zap.Uint64("offset", offset))
+
+	expressionList := filter.toExpressionList()
+
+	var auditLogList []AuditLog
+	if err := a.database.
+		Select().
+		From(TableNameAuditLog).
+		Where(expressionList...).
+		Order(goqu.C(ColNameAuditLogID).Desc()).
+		Limit(uint(limit)).
+		Offset(uint(offset)).
+		Executor().
+		ScanStructsContext(ctx, &auditLogList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get audit log list by filter")
+		return nil, 0, status.Error(codes.Internal, "failed to get audit log list by filter")
+	}
+
+	count, err := a.database.
+		From(TableNameAuditLog).
+		Where(expressionList...).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get count of audit log by filter")
+		return nil, 0, status.Error(codes.Internal, "failed to get count of audit log by filter")
+	}
+
+	return auditLogList, uint64(count), nil
+}
+
+func (a *auditLogDataAccessor) WithDatabase(database Database) AuditLogDataAccessor {
+	return &auditLogDataAccessor{
+		database: database,
+		logger:   a.logger,
+	}
+}
@@ -14,6 +14,8 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 )
 
+const databaseDialect = "mysql"
+
 type Database interface {
 	Delete(table any) *goqu.DeleteDataset
 	Dialect() string
@@ -43,7 +45,7 @@ type Database interface {
 }
 
 func InitializeGoquDB(db *sql.DB) *goqu.Database {
-	return goqu.New("mysql", db)
+	return goqu.New(databaseDialect, db)
 }
 
 func InitializeAndMigrateUpDB(database configs.Database, logger *zap.Logger) (*sql.DB, func(), error) {
@@ -0,0 +1,178 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameDeadLetterMessage = goqu.T("dead_letter_messages")
+
+	ErrDeadLetterMessageNotFound = status.Error(codes.NotFound, "dead letter message not found")
+)
+
+const (
+	ColNameDeadLetterMessageID            = "id"
+	ColNameDeadLetterMessageQueueName     = "queue_name"
+	ColNameDeadLetterMessagePayload       = "payload"
+	ColNameDeadLetterMessageHeaders       = "headers"
+	ColNameDeadLetterMessageError         = "error"
+	ColNameDeadLetterMessageDeliveryCount = "delivery_count"
+	ColNameDeadLetterMessageCreatedAt     = "created_at"
+)
+
+// DeadLetterMessage is a message of any message queue whose handler kept failing until it reached
+// mq.max_delivery_count, it is kept until an admin replays or deletes it
+type DeadLetterMessage struct {
+	ID        uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	QueueName string `db:"queue_name"`
+	Payload   []byte `db:"payload"`
+	// Headers are the headers of the original message, if the message queue supports them
+	Headers JSON `db:"headers"`
+	// Error is the error returned by the handler on the last delivery
+	Error         string    `db:"error"`
+	DeliveryCount uint32    `db:"delivery_count"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+type DeadLetterMessageDataAccessor interface {
+	CreateDeadLetterMessage(ctx context.Context, deadLetterMessage DeadLetterMessage) (uint64, error)
+	GetDeadLetterMessageWithXLock(ctx context.Context, id uint64) (DeadLetterMessage, error)
+	// GetDeadLetterMessageList returns the most recent messages first, queueName is empty to list messages of every
+	// queue
+	GetDeadLetterMessageList(ctx context.Context, queueName string, limit, offset uint64) ([]DeadLetterMessage, uint64, error)
+	DeleteDeadLetterMessage(ctx context.Context, id uint64) error
+	WithDatabase(database Database) DeadLetterMessageDataAccessor
+}
+
+type deadLetterMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDeadLetterMessageDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DeadLetterMessageDataAccessor {
+	return &deadLetterMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d *deadLetterMessageDataAccessor) CreateDeadLetterMessage(
+	ctx context.Context,
+	deadLetterMessage DeadLetterMessage,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.String("queue_name", deadLetterMessage.QueueName)).
+		With(zap.ByteString("payload", deadLetterMessage.Payload))
+
+	result, err := d.database.
+		Insert(TableNameDeadLetterMessage).
+		Rows(deadLetterMessage).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create dead letter message")
+		return 0, status.Error(codes.Internal, "failed to create dead letter message")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (d *deadLetterMessageDataAccessor) GetDeadLetterMessageWithXLock(ctx context.Context, id uint64) (DeadLetterMessage, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	var deadLetterMessage DeadLetterMessage
+	found, err := d.database.
+		From(TableNameDeadLetterMessage).
+		Where(goqu.Ex{ColNameDeadLetterMessageID: id}).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &deadLetterMessage)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get dead letter message")
+		return DeadLetterMessage{}, status.Error(codes.Internal, "failed to get dead letter message")
+	}
+
+	if !found {
+		return DeadLetterMessage{}, ErrDeadLetterMessageNotFound
+	}
+
+	return deadLetterMessage, nil
+}
+
+func (d *deadLetterMessageDataAccessor) GetDeadLetterMessageList(
+	ctx context.Context,
+	queueName string,
+	limit, offset uint64,
+) ([]DeadLetterMessage, uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.Uint64("limit", limit)).
+		With(zap.Uint64("offset", offset))
+
+	expressionList := make([]goqu.Expression, 0)
+	if queueName != "" {
+		expressionList = append(expressionList, goqu.C(ColNameDeadLetterMessageQueueName).Eq(queueName))
+	}
+
+	deadLetterMessageList := make([]DeadLetterMessage, 0)
+	if err := d.database.
+		From(TableNameDeadLetterMessage).
+		Where(expressionList...).
+		Order(goqu.C(ColNameDeadLetterMessageID).Desc()).
+		Limit(uint(limit)).
+		Offset(uint(offset)).
+		Executor().
+		ScanStructsContext(ctx, &deadLetterMessageList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get dead letter message list")
+		return nil, 0, status.Error(codes.Internal, "failed to get dead letter message list")
+	}
+
+	count, err := d.database.
+		From(TableNameDeadLetterMessage).
+		Where(expressionList...).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get count of dead letter message")
+		return nil, 0, status.Error(codes.Internal, "failed to get count of dead letter message")
+	}
+
+	return deadLetterMessageList, uint64(count), nil
+}
+
+func (d *deadLetterMessageDataAccessor) DeleteDeadLetterMessage(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	_, err := d.database.
+		Delete(TableNameDeadLetterMessage).
+		Where(goqu.Ex{ColNameDeadLetterMessageID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete dead letter message")
+		return status.Error(codes.Internal, "failed to delete dead letter message")
+	}
+
+	return nil
+}
+
+func (d *deadLetterMessageDataAccessor) WithDatabase(database Database) DeadLetterMessageDataAccessor {
+	return &deadLetterMessageDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
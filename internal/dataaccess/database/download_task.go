@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
@@ -23,6 +25,8 @@ const (
 	ColNameDownloadTaskURL            = "url"
 	ColNameDownloadTaskDownloadStatus = "download_status"
 	ColNameDownloadTaskMetadata       = "metadata"
+	ColNameDownloadTaskExpiresAt      = "expires_at"
+	ColNameDownloadTaskOfTeamID       = "of_team_id"
 )
 
 type DownloadTask struct {
@@ -32,6 +36,74 @@ type DownloadTask struct {
 	URL            string `db:"url"`
 	DownloadStatus int32  `db:"download_status"`
 	Metadata       JSON   `db:"metadata"`
+	// ExpiresAt is nil for downloads that never expire
+	ExpiresAt *time.Time `db:"expires_at"`
+	// OfTeamID is nil for download tasks only reachable by the account that created them
+	OfTeamID *uint64 `db:"of_team_id" goqu:"skipupdate"`
+}
+
+// DownloadTaskFilter matches download tasks satisfying every set field, empty fields are ignored
+type DownloadTaskFilter struct {
+	IDList      []uint64
+	OfAccountID *uint64
+	OfTeamID    *uint64
+	// AccessibleByAccountID matches download tasks of the account outside of teams, and download tasks of the teams
+	// the account is a member of
+	AccessibleByAccountID *uint64
+	// SharedWithAccountID matches download tasks shared with the account
+	SharedWithAccountID *uint64
+	DownloadStatusList  []int32
+	URLContains         string
+}
+
+func (f DownloadTaskFilter) toExpressionList() []goqu.Expression {
+	expressionList := make([]goqu.Expression, 0)
+	if len(f.IDList) > 0 {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskID).In(f.IDList))
+	}
+
+	if f.OfAccountID != nil {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskOfAccountID).Eq(*f.OfAccountID))
+	}
+
+	if f.OfTeamID != nil {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskOfTeamID).Eq(*f.OfTeamID))
+	}
+
+	if f.AccessibleByAccountID != nil {
+		// The sub query is rendered with its own dialect, not the one of the query it is embedded in
+		teamIDOfAccountQuery := goqu.Dialect(databaseDialect).
+			From(TableNameTeamMember).
+			Select(TableNameTeamMember.Col(ColNameTeamMemberOfTeamID)).
+			Where(TableNameTeamMember.Col(ColNameTeamMemberOfAccountID).Eq(*f.AccessibleByAccountID))
+		expressionList = append(expressionList, goqu.Or(
+			goqu.And(
+				TableNameDownloadTask.Col(ColNameDownloadTaskOfTeamID).IsNull(),
+				TableNameDownloadTask.Col(ColNameDownloadTaskOfAccountID).Eq(*f.AccessibleByAccountID),
+			),
+			TableNameDownloadTask.Col(ColNameDownloadTaskOfTeamID).In(teamIDOfAccountQuery),
+		))
+	}
+
+	if f.SharedWithAccountID != nil {
+		downloadTaskIDSharedWithAccountQuery := goqu.Dialect(databaseDialect).
+			From(TableNameDownloadTaskShare).
+			Select(TableNameDownloadTaskShare.Col(ColNameDownloadTaskShareOfDownloadTaskID)).
+			Where(TableNameDownloadTaskShare.Col(ColNameDownloadTaskShareGranteeAccountID).Eq(*f.SharedWithAccountID))
+		expressionList = append(expressionList,
+			TableNameDownloadTask.Col(ColNameDownloadTaskID).In(downloadTaskIDSharedWithAccountQuery))
+	}
+
+	if len(f.DownloadStatusList) > 0 {
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskDownloadStatus).In(f.DownloadStatusList))
+	}
+
+	if f.URLContains != "" {
+		escapedURLContains := strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(f.URLContains)
+		expressionList = append(expressionList, goqu.C(ColNameDownloadTaskURL).Like("%"+escapedURLContains+"%"))
+	}
+
+	return expressionList
 }
 
 type DownloadTaskDataAccessor interface {
@@ -40,7 +112,17 @@ type DownloadTaskDataAccessor interface {
 	GetDownloadTask(ctx context.Context, id uint64) (DownloadTask, error)
 	GetDownloadTaskWithXLock(ctx context.Context, id uint64) (DownloadTask, error)
 	GetDownloadTaskListByAccount(ctx context.Context, accountID uint64, limit uint64, offset uint64) ([]DownloadTask, uint64, error)
+	// GetDownloadTaskListByFilter returns every matching download task when limit is 0
+	GetDownloadTaskListByFilter(ctx context.Context, filter DownloadTaskFilter, limit uint64, offset uint64) ([]DownloadTask, uint64, error)
+	GetDownloadTaskCountByStatus(ctx context.Context) (map[int32]uint64, error)
+	GetExpiredDownloadTaskList(ctx context.Context, downloadStatusList []int32, now time.Time, limit uint64) ([]DownloadTask, error)
 	DeleteDownloadTask(ctx context.Context, id uint64) error
+	// DeleteDownloadTaskListByAccount only deletes the download tasks of the account outside of teams
+	DeleteDownloadTaskListByAccount(ctx context.Context, accountID uint64) error
+	// TransferTeamDownloadTaskList gives the download tasks an account created in a team to another account
+	TransferTeamDownloadTaskList(ctx context.Context, teamID, fromAccountID, toAccountID uint64) error
+	// DetachDownloadTaskListFromTeam gives the download tasks of a team back to the accounts that created them
+	DetachDownloadTaskListFromTeam(ctx context.Context, teamID uint64) error
 	WithDatabase(database Database) DownloadTaskDataAccessor
 }
 
@@ -158,13 +240,110 @@ func (d *downloadTaskDataAccessor) GetDownloadTaskListByAccount(
 	return downloadTaskList, uint64(count), nil
 }
 
+func (d *downloadTaskDataAccessor) GetDownloadTaskListByFilter(
+	ctx context.Context,
+	filter DownloadTaskFilter,
+	limit uint64,
+	offset uint64,
+) ([]DownloadTask, uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("filter", filter)).
+		With(zap.Uint64("limit", limit)).
+		With(zap.Uint64("offset", offset))
+
+	expressionList := filter.toExpressionList()
+
+	var downloadTaskList []DownloadTask
+	if err := d.database.
+		Select().
+		From(TableNameDownloadTask).
+		Where(expressionList...).
+		Order(goqu.C(ColNameDownloadTaskID).Asc()).
+		Limit(uint(limit)).
+		Offset(uint(offset)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task list by filter")
+		return nil, 0, status.Error(codes.Internal, "failed to get download task list by filter")
+	}
+
+	count, err := d.database.
+		From(TableNameDownloadTask).
+		Where(expressionList...).
+		CountContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get count of download task by filter")
+		return nil, 0, status.Error(codes.Internal, "failed to get count of download task by filter")
+	}
+
+	return downloadTaskList, uint64(count), nil
+}
+
+func (d *downloadTaskDataAccessor) GetDownloadTaskCountByStatus(ctx context.Context) (map[int32]uint64, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	var rowList []struct {
+		DownloadStatus int32  `db:"download_status"`
+		Count          uint64 `db:"count"`
+	}
+	if err := d.database.
+		Select(
+			goqu.C(ColNameDownloadTaskDownloadStatus),
+			goqu.COUNT(goqu.Star()).As("count"),
+		).
+		From(TableNameDownloadTask).
+		GroupBy(goqu.C(ColNameDownloadTaskDownloadStatus)).
+		Executor().
+		ScanStructsContext(ctx, &rowList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task count by status")
+		return nil, status.Error(codes.Internal, "failed to get download task count by status")
+	}
+
+	countByStatus := make(map[int32]uint64, len(rowList))
+	for _, row := range rowList {
+		countByStatus[row.DownloadStatus] = row.Count
+	}
+
+	return countByStatus, nil
+}
+
+func (d *downloadTaskDataAccessor) GetExpiredDownloadTaskList(
+	ctx context.Context,
+	downloadStatusList []int32,
+	now time.Time,
+	limit uint64,
+) ([]DownloadTask, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Int32s("download_status_list", downloadStatusList)).
+		With(zap.Time("now", now)).
+		With(zap.Uint64("limit", limit))
+
+	var downloadTaskList []DownloadTask
+	if err := d.database.
+		Select().
+		From(TableNameDownloadTask).
+		Where(
+			goqu.C(ColNameDownloadTaskDownloadStatus).In(downloadStatusList),
+			goqu.C(ColNameDownloadTaskExpiresAt).Lte(now),
+		).
+		Order(goqu.C(ColNameDownloadTaskExpiresAt).Asc()).
+		Limit(uint(limit)).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get expired download task list")
+		return nil, status.Error(codes.Internal, "failed to get expired download task list")
+	}
+
+	return downloadTaskList, nil
+}
+
 func (d *downloadTaskDataAccessor) GetDownloadTask(ctx context.Context, id uint64) (DownloadTask, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
 
 	var downloadTask DownloadTask
 	found, err := d.database.
 		From(TableNameDownloadTask).
-		Where(goqu.Ex{ColNameAccountPasswordOfAccountID: id}).
+		Where(goqu.Ex{ColNameDownloadTaskID: id}).
 		Executor().
 		ScanStructContext(ctx, &downloadTask)
 	if err != nil {
@@ -196,6 +375,68 @@ func (d *downloadTaskDataAccessor) DeleteDownloadTask(ctx context.Context, id ui
 	return nil
 }
 
+func (d *downloadTaskDataAccessor) DeleteDownloadTaskListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := d.database.
+		Delete(TableNameDownloadTask).
+		Where(
+			goqu.C(ColNameDownloadTaskOfAccountID).Eq(accountID),
+			goqu.C(ColNameDownloadTaskOfTeamID).IsNull(),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete download task list by account")
+		return status.Error(codes.Internal, "failed to delete download task list by account")
+	}
+
+	return nil
+}
+
+func (d *downloadTaskDataAccessor) TransferTeamDownloadTaskList(
+	ctx context.Context,
+	teamID, fromAccountID, toAccountID uint64,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("team_id", teamID)).
+		With(zap.Uint64("from_account_id", fromAccountID)).
+		With(zap.Uint64("to_account_id", toAccountID))
+
+	_, err := d.database.
+		Update(TableNameDownloadTask).
+		Set(goqu.Record{ColNameDownloadTaskOfAccountID: toAccountID}).
+		Where(
+			goqu.C(ColNameDownloadTaskOfTeamID).Eq(teamID),
+			goqu.C(ColNameDownloadTaskOfAccountID).Eq(fromAccountID),
+		).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to transfer team download task list")
+		return status.Error(codes.Internal, "failed to transfer team download task list")
+	}
+
+	return nil
+}
+
+func (d *downloadTaskDataAccessor) DetachDownloadTaskListFromTeam(ctx context.Context, teamID uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("team_id", teamID))
+
+	_, err := d.database.
+		Update(TableNameDownloadTask).
+		Set(goqu.Record{ColNameDownloadTaskOfTeamID: nil}).
+		Where(goqu.C(ColNameDownloadTaskOfTeamID).Eq(teamID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to detach download task list from team")
+		return status.Error(codes.Internal, "failed to detach download task list from team")
+	}
+
+	return nil
+}
+
 func (d *downloadTaskDataAccessor) WithDatabase(database Database) DownloadTaskDataAccessor {
 	return &downloadTaskDataAccessor{
 		logger:   d.logger,
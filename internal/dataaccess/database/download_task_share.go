@@ -0,0 +1,192 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameDownloadTaskShare = goqu.T("download_task_shares")
+)
+
+const (
+	ColNameDownloadTaskShareOfDownloadTaskID = "of_download_task_id"
+	ColNameDownloadTaskShareGranteeAccountID = "grantee_account_id"
+	ColNameDownloadTaskShareCreatedAt        = "created_at"
+)
+
+// DownloadTaskShare grants read access to a download task to an account other than the one that created it
+type DownloadTaskShare struct {
+	OfDownloadTaskID uint64    `db:"of_download_task_id"`
+	GranteeAccountID uint64    `db:"grantee_account_id"`
+	CreatedAt        time.Time `db:"created_at"`
+}
+
+type DownloadTaskShareDataAccessor interface {
+	// CreateDownloadTaskShareList ignores accounts the download task is already shared with
+	CreateDownloadTaskShareList(ctx context.Context, downloadTaskShareList []DownloadTaskShare) error
+	GetDownloadTaskShareListByDownloadTask(ctx context.Context, downloadTaskID uint64) ([]DownloadTaskShare, error)
+	// GetDownloadTaskShareListByGrantee only returns the shares of download tasks in downloadTaskIDList
+	GetDownloadTaskShareListByGrantee(
+		ctx context.Context,
+		granteeAccountID uint64,
+		downloadTaskIDList []uint64,
+	) ([]DownloadTaskShare, error)
+	DeleteDownloadTaskShareList(ctx context.Context, downloadTaskID uint64, granteeAccountIDList []uint64) error
+	DeleteDownloadTaskShareListByDownloadTask(ctx context.Context, downloadTaskID uint64) error
+	// DeleteDownloadTaskShareListByAccount deletes shares granted to the account and shares of download tasks
+	// created by the account
+	DeleteDownloadTaskShareListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) DownloadTaskShareDataAccessor
+}
+
+type downloadTaskShareDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewDownloadTaskShareDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) DownloadTaskShareDataAccessor {
+	return &downloadTaskShareDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (d *downloadTaskShareDataAccessor) CreateDownloadTaskShareList(
+	ctx context.Context,
+	downloadTaskShareList []DownloadTaskShare,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("download_task_share_list", downloadTaskShareList))
+
+	if len(downloadTaskShareList) == 0 {
+		return nil
+	}
+
+	_, err := d.database.
+		Insert(TableNameDownloadTaskShare).
+		Rows(downloadTaskShareList).
+		OnConflict(goqu.DoNothing()).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create download task share list")
+		return status.Error(codes.Internal, "failed to create download task share list")
+	}
+
+	return nil
+}
+
+func (d *downloadTaskShareDataAccessor) GetDownloadTaskShareListByDownloadTask(
+	ctx context.Context,
+	downloadTaskID uint64,
+) ([]DownloadTaskShare, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("download_task_id", downloadTaskID))
+
+	downloadTaskShareList := make([]DownloadTaskShare, 0)
+	if err := d.database.
+		From(TableNameDownloadTaskShare).
+		Where(goqu.C(ColNameDownloadTaskShareOfDownloadTaskID).Eq(downloadTaskID)).
+		Order(goqu.C(ColNameDownloadTaskShareCreatedAt).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskShareList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task share list by download task")
+		return nil, status.Error(codes.Internal, "failed to get download task share list by download task")
+	}
+
+	return downloadTaskShareList, nil
+}
+
+func (d *downloadTaskShareDataAccessor) GetDownloadTaskShareListByGrantee(
+	ctx context.Context,
+	granteeAccountID uint64,
+	downloadTaskIDList []uint64,
+) ([]DownloadTaskShare, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("grantee_account_id", granteeAccountID)).
+		With(zap.Uint64s("download_task_id_list", downloadTaskIDList))
+
+	downloadTaskShareList := make([]DownloadTaskShare, 0)
+	if len(downloadTaskIDList) == 0 {
+		return downloadTaskShareList, nil
+	}
+
+	if err := d.database.
+		From(TableNameDownloadTaskShare).
+		Where(
+			goqu.C(ColNameDownloadTaskShareGranteeAccountID).Eq(granteeAccountID),
+			goqu.C(ColNameDownloadTaskShareOfDownloadTaskID).In(downloadTaskIDList),
+		).
+		Executor().
+		ScanStructsContext(ctx, &downloadTaskShareList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get download task share list by grantee")
+		return nil, status.Error(codes.Internal, "failed to get download task share list by grantee")
+	}
+
+	return downloadTaskShareList, nil
+}
+
+func (d *downloadTaskShareDataAccessor) deleteDownloadTaskShareList(ctx context.Context, expression goqu.Expression) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Any("expression", expression))
+
+	_, err := d.database.
+		Delete(TableNameDownloadTaskShare).
+		Where(expression).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete download task share list")
+		return status.Error(codes.Internal, "failed to delete download task share list")
+	}
+
+	return nil
+}
+
+func (d *downloadTaskShareDataAccessor) DeleteDownloadTaskShareList(
+	ctx context.Context,
+	downloadTaskID uint64,
+	granteeAccountIDList []uint64,
+) error {
+	if len(granteeAccountIDList) == 0 {
+		return nil
+	}
+
+	return d.deleteDownloadTaskShareList(ctx, goqu.And(
+		goqu.C(ColNameDownloadTaskShareOfDownloadTaskID).Eq(downloadTaskID),
+		goqu.C(ColNameDownloadTaskShareGranteeAccountID).In(granteeAccountIDList),
+	))
+}
+
+func (d *downloadTaskShareDataAccessor) DeleteDownloadTaskShareListByDownloadTask(
+	ctx context.Context,
+	downloadTaskID uint64,
+) error {
+	return d.deleteDownloadTaskShareList(ctx, goqu.C(ColNameDownloadTaskShareOfDownloadTaskID).Eq(downloadTaskID))
+}
+
+func (d *downloadTaskShareDataAccessor) DeleteDownloadTaskShareListByAccount(ctx context.Context, accountID uint64) error {
+	downloadTaskIDOfAccountQuery := goqu.Dialect(databaseDialect).
+		From(TableNameDownloadTask).
+		Select(TableNameDownloadTask.Col(ColNameDownloadTaskID)).
+		Where(TableNameDownloadTask.Col(ColNameDownloadTaskOfAccountID).Eq(accountID))
+
+	return d.deleteDownloadTaskShareList(ctx, goqu.Or(
+		goqu.C(ColNameDownloadTaskShareGranteeAccountID).Eq(accountID),
+		goqu.C(ColNameDownloadTaskShareOfDownloadTaskID).In(downloadTaskIDOfAccountQuery),
+	))
+}
+
+func (d *downloadTaskShareDataAccessor) WithDatabase(database Database) DownloadTaskShareDataAccessor {
+	return &downloadTaskShareDataAccessor{
+		database: database,
+		logger:   d.logger,
+	}
+}
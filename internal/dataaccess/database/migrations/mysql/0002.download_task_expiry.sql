@@ -0,0 +1,13 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN download_task_retention BIGINT UNSIGNED NULL;
+
+ALTER TABLE download_tasks ADD COLUMN expires_at DATETIME NULL;
+
+CREATE INDEX download_tasks_download_status_expires_at_idx ON download_tasks (download_status, expires_at);
+
+-- +migrate Down
+DROP INDEX download_tasks_download_status_expires_at_idx ON download_tasks;
+
+ALTER TABLE download_tasks DROP COLUMN expires_at;
+
+ALTER TABLE accounts DROP COLUMN download_task_retention;
@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE accounts ADD COLUMN post_processor_list TEXT NULL;
+
+-- +migrate Down
+ALTER TABLE accounts DROP COLUMN post_processor_list;
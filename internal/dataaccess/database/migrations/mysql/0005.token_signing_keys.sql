@@ -0,0 +1,19 @@
+-- +migrate Up
+ALTER TABLE token_public_keys
+    ADD COLUMN encrypted_private_key TEXT NULL,
+    ADD COLUMN fingerprint CHAR(64) NULL,
+    ADD COLUMN created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN retire_at DATETIME NULL,
+    ADD UNIQUE (fingerprint);
+
+-- Keys created before this migration were generated per process and their private keys are lost,
+-- they are only kept long enough for the tokens they signed to expire
+UPDATE token_public_keys SET retire_at = DATE_ADD(NOW(), INTERVAL 1 DAY) WHERE retire_at IS NULL;
+
+-- +migrate Down
+ALTER TABLE token_public_keys
+    DROP INDEX fingerprint,
+    DROP COLUMN retire_at,
+    DROP COLUMN created_at,
+    DROP COLUMN fingerprint,
+    DROP COLUMN encrypted_private_key;
@@ -0,0 +1,25 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'user',
+    ADD COLUMN disabled_at DATETIME NULL;
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    of_account_id BIGINT UNSIGNED NOT NULL,
+    action VARCHAR(64) NOT NULL,
+    target_type VARCHAR(64) NOT NULL,
+    target_id BIGINT UNSIGNED NOT NULL,
+    metadata TEXT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id),
+    INDEX (of_account_id),
+    INDEX (target_type, target_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_logs;
+
+ALTER TABLE accounts
+    DROP COLUMN disabled_at,
+    DROP COLUMN role;
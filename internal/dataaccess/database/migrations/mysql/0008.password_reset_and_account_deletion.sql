@@ -0,0 +1,29 @@
+-- +migrate Up
+ALTER TABLE accounts
+    ADD COLUMN email VARCHAR(256) NULL;
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    of_account_id BIGINT UNSIGNED NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE (token_hash),
+    INDEX (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+-- Audit logs are kept after the account that performed the action is deleted
+ALTER TABLE audit_logs
+    DROP FOREIGN KEY audit_logs_ibfk_1;
+
+-- +migrate Down
+ALTER TABLE audit_logs
+    ADD FOREIGN KEY (of_account_id) REFERENCES accounts(id);
+
+DROP TABLE IF EXISTS password_reset_tokens;
+
+ALTER TABLE accounts
+    DROP COLUMN email;
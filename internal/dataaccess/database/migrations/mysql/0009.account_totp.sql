@@ -0,0 +1,25 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS account_totps (
+    of_account_id BIGINT UNSIGNED NOT NULL,
+    encrypted_secret TEXT NOT NULL,
+    created_at DATETIME NOT NULL,
+    confirmed_at DATETIME NULL,
+    last_used_step BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    PRIMARY KEY (of_account_id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+CREATE TABLE IF NOT EXISTS account_recovery_codes (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    of_account_id BIGINT UNSIGNED NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    used_at DATETIME NULL,
+    PRIMARY KEY (id),
+    UNIQUE (of_account_id, code_hash),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS account_recovery_codes;
+
+DROP TABLE IF EXISTS account_totps;
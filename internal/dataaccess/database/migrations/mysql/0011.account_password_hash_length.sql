@@ -0,0 +1,6 @@
+-- +migrate Up
+-- Hashes are stored in the phc string format, which is longer than bcrypt hashes for some algorithms
+ALTER TABLE account_passwords MODIFY hash VARCHAR(256) NOT NULL;
+
+-- +migrate Down
+ALTER TABLE account_passwords MODIFY hash VARCHAR(128) NOT NULL;
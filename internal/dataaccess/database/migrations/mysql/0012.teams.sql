@@ -0,0 +1,50 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS teams (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    name VARCHAR(256) NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS team_members (
+    of_team_id BIGINT UNSIGNED NOT NULL,
+    of_account_id BIGINT UNSIGNED NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (of_team_id, of_account_id),
+    INDEX (of_account_id),
+    FOREIGN KEY (of_team_id) REFERENCES teams(id),
+    FOREIGN KEY (of_account_id) REFERENCES accounts(id)
+);
+
+CREATE TABLE IF NOT EXISTS team_invitations (
+    id BIGINT UNSIGNED AUTO_INCREMENT,
+    of_team_id BIGINT UNSIGNED NOT NULL,
+    invitee_account_id BIGINT UNSIGNED NOT NULL,
+    role VARCHAR(16) NOT NULL,
+    created_at DATETIME NOT NULL,
+    expires_at DATETIME NOT NULL,
+    PRIMARY KEY (id),
+    UNIQUE (of_team_id, invitee_account_id),
+    INDEX (invitee_account_id),
+    FOREIGN KEY (of_team_id) REFERENCES teams(id),
+    FOREIGN KEY (invitee_account_id) REFERENCES accounts(id)
+);
+
+-- Download tasks of a team are still created by an account, of_account_id keeps track of it
+ALTER TABLE download_tasks
+    ADD COLUMN of_team_id BIGINT UNSIGNED NULL,
+    ADD FOREIGN KEY (of_team_id) REFERENCES teams(id);
+
+-- +migrate Down
+ALTER TABLE download_tasks
+    DROP FOREIGN KEY download_tasks_ibfk_2;
+
+ALTER TABLE download_tasks
+    DROP COLUMN of_team_id;
+
+DROP TABLE IF EXISTS team_invitations;
+
+DROP TABLE IF EXISTS team_members;
+
+DROP TABLE IF EXISTS teams;
@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS download_task_shares (
+    of_download_task_id BIGINT UNSIGNED NOT NULL,
+    grantee_account_id BIGINT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL,
+    PRIMARY KEY (of_download_task_id, grantee_account_id),
+    INDEX (grantee_account_id),
+    FOREIGN KEY (of_download_task_id) REFERENCES download_tasks(id),
+    FOREIGN KEY (grantee_account_id) REFERENCES accounts(id)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS download_task_shares;
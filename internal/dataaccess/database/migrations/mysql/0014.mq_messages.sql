@@ -0,0 +1,13 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS mq_messages (
+    id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    queue_name VARCHAR(256) NOT NULL,
+    payload MEDIUMBLOB NOT NULL,
+    created_at DATETIME NOT NULL,
+    available_at DATETIME NOT NULL,
+    delivery_count INT UNSIGNED NOT NULL DEFAULT 0,
+    INDEX (queue_name, available_at)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS mq_messages;
@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS dead_letter_messages (
+    id BIGINT UNSIGNED PRIMARY KEY AUTO_INCREMENT,
+    queue_name VARCHAR(256) NOT NULL,
+    payload MEDIUMBLOB NOT NULL,
+    headers JSON,
+    error TEXT NOT NULL,
+    delivery_count INT UNSIGNED NOT NULL,
+    created_at DATETIME NOT NULL,
+    INDEX (queue_name)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS dead_letter_messages;
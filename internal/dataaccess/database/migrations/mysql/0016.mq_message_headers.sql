@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE mq_messages ADD COLUMN headers JSON AFTER payload;
+
+-- +migrate Down
+ALTER TABLE mq_messages DROP COLUMN headers;
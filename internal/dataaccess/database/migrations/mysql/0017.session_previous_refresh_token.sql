@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE sessions ADD COLUMN previous_refresh_token_hash CHAR(64) NULL AFTER refresh_token_hash;
+
+CREATE INDEX sessions_previous_refresh_token_hash_idx ON sessions (previous_refresh_token_hash);
+
+-- +migrate Down
+DROP INDEX sessions_previous_refresh_token_hash_idx ON sessions;
+
+ALTER TABLE sessions DROP COLUMN previous_refresh_token_hash;
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameMQMessage = goqu.T("mq_messages")
+
+	ErrMQMessageNotFound = status.Error(codes.NotFound, "mq message not found")
+)
+
+const (
+	ColNameMQMessageID            = "id"
+	ColNameMQMessageQueueName     = "queue_name"
+	ColNameMQMessagePayload       = "payload"
+	ColNameMQMessageHeaders       = "headers"
+	ColNameMQMessageCreatedAt     = "created_at"
+	ColNameMQMessageAvailableAt   = "available_at"
+	ColNameMQMessageDeliveryCount = "delivery_count"
+)
+
+// MQMessage is a message of the database backed message queue, it is deleted once a consumer acks it
+type MQMessage struct {
+	ID        uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	QueueName string    `db:"queue_name" goqu:"skipupdate"`
+	Payload   []byte    `db:"payload" goqu:"skipupdate"`
+	Headers   JSON      `db:"headers" goqu:"skipupdate"`
+	CreatedAt time.Time `db:"created_at" goqu:"skipupdate"`
+	// AvailableAt is when the message can be delivered next, it is pushed back while a consumer is handling the
+	// message and when a consumer nacks it
+	AvailableAt   time.Time `db:"available_at"`
+	DeliveryCount uint32    `db:"delivery_count"`
+}
+
+type MQMessageDataAccessor interface {
+	CreateMQMessage(ctx context.Context, mqMessage MQMessage) (uint64, error)
+	// GetAvailableMQMessageWithXLock returns the oldest available message of the queue, skipping messages locked by
+	// other consumers so that they can claim messages concurrently
+	GetAvailableMQMessageWithXLock(ctx context.Context, queueName string, now time.Time) (MQMessage, error)
+	UpdateMQMessage(ctx context.Context, mqMessage MQMessage) error
+	DeleteMQMessage(ctx context.Context, id uint64) error
+	WithDatabase(database Database) MQMessageDataAccessor
+}
+
+type mqMessageDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewMQMessageDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) MQMessageDataAccessor {
+	return &mqMessageDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (m *mqMessageDataAccessor) CreateMQMessage(ctx context.Context, mqMessage MQMessage) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger).
+		With(zap.String("queue_name", mqMessage.QueueName)).
+		With(zap.ByteString("payload", mqMessage.Payload))
+
+	result, err := m.database.
+		Insert(TableNameMQMessage).
+		Rows(mqMessage).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create mq message")
+		return 0, status.Error(codes.Internal, "failed to create mq message")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (m *mqMessageDataAccessor) GetAvailableMQMessageWithXLock(
+	ctx context.Context,
+	queueName string,
+	now time.Time,
+) (MQMessage, error) {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.String("queue_name", queueName))
+
+	var mqMessage MQMessage
+	found, err := m.database.
+		From(TableNameMQMessage).
+		Where(
+			goqu.C(ColNameMQMessageQueueName).Eq(queueName),
+			goqu.C(ColNameMQMessageAvailableAt).Lte(now),
+		).
+		Order(goqu.C(ColNameMQMessageAvailableAt).Asc(), goqu.C(ColNameMQMessageID).Asc()).
+		Limit(1).
+		ForUpdate(goqu.SkipLocked).
+		ScanStructContext(ctx, &mqMessage)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get available mq message")
+		return MQMessage{}, status.Error(codes.Internal, "failed to get available mq message")
+	}
+
+	if !found {
+		return MQMessage{}, ErrMQMessageNotFound
+	}
+
+	return mqMessage, nil
+}
+
+func (m *mqMessageDataAccessor) UpdateMQMessage(ctx context.Context, mqMessage MQMessage) error {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.Uint64("id", mqMessage.ID))
+
+	_, err := m.database.
+		Update(TableNameMQMessage).
+		Set(mqMessage).
+		Where(goqu.Ex{ColNameMQMessageID: mqMessage.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update mq message")
+		return status.Error(codes.Internal, "failed to update mq message")
+	}
+
+	return nil
+}
+
+func (m *mqMessageDataAccessor) DeleteMQMessage(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, m.logger).With(zap.Uint64("id", id))
+
+	_, err := m.database.
+		Delete(TableNameMQMessage).
+		Where(goqu.Ex{ColNameMQMessageID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete mq message")
+		return status.Error(codes.Internal, "failed to delete mq message")
+	}
+
+	return nil
+}
+
+func (m *mqMessageDataAccessor) WithDatabase(database Database) MQMessageDataAccessor {
+	return &mqMessageDataAccessor{
+		database: database,
+		logger:   m.logger,
+	}
+}
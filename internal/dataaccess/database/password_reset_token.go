@@ -0,0 +1,154 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNamePasswordResetToken = goqu.T("password_reset_tokens")
+
+	ErrPasswordResetTokenNotFound = status.Error(codes.NotFound, "password reset token not found")
+)
+
+const (
+	ColNamePasswordResetTokenID          = "id"
+	ColNamePasswordResetTokenOfAccountID = "of_account_id"
+	ColNamePasswordResetTokenTokenHash   = "token_hash"
+	ColNamePasswordResetTokenCreatedAt   = "created_at"
+	ColNamePasswordResetTokenExpiresAt   = "expires_at"
+	ColNamePasswordResetTokenUsedAt      = "used_at"
+)
+
+type PasswordResetToken struct {
+	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	// TokenHash is the hex encoded sha256 of the token, the token itself is only sent to the account
+	TokenHash string    `db:"token_hash" goqu:"skipupdate"`
+	CreatedAt time.Time `db:"created_at" goqu:"skipupdate"`
+	ExpiresAt time.Time `db:"expires_at"`
+	// UsedAt is nil for tokens that were not used, a token can only be used once
+	UsedAt *time.Time `db:"used_at"`
+}
+
+type PasswordResetTokenDataAccessor interface {
+	CreatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) (uint64, error)
+	UpdatePasswordResetToken(ctx context.Context, passwordResetToken PasswordResetToken) error
+	GetPasswordResetTokenByTokenHashWithXLock(ctx context.Context, tokenHash string) (PasswordResetToken, error)
+	DeletePasswordResetTokenListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) PasswordResetTokenDataAccessor
+}
+
+type passwordResetTokenDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewPasswordResetTokenDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (p *passwordResetTokenDataAccessor) CreatePasswordResetToken(
+	ctx context.Context,
+	passwordResetToken PasswordResetToken,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("of_account_id", passwordResetToken.OfAccountID))
+
+	result, err := p.database.
+		Insert(TableNamePasswordResetToken).
+		Rows(passwordResetToken).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create password reset token")
+		return 0, status.Error(codes.Internal, "failed to create password reset token")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (p *passwordResetTokenDataAccessor) UpdatePasswordResetToken(
+	ctx context.Context,
+	passwordResetToken PasswordResetToken,
+) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("id", passwordResetToken.ID))
+
+	_, err := p.database.
+		Update(TableNamePasswordResetToken).
+		Set(passwordResetToken).
+		Where(goqu.Ex{ColNamePasswordResetTokenID: passwordResetToken.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update password reset token")
+		return status.Error(codes.Internal, "failed to update password reset token")
+	}
+
+	return nil
+}
+
+func (p *passwordResetTokenDataAccessor) GetPasswordResetTokenByTokenHashWithXLock(
+	ctx context.Context,
+	tokenHash string,
+) (PasswordResetToken, error) {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	var passwordResetToken PasswordResetToken
+	found, err := p.database.
+		From(TableNamePasswordResetToken).
+		Where(goqu.Ex{ColNamePasswordResetTokenTokenHash: tokenHash}).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &passwordResetToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get password reset token by token hash")
+		return PasswordResetToken{}, status.Error(codes.Internal, "failed to get password reset token by token hash")
+	}
+
+	if !found {
+		logger.Warn("password reset token not found")
+		return PasswordResetToken{}, ErrPasswordResetTokenNotFound
+	}
+
+	return passwordResetToken, nil
+}
+
+func (p *passwordResetTokenDataAccessor) DeletePasswordResetTokenListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := p.database.
+		Delete(TableNamePasswordResetToken).
+		Where(goqu.C(ColNamePasswordResetTokenOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete password reset token list by account")
+		return status.Error(codes.Internal, "failed to delete password reset token list by account")
+	}
+
+	return nil
+}
+
+func (p *passwordResetTokenDataAccessor) WithDatabase(database Database) PasswordResetTokenDataAccessor {
+	return &passwordResetTokenDataAccessor{
+		database: database,
+		logger:   p.logger,
+	}
+}
@@ -0,0 +1,209 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameSession = goqu.T("sessions")
+
+	ErrSessionNotFound = status.Error(codes.NotFound, "session not found")
+)
+
+const (
+	ColNameSessionID                       = "id"
+	ColNameSessionOfAccountID              = "of_account_id"
+	ColNameSessionRefreshTokenHash         = "refresh_token_hash"
+	ColNameSessionPreviousRefreshTokenHash = "previous_refresh_token_hash"
+	ColNameSessionUserAgent                = "user_agent"
+	ColNameSessionCreatedAt                = "created_at"
+	ColNameSessionLastRefreshedAt          = "last_refreshed_at"
+	ColNameSessionExpiresAt                = "expires_at"
+	ColNameSessionRevokedAt                = "revoked_at"
+)
+
+type Session struct {
+	ID          uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	// RefreshTokenHash is the hex encoded sha256 of the refresh token, the refresh token itself is never stored
+	RefreshTokenHash string `db:"refresh_token_hash"`
+	// PreviousRefreshTokenHash is the hash of the refresh token rotated by the last refresh, it is nil for sessions
+	// that were never refreshed
+	PreviousRefreshTokenHash *string   `db:"previous_refresh_token_hash"`
+	UserAgent                string    `db:"user_agent"`
+	CreatedAt                time.Time `db:"created_at" goqu:"skipupdate"`
+	LastRefreshedAt          time.Time `db:"last_refreshed_at"`
+	ExpiresAt                time.Time `db:"expires_at"`
+	// RevokedAt is nil for sessions that were not revoked
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+type SessionDataAccessor interface {
+	CreateSession(ctx context.Context, session Session) (uint64, error)
+	UpdateSession(ctx context.Context, session Session) error
+	GetSession(ctx context.Context, id uint64) (Session, error)
+	// GetSessionByRefreshTokenHashWithXLock also finds the session by the hash of its previous refresh token
+	GetSessionByRefreshTokenHashWithXLock(ctx context.Context, refreshTokenHash string) (Session, error)
+	GetActiveSessionListByAccount(ctx context.Context, accountID uint64, now time.Time) ([]Session, error)
+	DeleteSessionListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) SessionDataAccessor
+}
+
+type sessionDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewSessionDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (s *sessionDataAccessor) CreateSession(ctx context.Context, session Session) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("of_account_id", session.OfAccountID))
+
+	result, err := s.database.
+		Insert(TableNameSession).
+		Rows(session).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create session")
+		return 0, status.Error(codes.Internal, "failed to create session")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (s *sessionDataAccessor) UpdateSession(ctx context.Context, session Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", session.ID))
+
+	_, err := s.database.
+		Update(TableNameSession).
+		Set(session).
+		Where(goqu.Ex{ColNameSessionID: session.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update session")
+		return status.Error(codes.Internal, "failed to update session")
+	}
+
+	return nil
+}
+
+func (s *sessionDataAccessor) GetSession(ctx context.Context, id uint64) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("id", id))
+
+	var session Session
+	found, err := s.database.
+		From(TableNameSession).
+		Where(goqu.Ex{ColNameSessionID: id}).
+		ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session")
+		return Session{}, status.Error(codes.Internal, "failed to get session")
+	}
+
+	if !found {
+		logger.Warn("session not found")
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *sessionDataAccessor) GetSessionByRefreshTokenHashWithXLock(
+	ctx context.Context,
+	refreshTokenHash string,
+) (Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	var session Session
+	found, err := s.database.
+		From(TableNameSession).
+		Where(goqu.Or(
+			goqu.C(ColNameSessionRefreshTokenHash).Eq(refreshTokenHash),
+			goqu.C(ColNameSessionPreviousRefreshTokenHash).Eq(refreshTokenHash),
+		)).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &session)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get session by refresh token hash")
+		return Session{}, status.Error(codes.Internal, "failed to get session by refresh token hash")
+	}
+
+	if !found {
+		logger.Warn("session not found")
+		return Session{}, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *sessionDataAccessor) GetActiveSessionListByAccount(
+	ctx context.Context,
+	accountID uint64,
+	now time.Time,
+) ([]Session, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	var sessionList []Session
+	if err := s.database.
+		Select().
+		From(TableNameSession).
+		Where(
+			goqu.C(ColNameSessionOfAccountID).Eq(accountID),
+			goqu.C(ColNameSessionRevokedAt).IsNull(),
+			goqu.C(ColNameSessionExpiresAt).Gt(now),
+		).
+		Order(goqu.C(ColNameSessionLastRefreshedAt).Desc()).
+		Executor().
+		ScanStructsContext(ctx, &sessionList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get active session list by account")
+		return nil, status.Error(codes.Internal, "failed to get active session list by account")
+	}
+
+	return sessionList, nil
+}
+
+func (s *sessionDataAccessor) DeleteSessionListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := s.database.
+		Delete(TableNameSession).
+		Where(goqu.C(ColNameSessionOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete session list by account")
+		return status.Error(codes.Internal, "failed to delete session list by account")
+	}
+
+	return nil
+}
+
+func (s *sessionDataAccessor) WithDatabase(database Database) SessionDataAccessor {
+	return &sessionDataAccessor{
+		database: database,
+		logger:   s.logger,
+	}
+}
@@ -0,0 +1,162 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameTeam = goqu.T("teams")
+
+	ErrTeamNotFound = status.Error(codes.NotFound, "team not found")
+)
+
+const (
+	ColNameTeamID        = "id"
+	ColNameTeamName      = "name"
+	ColNameTeamCreatedAt = "created_at"
+)
+
+type Team struct {
+	ID        uint64    `db:"id" goqu:"skipinsert,skipupdate"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at" goqu:"skipupdate"`
+}
+
+type TeamDataAccessor interface {
+	CreateTeam(ctx context.Context, team Team) (uint64, error)
+	GetTeam(ctx context.Context, id uint64) (Team, error)
+	// GetTeamWithXLock is taken before changing the members of the team, so a team cannot be left without an owner
+	GetTeamWithXLock(ctx context.Context, id uint64) (Team, error)
+	GetTeamListByIDList(ctx context.Context, idList []uint64) ([]Team, error)
+	DeleteTeam(ctx context.Context, id uint64) error
+	WithDatabase(database Database) TeamDataAccessor
+}
+
+type teamDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewTeamDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) TeamDataAccessor {
+	return &teamDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (t *teamDataAccessor) CreateTeam(ctx context.Context, team Team) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("name", team.Name))
+
+	result, err := t.database.
+		Insert(TableNameTeam).
+		Rows(team).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create team")
+		return 0, status.Error(codes.Internal, "failed to create team")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (t *teamDataAccessor) GetTeam(ctx context.Context, id uint64) (Team, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("id", id))
+
+	var team Team
+	found, err := t.database.
+		From(TableNameTeam).
+		Where(goqu.Ex{ColNameTeamID: id}).
+		ScanStructContext(ctx, &team)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team")
+		return Team{}, status.Error(codes.Internal, "failed to get team")
+	}
+
+	if !found {
+		return Team{}, ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (t *teamDataAccessor) GetTeamWithXLock(ctx context.Context, id uint64) (Team, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("id", id))
+
+	var team Team
+	found, err := t.database.
+		From(TableNameTeam).
+		Where(goqu.Ex{ColNameTeamID: id}).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &team)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team with x lock")
+		return Team{}, status.Error(codes.Internal, "failed to get team with x lock")
+	}
+
+	if !found {
+		return Team{}, ErrTeamNotFound
+	}
+
+	return team, nil
+}
+
+func (t *teamDataAccessor) GetTeamListByIDList(ctx context.Context, idList []uint64) ([]Team, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64s("id_list", idList))
+
+	teamList := make([]Team, 0)
+	if len(idList) == 0 {
+		return teamList, nil
+	}
+
+	if err := t.database.
+		From(TableNameTeam).
+		Where(goqu.C(ColNameTeamID).In(idList)).
+		Order(goqu.C(ColNameTeamID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &teamList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team list by id list")
+		return nil, status.Error(codes.Internal, "failed to get team list by id list")
+	}
+
+	return teamList, nil
+}
+
+func (t *teamDataAccessor) DeleteTeam(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("id", id))
+
+	_, err := t.database.
+		Delete(TableNameTeam).
+		Where(goqu.Ex{ColNameTeamID: id}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete team")
+		return status.Error(codes.Internal, "failed to delete team")
+	}
+
+	return nil
+}
+
+func (t *teamDataAccessor) WithDatabase(database Database) TeamDataAccessor {
+	return &teamDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
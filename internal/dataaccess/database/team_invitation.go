@@ -0,0 +1,206 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameTeamInvitation = goqu.T("team_invitations")
+
+	ErrTeamInvitationNotFound = status.Error(codes.NotFound, "team invitation not found")
+)
+
+const (
+	ColNameTeamInvitationID               = "id"
+	ColNameTeamInvitationOfTeamID         = "of_team_id"
+	ColNameTeamInvitationInviteeAccountID = "invitee_account_id"
+	ColNameTeamInvitationRole             = "role"
+	ColNameTeamInvitationCreatedAt        = "created_at"
+	ColNameTeamInvitationExpiresAt        = "expires_at"
+)
+
+// TeamInvitation is deleted once it is accepted, declined or revoked
+type TeamInvitation struct {
+	ID               uint64 `db:"id" goqu:"skipinsert,skipupdate"`
+	OfTeamID         uint64 `db:"of_team_id"`
+	InviteeAccountID uint64 `db:"invitee_account_id"`
+	// Role is given to the invitee when the invitation is accepted
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+type TeamInvitationDataAccessor interface {
+	CreateTeamInvitation(ctx context.Context, teamInvitation TeamInvitation) (uint64, error)
+	GetTeamInvitation(ctx context.Context, id uint64) (TeamInvitation, error)
+	GetTeamInvitationWithXLock(ctx context.Context, id uint64) (TeamInvitation, error)
+	GetTeamInvitationByTeamAndInvitee(ctx context.Context, teamID, inviteeAccountID uint64) (TeamInvitation, error)
+	GetUnexpiredTeamInvitationListByTeam(ctx context.Context, teamID uint64, now time.Time) ([]TeamInvitation, error)
+	GetUnexpiredTeamInvitationListByInvitee(ctx context.Context, inviteeAccountID uint64, now time.Time) ([]TeamInvitation, error)
+	DeleteTeamInvitation(ctx context.Context, id uint64) error
+	DeleteTeamInvitationListByTeam(ctx context.Context, teamID uint64) error
+	DeleteTeamInvitationListByInvitee(ctx context.Context, inviteeAccountID uint64) error
+	WithDatabase(database Database) TeamInvitationDataAccessor
+}
+
+type teamInvitationDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewTeamInvitationDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) TeamInvitationDataAccessor {
+	return &teamInvitationDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (t *teamInvitationDataAccessor) CreateTeamInvitation(ctx context.Context, teamInvitation TeamInvitation) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("team_invitation", teamInvitation))
+
+	result, err := t.database.
+		Insert(TableNameTeamInvitation).
+		Rows(teamInvitation).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create team invitation")
+		return 0, status.Error(codes.Internal, "failed to create team invitation")
+	}
+
+	lastInsertedID, err := result.LastInsertId()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get last inserted id")
+		return 0, status.Error(codes.Internal, "failed to get last inserted id")
+	}
+
+	return uint64(lastInsertedID), nil
+}
+
+func (t *teamInvitationDataAccessor) getTeamInvitation(
+	ctx context.Context,
+	expression goqu.Ex,
+	withXLock bool,
+) (TeamInvitation, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("expression", expression))
+
+	query := t.database.
+		From(TableNameTeamInvitation).
+		Where(expression)
+	if withXLock {
+		query = query.ForUpdate(goqu.Wait)
+	}
+
+	var teamInvitation TeamInvitation
+	found, err := query.ScanStructContext(ctx, &teamInvitation)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team invitation")
+		return TeamInvitation{}, status.Error(codes.Internal, "failed to get team invitation")
+	}
+
+	if !found {
+		return TeamInvitation{}, ErrTeamInvitationNotFound
+	}
+
+	return teamInvitation, nil
+}
+
+func (t *teamInvitationDataAccessor) GetTeamInvitation(ctx context.Context, id uint64) (TeamInvitation, error) {
+	return t.getTeamInvitation(ctx, goqu.Ex{ColNameTeamInvitationID: id}, false)
+}
+
+func (t *teamInvitationDataAccessor) GetTeamInvitationWithXLock(ctx context.Context, id uint64) (TeamInvitation, error) {
+	return t.getTeamInvitation(ctx, goqu.Ex{ColNameTeamInvitationID: id}, true)
+}
+
+func (t *teamInvitationDataAccessor) GetTeamInvitationByTeamAndInvitee(
+	ctx context.Context,
+	teamID, inviteeAccountID uint64,
+) (TeamInvitation, error) {
+	return t.getTeamInvitation(ctx, goqu.Ex{
+		ColNameTeamInvitationOfTeamID:         teamID,
+		ColNameTeamInvitationInviteeAccountID: inviteeAccountID,
+	}, false)
+}
+
+func (t *teamInvitationDataAccessor) getUnexpiredTeamInvitationList(
+	ctx context.Context,
+	expression goqu.Ex,
+	now time.Time,
+) ([]TeamInvitation, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("expression", expression))
+
+	teamInvitationList := make([]TeamInvitation, 0)
+	if err := t.database.
+		From(TableNameTeamInvitation).
+		Where(expression, goqu.C(ColNameTeamInvitationExpiresAt).Gt(now)).
+		Order(goqu.C(ColNameTeamInvitationID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &teamInvitationList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get unexpired team invitation list")
+		return nil, status.Error(codes.Internal, "failed to get unexpired team invitation list")
+	}
+
+	return teamInvitationList, nil
+}
+
+func (t *teamInvitationDataAccessor) GetUnexpiredTeamInvitationListByTeam(
+	ctx context.Context,
+	teamID uint64,
+	now time.Time,
+) ([]TeamInvitation, error) {
+	return t.getUnexpiredTeamInvitationList(ctx, goqu.Ex{ColNameTeamInvitationOfTeamID: teamID}, now)
+}
+
+func (t *teamInvitationDataAccessor) GetUnexpiredTeamInvitationListByInvitee(
+	ctx context.Context,
+	inviteeAccountID uint64,
+	now time.Time,
+) ([]TeamInvitation, error) {
+	return t.getUnexpiredTeamInvitationList(ctx, goqu.Ex{ColNameTeamInvitationInviteeAccountID: inviteeAccountID}, now)
+}
+
+func (t *teamInvitationDataAccessor) deleteTeamInvitationList(ctx context.Context, expression goqu.Ex) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("expression", expression))
+
+	_, err := t.database.
+		Delete(TableNameTeamInvitation).
+		Where(expression).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete team invitation")
+		return status.Error(codes.Internal, "failed to delete team invitation")
+	}
+
+	return nil
+}
+
+func (t *teamInvitationDataAccessor) DeleteTeamInvitation(ctx context.Context, id uint64) error {
+	return t.deleteTeamInvitationList(ctx, goqu.Ex{ColNameTeamInvitationID: id})
+}
+
+func (t *teamInvitationDataAccessor) DeleteTeamInvitationListByTeam(ctx context.Context, teamID uint64) error {
+	return t.deleteTeamInvitationList(ctx, goqu.Ex{ColNameTeamInvitationOfTeamID: teamID})
+}
+
+func (t *teamInvitationDataAccessor) DeleteTeamInvitationListByInvitee(ctx context.Context, inviteeAccountID uint64) error {
+	return t.deleteTeamInvitationList(ctx, goqu.Ex{ColNameTeamInvitationInviteeAccountID: inviteeAccountID})
+}
+
+func (t *teamInvitationDataAccessor) WithDatabase(database Database) TeamInvitationDataAccessor {
+	return &teamInvitationDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
@@ -0,0 +1,215 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	TableNameTeamMember = goqu.T("team_members")
+
+	ErrTeamMemberNotFound = status.Error(codes.NotFound, "team member not found")
+)
+
+const (
+	ColNameTeamMemberOfTeamID    = "of_team_id"
+	ColNameTeamMemberOfAccountID = "of_account_id"
+	ColNameTeamMemberRole        = "role"
+	ColNameTeamMemberCreatedAt   = "created_at"
+)
+
+type TeamMember struct {
+	OfTeamID    uint64 `db:"of_team_id" goqu:"skipupdate"`
+	OfAccountID uint64 `db:"of_account_id" goqu:"skipupdate"`
+	// Role is one of owner, member and viewer
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at" goqu:"skipupdate"`
+}
+
+type TeamMemberDataAccessor interface {
+	CreateTeamMember(ctx context.Context, teamMember TeamMember) error
+	UpdateTeamMember(ctx context.Context, teamMember TeamMember) error
+	GetTeamMember(ctx context.Context, teamID, accountID uint64) (TeamMember, error)
+	GetTeamMemberListByTeam(ctx context.Context, teamID uint64) ([]TeamMember, error)
+	GetTeamMemberListByAccount(ctx context.Context, accountID uint64) ([]TeamMember, error)
+	DeleteTeamMember(ctx context.Context, teamID, accountID uint64) error
+	DeleteTeamMemberListByTeam(ctx context.Context, teamID uint64) error
+	DeleteTeamMemberListByAccount(ctx context.Context, accountID uint64) error
+	WithDatabase(database Database) TeamMemberDataAccessor
+}
+
+type teamMemberDataAccessor struct {
+	database Database
+	logger   *zap.Logger
+}
+
+func NewTeamMemberDataAccessor(
+	database *goqu.Database,
+	logger *zap.Logger,
+) TeamMemberDataAccessor {
+	return &teamMemberDataAccessor{
+		database: database,
+		logger:   logger,
+	}
+}
+
+func (t *teamMemberDataAccessor) CreateTeamMember(ctx context.Context, teamMember TeamMember) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("team_member", teamMember))
+
+	_, err := t.database.
+		Insert(TableNameTeamMember).
+		Rows(teamMember).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to create team member")
+		return status.Error(codes.Internal, "failed to create team member")
+	}
+
+	return nil
+}
+
+func (t *teamMemberDataAccessor) UpdateTeamMember(ctx context.Context, teamMember TeamMember) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Any("team_member", teamMember))
+
+	_, err := t.database.
+		Update(TableNameTeamMember).
+		Set(teamMember).
+		Where(goqu.Ex{
+			ColNameTeamMemberOfTeamID:    teamMember.OfTeamID,
+			ColNameTeamMemberOfAccountID: teamMember.OfAccountID,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update team member")
+		return status.Error(codes.Internal, "failed to update team member")
+	}
+
+	return nil
+}
+
+func (t *teamMemberDataAccessor) GetTeamMember(ctx context.Context, teamID, accountID uint64) (TeamMember, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).
+		With(zap.Uint64("team_id", teamID)).
+		With(zap.Uint64("account_id", accountID))
+
+	var teamMember TeamMember
+	found, err := t.database.
+		From(TableNameTeamMember).
+		Where(goqu.Ex{
+			ColNameTeamMemberOfTeamID:    teamID,
+			ColNameTeamMemberOfAccountID: accountID,
+		}).
+		ScanStructContext(ctx, &teamMember)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team member")
+		return TeamMember{}, status.Error(codes.Internal, "failed to get team member")
+	}
+
+	if !found {
+		return TeamMember{}, ErrTeamMemberNotFound
+	}
+
+	return teamMember, nil
+}
+
+func (t *teamMemberDataAccessor) GetTeamMemberListByTeam(ctx context.Context, teamID uint64) ([]TeamMember, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("team_id", teamID))
+
+	teamMemberList := make([]TeamMember, 0)
+	if err := t.database.
+		From(TableNameTeamMember).
+		Where(goqu.C(ColNameTeamMemberOfTeamID).Eq(teamID)).
+		Order(goqu.C(ColNameTeamMemberCreatedAt).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &teamMemberList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team member list by team")
+		return nil, status.Error(codes.Internal, "failed to get team member list by team")
+	}
+
+	return teamMemberList, nil
+}
+
+func (t *teamMemberDataAccessor) GetTeamMemberListByAccount(ctx context.Context, accountID uint64) ([]TeamMember, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID))
+
+	teamMemberList := make([]TeamMember, 0)
+	if err := t.database.
+		From(TableNameTeamMember).
+		Where(goqu.C(ColNameTeamMemberOfAccountID).Eq(accountID)).
+		Order(goqu.C(ColNameTeamMemberOfTeamID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &teamMemberList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get team member list by account")
+		return nil, status.Error(codes.Internal, "failed to get team member list by account")
+	}
+
+	return teamMemberList, nil
+}
+
+func (t *teamMemberDataAccessor) DeleteTeamMember(ctx context.Context, teamID, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).
+		With(zap.Uint64("team_id", teamID)).
+		With(zap.Uint64("account_id", accountID))
+
+	_, err := t.database.
+		Delete(TableNameTeamMember).
+		Where(goqu.Ex{
+			ColNameTeamMemberOfTeamID:    teamID,
+			ColNameTeamMemberOfAccountID: accountID,
+		}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete team member")
+		return status.Error(codes.Internal, "failed to delete team member")
+	}
+
+	return nil
+}
+
+func (t *teamMemberDataAccessor) DeleteTeamMemberListByTeam(ctx context.Context, teamID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("team_id", teamID))
+
+	_, err := t.database.
+		Delete(TableNameTeamMember).
+		Where(goqu.C(ColNameTeamMemberOfTeamID).Eq(teamID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete team member list by team")
+		return status.Error(codes.Internal, "failed to delete team member list by team")
+	}
+
+	return nil
+}
+
+func (t *teamMemberDataAccessor) DeleteTeamMemberListByAccount(ctx context.Context, accountID uint64) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID))
+
+	_, err := t.database.
+		Delete(TableNameTeamMember).
+		Where(goqu.C(ColNameTeamMemberOfAccountID).Eq(accountID)).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to delete team member list by account")
+		return status.Error(codes.Internal, "failed to delete team member list by account")
+	}
+
+	return nil
+}
+
+func (t *teamMemberDataAccessor) WithDatabase(database Database) TeamMemberDataAccessor {
+	return &teamMemberDataAccessor{
+		database: database,
+		logger:   t.logger,
+	}
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
@@ -16,18 +17,34 @@ var (
 )
 
 const (
-	ColNameTokenPublicKeysID        = "id"
-	ColNameTokenPublicKeysPublicKey = "public_key"
+	ColNameTokenPublicKeysID                  = "id"
+	ColNameTokenPublicKeysPublicKey           = "public_key"
+	ColNameTokenPublicKeysEncryptedPrivateKey = "encrypted_private_key"
+	ColNameTokenPublicKeysFingerprint         = "fingerprint"
+	ColNameTokenPublicKeysCreatedAt           = "created_at"
+	ColNameTokenPublicKeysRetireAt            = "retire_at"
 )
 
 type TokenPublicKey struct {
 	ID        uint64 `db:"id" goqu:"skipinsert,skipupdate"`
 	PublicKey string `db:"public_key"`
+	// EncryptedPrivateKey is only set for generated keys that can still sign tokens
+	EncryptedPrivateKey *string `db:"encrypted_private_key"`
+	// Fingerprint is the hex encoded sha256 of the public key, it is used to find keys loaded from files
+	Fingerprint *string   `db:"fingerprint"`
+	CreatedAt   time.Time `db:"created_at" goqu:"skipupdate"`
+	// RetireAt is when the key stops being accepted to verify tokens, nil for keys that are still in use
+	RetireAt *time.Time `db:"retire_at"`
 }
 
 type TokenPublicKeyDataAccessor interface {
 	CreatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) (uint64, error)
+	UpdatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) error
 	GetPublicKey(ctx context.Context, id uint64) (TokenPublicKey, error)
+	GetPublicKeyByFingerprint(ctx context.Context, fingerprint string) (TokenPublicKey, error)
+	// GetLatestSigningKeyWithXLock returns sql.ErrNoRows if no generated key can sign tokens
+	GetLatestSigningKeyWithXLock(ctx context.Context) (TokenPublicKey, error)
+	GetUnretiredPublicKeyList(ctx context.Context, now time.Time) ([]TokenPublicKey, error)
 	WithDatabase(database Database) TokenPublicKeyDataAccessor
 }
 
@@ -51,9 +68,7 @@ func (t *tokenPublicKeyDataAccessor) CreatePublicKey(ctx context.Context, tokenP
 
 	result, err := t.database.
 		Insert(TableNameTokenPublicKeys).
-		Rows(goqu.Record{
-			ColNameTokenPublicKeysPublicKey: tokenPublicKey.PublicKey,
-		}).
+		Rows(tokenPublicKey).
 		Executor().
 		ExecContext(ctx)
 	if err != nil {
@@ -70,6 +85,23 @@ func (t *tokenPublicKeyDataAccessor) CreatePublicKey(ctx context.Context, tokenP
 	return uint64(lastInsertedID), nil
 }
 
+func (t *tokenPublicKeyDataAccessor) UpdatePublicKey(ctx context.Context, tokenPublicKey TokenPublicKey) error {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("id", tokenPublicKey.ID))
+
+	_, err := t.database.
+		Update(TableNameTokenPublicKeys).
+		Set(tokenPublicKey).
+		Where(goqu.Ex{ColNameTokenPublicKeysID: tokenPublicKey.ID}).
+		Executor().
+		ExecContext(ctx)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update token public key")
+		return status.Error(codes.Internal, "failed to update token public key")
+	}
+
+	return nil
+}
+
 func (t *tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64) (TokenPublicKey, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Int("id", int(id)))
 
@@ -91,6 +123,73 @@ func (t *tokenPublicKeyDataAccessor) GetPublicKey(ctx context.Context, id uint64
 	return tokenPublicKey, nil
 }
 
+func (t *tokenPublicKeyDataAccessor) GetPublicKeyByFingerprint(ctx context.Context, fingerprint string) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.String("fingerprint", fingerprint))
+
+	tokenPublicKey := TokenPublicKey{}
+	found, err := t.database.
+		From(TableNameTokenPublicKeys).
+		Where(goqu.C(ColNameTokenPublicKeysFingerprint).Eq(fingerprint)).
+		ScanStructContext(ctx, &tokenPublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get public key by fingerprint")
+		return TokenPublicKey{}, status.Error(codes.Internal, "failed to get public key by fingerprint")
+	}
+
+	if !found {
+		return TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (t *tokenPublicKeyDataAccessor) GetLatestSigningKeyWithXLock(ctx context.Context) (TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKey := TokenPublicKey{}
+	found, err := t.database.
+		From(TableNameTokenPublicKeys).
+		Where(
+			goqu.C(ColNameTokenPublicKeysEncryptedPrivateKey).IsNotNull(),
+			goqu.C(ColNameTokenPublicKeysRetireAt).IsNull(),
+		).
+		Order(goqu.C(ColNameTokenPublicKeysID).Desc()).
+		Limit(1).
+		ForUpdate(goqu.Wait).
+		ScanStructContext(ctx, &tokenPublicKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get latest signing key")
+		return TokenPublicKey{}, status.Error(codes.Internal, "failed to get latest signing key")
+	}
+
+	if !found {
+		return TokenPublicKey{}, sql.ErrNoRows
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (t *tokenPublicKeyDataAccessor) GetUnretiredPublicKeyList(ctx context.Context, now time.Time) ([]TokenPublicKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	var tokenPublicKeyList []TokenPublicKey
+	if err := t.database.
+		Select().
+		From(TableNameTokenPublicKeys).
+		Where(goqu.Or(
+			goqu.C(ColNameTokenPublicKeysRetireAt).IsNull(),
+			goqu.C(ColNameTokenPublicKeysRetireAt).Gt(now),
+		)).
+		Order(goqu.C(ColNameTokenPublicKeysID).Asc()).
+		Executor().
+		ScanStructsContext(ctx, &tokenPublicKeyList); err != nil {
+		logger.With(zap.Error(err)).Error("failed to get unretired public key list")
+		return nil, status.Error(codes.Internal, "failed to get unretired public key list")
+	}
+
+	return tokenPublicKeyList, nil
+}
+
 func (t *tokenPublicKeyDataAccessor) WithDatabase(database Database) TokenPublicKeyDataAccessor {
 	return &tokenPublicKeyDataAccessor{
 		logger:   t.logger,
@@ -9,4 +9,17 @@ var WireSet = wire.NewSet(
 	NewAccountPasswordDataAccessor,
 	NewTokenPublicKeyAccessor,
 	NewDownloadTaskDataAccessor,
+	NewSessionDataAccessor,
+	NewAPIKeyDataAccessor,
+	NewAuditLogDataAccessor,
+	NewPasswordResetTokenDataAccessor,
+	NewAccountTOTPDataAccessor,
+	NewAccountRecoveryCodeDataAccessor,
+	NewAccountExternalIdentityDataAccessor,
+	NewTeamDataAccessor,
+	NewTeamMemberDataAccessor,
+	NewTeamInvitationDataAccessor,
+	NewDownloadTaskShareDataAccessor,
+	NewMQMessageDataAccessor,
+	NewDeadLetterMessageDataAccessor,
 )
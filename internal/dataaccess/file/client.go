@@ -21,6 +21,8 @@ import (
 type Client interface {
 	Writer(ctx context.Context, filePath string) (io.WriteCloser, error)
 	Reader(ctx context.Context, filePath string) (io.ReadCloser, error)
+	Delete(ctx context.Context, filePath string) error
+	Size(ctx context.Context, filePath string) (uint64, error)
 }
 
 func NewClient(
@@ -85,6 +87,11 @@ func (l localClient) Writer(ctx context.Context, filePath string) (io.WriteClose
 	logger := utils.LoggerWithContext(ctx, l.logger)
 
 	absolutePath := path.Join(l.downloadDirectory, filePath)
+	if err := os.MkdirAll(path.Dir(absolutePath), os.ModePerm); err != nil {
+		logger.With(zap.Error(err)).Error("failed to create file directory")
+		return nil, status.Error(codes.Internal, "failed to create file directory")
+	}
+
 	file, err := os.Create(absolutePath)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to create file")
@@ -107,58 +114,79 @@ func (l localClient) Reader(ctx context.Context, filePath string) (io.ReadCloser
 	return newBufferedFileReader(file), nil
 }
 
-// s3ClientReadWriteCloser is used in S3Client
-type s3ClientReadWriteCloser struct {
-	writtenData []byte
-	isClosed    bool
+func (l localClient) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	if err := os.Remove(absolutePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logger.With(zap.Error(err)).Error("failed to remove file")
+		return status.Error(codes.Internal, "failed to remove file")
+	}
+
+	return nil
 }
 
-func newS3ClientReadWriteCloser(
+func (l localClient) Size(ctx context.Context, filePath string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	absolutePath := path.Join(l.downloadDirectory, filePath)
+	fileInfo, err := os.Stat(absolutePath)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to stat file")
+		return 0, status.Error(codes.Internal, "failed to stat file")
+	}
+
+	return uint64(fileInfo.Size()), nil
+}
+
+// s3ClientWriteCloser is used in S3Client, written data is streamed to PutObject through a pipe
+type s3ClientWriteCloser struct {
+	pipeWriter    *io.PipeWriter
+	putObjectDone chan struct{}
+	putObjectErr  error
+}
+
+func newS3ClientWriteCloser(
 	ctx context.Context,
 	minioClient *minio.Client,
 	logger *zap.Logger,
 	bucketName,
 	objectName string,
-) io.ReadWriteCloser {
+) io.WriteCloser {
 	logger = utils.LoggerWithContext(ctx, logger)
-	readWriteCloser := &s3ClientReadWriteCloser{
-		writtenData: make([]byte, 0),
-		isClosed:    false,
+	pipeReader, pipeWriter := io.Pipe()
+	writeCloser := &s3ClientWriteCloser{
+		pipeWriter:    pipeWriter,
+		putObjectDone: make(chan struct{}),
 	}
 
 	go func() {
-		if _, err := minioClient.PutObject(ctx, bucketName, objectName, readWriteCloser, -1, minio.PutObjectOptions{}); err != nil {
+		defer close(writeCloser.putObjectDone)
+		_, err := minioClient.PutObject(ctx, bucketName, objectName, pipeReader, -1, minio.PutObjectOptions{})
+		if err != nil {
 			logger.With(zap.Error(err)).Error("failed to put object")
+			writeCloser.putObjectErr = status.Error(codes.Internal, "failed to put object")
 		}
+
+		// Unblock the writer in case PutObject returned before reading all written data
+		pipeReader.CloseWithError(err)
 	}()
 
-	return readWriteCloser
+	return writeCloser
 }
 
-func (s *s3ClientReadWriteCloser) Read(p []byte) (int, error) {
-	if len(s.writtenData) > 0 {
-		// Copy writtenData to p, so s3 object can be get from p to process PutObject
-		writtenLength := copy(p, s.writtenData)
-		// reset writtenData to empty string
-		s.writtenData = s.writtenData[writtenLength:]
-		return writtenLength, nil
-	}
-
-	if s.isClosed {
-		return 0, io.EOF
-	}
-
-	return 0, nil
+func (s *s3ClientWriteCloser) Write(p []byte) (int, error) {
+	return s.pipeWriter.Write(p)
 }
 
-func (s *s3ClientReadWriteCloser) Close() error {
-	s.isClosed = true
-	return nil
-}
+// Close waits for the object to be fully uploaded, so it can be read right after
+func (s *s3ClientWriteCloser) Close() error {
+	if err := s.pipeWriter.Close(); err != nil {
+		return err
+	}
 
-func (s *s3ClientReadWriteCloser) Write(p []byte) (int, error) {
-	s.writtenData = append(s.writtenData, p...)
-	return len(p), nil
+	<-s.putObjectDone
+	return s.putObjectErr
 }
 
 type s3Client struct {
@@ -217,5 +245,28 @@ func (s s3Client) Reader(ctx context.Context, filePath string) (io.ReadCloser, e
 }
 
 func (s s3Client) Writer(ctx context.Context, filePath string) (io.WriteCloser, error) {
-	return newS3ClientReadWriteCloser(ctx, s.minioClient, s.logger, s.bucket, filePath), nil
+	return newS3ClientWriteCloser(ctx, s.minioClient, s.logger, s.bucket, filePath), nil
+}
+
+func (s s3Client) Delete(ctx context.Context, filePath string) error {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	if err := s.minioClient.RemoveObject(ctx, s.bucket, filePath, minio.RemoveObjectOptions{}); err != nil {
+		logger.With(zap.Error(err)).Error("failed to remove s3 object")
+		return status.Error(codes.Internal, "failed to remove s3 object")
+	}
+
+	return nil
+}
+
+func (s s3Client) Size(ctx context.Context, filePath string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	objectInfo, err := s.minioClient.StatObject(ctx, s.bucket, filePath, minio.StatObjectOptions{})
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to stat s3 object")
+		return 0, status.Error(codes.Internal, "failed to stat s3 object")
+	}
+
+	return uint64(objectInfo.Size), nil
 }
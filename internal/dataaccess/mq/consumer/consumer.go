@@ -3,127 +3,73 @@ package consumer
 import (
 	"context"
 	"fmt"
-	"os"
-	"os/signal"
-	"syscall"
+	"time"
 
-	"github.com/IBM/sarama"
+	"github.com/doug-martin/goqu/v9"
 	"github.com/nhtuan0700/GoLoad/internal/configs"
-	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
 	"go.uber.org/zap"
 )
 
-type HandlerFunc func(ctx context.Context, queueName string, payload []byte) error
-
-type consumerHandler struct {
-	handlerFunc      HandlerFunc
-	exitSignalChanel chan os.Signal
-	logger           *zap.Logger
-}
-
-func newConsumerHandler(
-	handlerFunc HandlerFunc,
-	exitSignalChanel chan os.Signal,
-	logger *zap.Logger,
-) *consumerHandler {
-	return &consumerHandler{
-		handlerFunc:      handlerFunc,
-		exitSignalChanel: exitSignalChanel,
-		logger:           logger,
-	}
-}
-
-func (h consumerHandler) Setup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
-	return nil
-}
-
-func (h consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	h.logger.Info("Consumer is ready...")
-	for {
-		select {
-		case message, ok := <-claim.Messages():
-			if !ok {
-				session.Commit()
-				return nil
-			}
-			fmt.Println(message)
-			if err := h.handlerFunc(session.Context(), message.Topic, message.Value); err != nil {
-				logger := utils.LoggerWithContext(session.Context(), h.logger)
-				// Note:
-				// - Not return error to make sure no blocking when handler failed
-				// - consider to handle another way to handle the failed task such as cronjob
-				logger.With(zap.Error(err)).Error("Consumer handler failed")
-			}
-
-		case <-h.exitSignalChanel:
-			h.logger.Info("All messages committed")
-			session.Commit()
-			return nil
-		}
-	}
-}
+// HandlerFunc acks a message by returning nil and nacks it by returning an error. A nacked message is delivered again
+// after mq.retry_delay, until it has been delivered mq.max_delivery_count times, then it is moved to the dead letter
+// queue, which keeps it in the database until an admin replays or deletes it. ctx is canceled mq.drain_timeout after
+// the consumer stops. headers are the ones the message was produced with, without the ones the message queue adds
+// to retry it
+//
+// Start blocks until ctx is done and the messages being handled are drained, it returns an error if the consumer
+// cannot keep consuming
+type HandlerFunc func(ctx context.Context, queueName string, headers map[string]string, payload []byte) error
 
 type Consumer interface {
 	RegisterHandler(queueName string, handlerFunc HandlerFunc)
 	Start(ctx context.Context) error
 }
 
-type consumer struct {
-	saramaConsumer            sarama.ConsumerGroup
-	logger                    *zap.Logger
-	queueNameToHandlerFuncMap map[string]HandlerFunc
-}
-
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.ClientID = mqConfig.ClientID
-	saramaConfig.Metadata.Full = true
-	return saramaConfig
-}
-
 func NewConsumer(
 	mqConfig configs.MQ,
+	broker inmemory.Broker,
+	goquDatabase *goqu.Database,
+	mqMessageDataAccessor database.MQMessageDataAccessor,
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor,
 	logger *zap.Logger,
 ) (Consumer, error) {
-	saramaConsumer, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.ClientID, newSaramaConfig(mqConfig))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create sarama consumer: %w", err)
+	deadLetterQueue := newDeadLetterQueue(deadLetterMessageDataAccessor, logger)
+	switch mqConfig.Type {
+	case configs.MQTypeKafka, "":
+		return newKafkaConsumer(mqConfig, deadLetterQueue, logger)
+	case configs.MQTypeInMemory:
+		return newInMemoryConsumer(mqConfig, broker, deadLetterQueue, logger)
+	case configs.MQTypeDatabase:
+		return newDatabaseConsumer(mqConfig, goquDatabase, mqMessageDataAccessor, deadLetterQueue, logger)
+	case configs.MQTypeNATS:
+		return newNATSConsumer(mqConfig, deadLetterQueue, logger)
+	case configs.MQTypeRabbitMQ:
+		return newRabbitMQConsumer(mqConfig, deadLetterQueue, logger)
+	default:
+		return nil, fmt.Errorf("unsupported mq type: %s", mqConfig.Type)
 	}
-
-	return &consumer{
-		saramaConsumer:            saramaConsumer,
-		logger:                    logger,
-		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
-	}, nil
-}
-
-func (c *consumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
-	// Each queueName(Topic) will be consumed by one handler (consumer) in consumer group [clientID]
-	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
 }
 
-func (c consumer) Start(ctx context.Context) error {
-	logger := utils.LoggerWithContext(ctx, c.logger)
-
-	exitSignalChanel := make(chan os.Signal, 1)
-	signal.Notify(exitSignalChanel, syscall.SIGINT, syscall.SIGTERM)
+// newHandlerContext returns the context handlers are called with. Consumers stop taking new messages as soon as ctx
+// is done, but the handler context is only canceled drainTimeout later, so messages being handled get a chance to
+// finish
+func newHandlerContext(ctx context.Context, drainTimeout time.Duration) (context.Context, context.CancelFunc) {
+	handlerCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-handlerCtx.Done():
+			return
+		}
 
-	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
-		go func(queueName string, handlerFunc HandlerFunc) {
-			err := c.saramaConsumer.Consume(ctx, []string{queueName}, newConsumerHandler(handlerFunc, exitSignalChanel, logger))
-			if err != nil {
-				logger.With(
-					zap.String("queue_name", queueName),
-					zap.Error(err),
-				).Error("failed to consume message from queue")
-			}
-		}(queueName, handlerFunc)
-	}
+		select {
+		case <-time.After(drainTimeout):
+			cancel()
+		case <-handlerCtx.Done():
+		}
+	}()
 
-	<-exitSignalChanel
-	return nil
+	return handlerCtx, cancel
 }
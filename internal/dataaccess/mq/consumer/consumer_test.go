@@ -0,0 +1,200 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
+	"go.uber.org/zap"
+)
+
+const (
+	testMaxDeliveryCount = 3
+	testRetryDelay       = "100ms"
+	testHeaderKey        = "event_type"
+	testHeaderValue      = "test_event"
+	testPayload          = "test_payload"
+	testWaitTimeout      = 10 * time.Second
+	testNoDeliveryWait   = time.Second
+)
+
+// fakeDeadLetterMessageDataAccessor keeps dead lettered messages in memory, the methods it does not override are not
+// used by the consumers
+type fakeDeadLetterMessageDataAccessor struct {
+	database.DeadLetterMessageDataAccessor
+	lock                  sync.Mutex
+	deadLetterMessageList []database.DeadLetterMessage
+}
+
+func (f *fakeDeadLetterMessageDataAccessor) CreateDeadLetterMessage(
+	_ context.Context,
+	deadLetterMessage database.DeadLetterMessage,
+) (uint64, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.deadLetterMessageList = append(f.deadLetterMessageList, deadLetterMessage)
+	return uint64(len(f.deadLetterMessageList)), nil
+}
+
+func (f *fakeDeadLetterMessageDataAccessor) WithDatabase(_ database.Database) database.DeadLetterMessageDataAccessor {
+	return f
+}
+
+func (f *fakeDeadLetterMessageDataAccessor) getDeadLetterMessageList() []database.DeadLetterMessage {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return append([]database.DeadLetterMessage(nil), f.deadLetterMessageList...)
+}
+
+type testDelivery struct {
+	headers map[string]string
+	payload []byte
+}
+
+type testConsumerOptions struct {
+	// newConsumer returns a consumer that is not started yet
+	newConsumer func(t *testing.T, deadLetterQueue deadLetterQueue) Consumer
+	client      producer.Client
+	// newQueueName returns a queue name no other test uses, and cleans the queue up when the test ends if needed
+	newQueueName func(t *testing.T, name string) string
+}
+
+// testConsumer checks that a consumer acks, delivers again and dead letters messages the same way whatever the
+// message queue is
+func testConsumer(t *testing.T, options testConsumerOptions) {
+	t.Run("ack", func(t *testing.T) {
+		queueName := options.newQueueName(t, "ack")
+		deliveryChannel, deadLetterMessageDataAccessor := startTestConsumer(t, options, queueName, 0)
+		produceTestMessage(t, options.client, queueName)
+
+		checkTestDelivery(t, receiveTestDelivery(t, deliveryChannel))
+		expectNoTestDelivery(t, deliveryChannel)
+		if deadLetterMessageList := deadLetterMessageDataAccessor.getDeadLetterMessageList(); len(deadLetterMessageList) != 0 {
+			t.Fatalf("expected no dead letter message, got %d", len(deadLetterMessageList))
+		}
+	})
+
+	t.Run("nack with redelivery", func(t *testing.T) {
+		queueName := options.newQueueName(t, "nack")
+		deliveryChannel, deadLetterMessageDataAccessor := startTestConsumer(t, options, queueName, 1)
+		produceTestMessage(t, options.client, queueName)
+
+		checkTestDelivery(t, receiveTestDelivery(t, deliveryChannel))
+		checkTestDelivery(t, receiveTestDelivery(t, deliveryChannel))
+		expectNoTestDelivery(t, deliveryChannel)
+		if deadLetterMessageList := deadLetterMessageDataAccessor.getDeadLetterMessageList(); len(deadLetterMessageList) != 0 {
+			t.Fatalf("expected no dead letter message, got %d", len(deadLetterMessageList))
+		}
+	})
+
+	t.Run("dead letter", func(t *testing.T) {
+		queueName := options.newQueueName(t, "dead_letter")
+		deliveryChannel, deadLetterMessageDataAccessor := startTestConsumer(t, options, queueName, testMaxDeliveryCount)
+		produceTestMessage(t, options.client, queueName)
+
+		for i := 0; i < testMaxDeliveryCount; i++ {
+			checkTestDelivery(t, receiveTestDelivery(t, deliveryChannel))
+		}
+
+		expectNoTestDelivery(t, deliveryChannel)
+		deadLetterMessageList := deadLetterMessageDataAccessor.getDeadLetterMessageList()
+		if len(deadLetterMessageList) != 1 {
+			t.Fatalf("expected 1 dead letter message, got %d", len(deadLetterMessageList))
+		}
+
+		deadLetterMessage := deadLetterMessageList[0]
+		if deadLetterMessage.QueueName != queueName {
+			t.Errorf("expected dead letter message of queue %s, got %s", queueName, deadLetterMessage.QueueName)
+		}
+
+		if deadLetterMessage.DeliveryCount != testMaxDeliveryCount {
+			t.Errorf("expected dead letter message delivered %d times, got %d", testMaxDeliveryCount, deadLetterMessage.DeliveryCount)
+		}
+
+		headers, _ := deadLetterMessage.Headers.Data.(map[string]string)
+		checkTestDelivery(t, testDelivery{headers: headers, payload: deadLetterMessage.Payload})
+	})
+}
+
+// startTestConsumer starts a consumer whose handler fails the first failedDeliveryCount deliveries, every delivery
+// is sent to the returned channel
+func startTestConsumer(
+	t *testing.T,
+	options testConsumerOptions,
+	queueName string,
+	failedDeliveryCount int,
+) (<-chan testDelivery, *fakeDeadLetterMessageDataAccessor) {
+	deadLetterMessageDataAccessor := &fakeDeadLetterMessageDataAccessor{}
+	consumer := options.newConsumer(t, newDeadLetterQueue(deadLetterMessageDataAccessor, zap.NewNop()))
+
+	deliveryChannel := make(chan testDelivery, 2*testMaxDeliveryCount)
+	var deliveryCount atomic.Int32
+	consumer.RegisterHandler(queueName, func(_ context.Context, _ string, headers map[string]string, payload []byte) error {
+		deliveryChannel <- testDelivery{headers: headers, payload: payload}
+		if int(deliveryCount.Add(1)) <= failedDeliveryCount {
+			return errors.New("handler failed")
+		}
+
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errChannel := make(chan error, 1)
+	go func() {
+		errChannel <- consumer.Start(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		if err := <-errChannel; err != nil {
+			t.Errorf("consumer stopped with an error: %v", err)
+		}
+	})
+
+	return deliveryChannel, deadLetterMessageDataAccessor
+}
+
+func produceTestMessage(t *testing.T, client producer.Client, queueName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), testWaitTimeout)
+	defer cancel()
+
+	headers := map[string]string{testHeaderKey: testHeaderValue}
+	if err := client.Produce(ctx, queueName, headers, []byte(testPayload)); err != nil {
+		t.Fatalf("failed to produce message: %v", err)
+	}
+}
+
+func receiveTestDelivery(t *testing.T, deliveryChannel <-chan testDelivery) testDelivery {
+	select {
+	case delivery := <-deliveryChannel:
+		return delivery
+	case <-time.After(testWaitTimeout):
+		t.Fatal("timed out waiting for a delivery")
+		return testDelivery{}
+	}
+}
+
+func expectNoTestDelivery(t *testing.T, deliveryChannel <-chan testDelivery) {
+	select {
+	case <-deliveryChannel:
+		t.Fatal("expected no more delivery")
+	case <-time.After(testNoDeliveryWait):
+	}
+}
+
+func checkTestDelivery(t *testing.T, delivery testDelivery) {
+	if string(delivery.payload) != testPayload {
+		t.Errorf("expected payload %q, got %q", testPayload, delivery.payload)
+	}
+
+	if delivery.headers[testHeaderKey] != testHeaderValue {
+		t.Errorf("expected header %s to be %q, got %q", testHeaderKey, testHeaderValue, delivery.headers[testHeaderKey])
+	}
+}
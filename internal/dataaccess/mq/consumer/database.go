@@ -0,0 +1,189 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type databaseConsumer struct {
+	goquDatabase              *goqu.Database
+	mqMessageDataAccessor     database.MQMessageDataAccessor
+	deadLetterQueue           deadLetterQueue
+	maxDeliveryCount          int
+	retryDelay                time.Duration
+	drainTimeout              time.Duration
+	pollInterval              time.Duration
+	visibilityTimeout         time.Duration
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+func newDatabaseConsumer(
+	mqConfig configs.MQ,
+	goquDatabase *goqu.Database,
+	mqMessageDataAccessor database.MQMessageDataAccessor,
+	deadLetterQueue deadLetterQueue,
+	logger *zap.Logger,
+) (Consumer, error) {
+	retryDelay, err := mqConfig.GetRetryDelayDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq retry_delay: %w", err)
+	}
+
+	drainTimeout, err := mqConfig.GetDrainTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq drain_timeout: %w", err)
+	}
+
+	pollInterval, err := mqConfig.Database.GetPollIntervalDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq database poll_interval: %w", err)
+	}
+
+	visibilityTimeout, err := mqConfig.Database.GetVisibilityTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq database visibility_timeout: %w", err)
+	}
+
+	return &databaseConsumer{
+		goquDatabase:              goquDatabase,
+		mqMessageDataAccessor:     mqMessageDataAccessor,
+		deadLetterQueue:           deadLetterQueue,
+		maxDeliveryCount:          mqConfig.MaxDeliveryCount,
+		retryDelay:                retryDelay,
+		drainTimeout:              drainTimeout,
+		pollInterval:              pollInterval,
+		visibilityTimeout:         visibilityTimeout,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *databaseConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+// claimMessage hides the oldest available message of the queue from other consumers for visibilityTimeout. The
+// row lock is only held while claiming, so a slow handler does not keep a transaction open
+func (c databaseConsumer) claimMessage(ctx context.Context, queueName string) (database.MQMessage, error) {
+	var mqMessage database.MQMessage
+	txErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		now := time.Now()
+		var err error
+		mqMessage, err = c.mqMessageDataAccessor.WithDatabase(td).GetAvailableMQMessageWithXLock(ctx, queueName, now)
+		if err != nil {
+			return err
+		}
+
+		mqMessage.DeliveryCount++
+		mqMessage.AvailableAt = now.Add(c.visibilityTimeout)
+		return c.mqMessageDataAccessor.WithDatabase(td).UpdateMQMessage(ctx, mqMessage)
+	})
+	if txErr != nil {
+		return database.MQMessage{}, txErr
+	}
+
+	return mqMessage, nil
+}
+
+func getDatabaseHeaders(mqMessage database.MQMessage) map[string]string {
+	headers := make(map[string]string)
+	if headerMap, ok := mqMessage.Headers.Data.(map[string]any); ok {
+		for key, value := range headerMap {
+			if stringValue, ok := value.(string); ok {
+				headers[key] = stringValue
+			}
+		}
+	}
+
+	return headers
+}
+
+func (c databaseConsumer) handleMessage(ctx context.Context, handlerFunc HandlerFunc, mqMessage database.MQMessage) error {
+	headers := getDatabaseHeaders(mqMessage)
+	err := handlerFunc(ctx, mqMessage.QueueName, headers, mqMessage.Payload)
+	if err == nil {
+		return c.mqMessageDataAccessor.DeleteMQMessage(ctx, mqMessage.ID)
+	}
+
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", mqMessage.QueueName)).
+		With(zap.Uint64("id", mqMessage.ID)).
+		With(zap.Uint32("delivery_count", mqMessage.DeliveryCount)).
+		With(zap.Error(err))
+	if int(mqMessage.DeliveryCount) >= c.maxDeliveryCount {
+		// The message is moved to the dead letter queue and deleted together, so it is never lost nor duplicated
+		deadLetterErr := c.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+			if deadLetterErr := c.deadLetterQueue.withDatabase(td).deadLetter(
+				ctx, mqMessage.QueueName, mqMessage.Payload, headers, int(mqMessage.DeliveryCount), err,
+			); deadLetterErr != nil {
+				return deadLetterErr
+			}
+
+			return c.mqMessageDataAccessor.WithDatabase(td).DeleteMQMessage(ctx, mqMessage.ID)
+		})
+		if deadLetterErr == nil {
+			return nil
+		}
+
+		logger = logger.With(zap.NamedError("dead_letter_error", deadLetterErr))
+	}
+
+	logger.Warn("consumer handler failed, message will be delivered again")
+	mqMessage.AvailableAt = time.Now().Add(c.retryDelay)
+	return c.mqMessageDataAccessor.UpdateMQMessage(ctx, mqMessage)
+}
+
+// consume claims messages until ctx is done, the messages are handled with handlerCtx
+func (c databaseConsumer) consume(ctx, handlerCtx context.Context, queueName string, handlerFunc HandlerFunc) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	for ctx.Err() == nil {
+		mqMessage, err := c.claimMessage(ctx, queueName)
+		if err == nil {
+			if err = c.handleMessage(handlerCtx, handlerFunc, mqMessage); err != nil {
+				logger.With(zap.Error(err)).Error("failed to ack or nack message")
+			}
+
+			// Look for the next message right away while the queue is not empty
+			continue
+		}
+
+		if !errors.Is(err, database.ErrMQMessageNotFound) {
+			logger.With(zap.Error(err)).Error("failed to claim message from queue")
+		}
+
+		select {
+		case <-time.After(c.pollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c databaseConsumer) Start(ctx context.Context) error {
+	handlerCtx, cancelHandlers := newHandlerContext(ctx, c.drainTimeout)
+	defer cancelHandlers()
+
+	var waitGroup sync.WaitGroup
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func(queueName string, handlerFunc HandlerFunc) {
+			defer waitGroup.Done()
+			c.consume(ctx, handlerCtx, queueName, handlerFunc)
+		}(queueName, handlerFunc)
+	}
+
+	<-ctx.Done()
+	waitGroup.Wait()
+	return nil
+}
@@ -0,0 +1,66 @@
+package consumer
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+// deadLetterQueue keeps messages that reached mq.max_delivery_count in the database instead of dropping them, so
+// admins can inspect them and replay them once the cause of the failure is fixed
+type deadLetterQueue struct {
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor
+	logger                        *zap.Logger
+}
+
+func newDeadLetterQueue(
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor,
+	logger *zap.Logger,
+) deadLetterQueue {
+	return deadLetterQueue{
+		deadLetterMessageDataAccessor: deadLetterMessageDataAccessor,
+		logger:                        logger,
+	}
+}
+
+// deadLetter returns an error if the message could not be stored, the caller should then deliver it again instead
+// of dropping it
+func (d deadLetterQueue) deadLetter(
+	ctx context.Context,
+	queueName string,
+	payload []byte,
+	headers map[string]string,
+	deliveryCount int,
+	handlerErr error,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.Int("delivery_count", deliveryCount)).
+		With(zap.Error(handlerErr))
+
+	deadLetterMessage := database.DeadLetterMessage{
+		QueueName:     queueName,
+		Payload:       payload,
+		Error:         handlerErr.Error(),
+		DeliveryCount: uint32(deliveryCount),
+		CreatedAt:     time.Now(),
+	}
+	if len(headers) > 0 {
+		deadLetterMessage.Headers = database.JSON{Data: headers}
+	}
+
+	if _, err := d.deadLetterMessageDataAccessor.CreateDeadLetterMessage(ctx, deadLetterMessage); err != nil {
+		return err
+	}
+
+	logger.Error("consumer handler failed, message reached max delivery count and is dead lettered")
+	return nil
+}
+
+func (d deadLetterQueue) withDatabase(database database.Database) deadLetterQueue {
+	d.deadLetterMessageDataAccessor = d.deadLetterMessageDataAccessor.WithDatabase(database)
+	return d
+}
@@ -0,0 +1,108 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type inMemoryConsumer struct {
+	broker                    inmemory.Broker
+	deadLetterQueue           deadLetterQueue
+	maxDeliveryCount          int
+	retryDelay                time.Duration
+	drainTimeout              time.Duration
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+func newInMemoryConsumer(
+	mqConfig configs.MQ,
+	broker inmemory.Broker,
+	deadLetterQueue deadLetterQueue,
+	logger *zap.Logger,
+) (Consumer, error) {
+	retryDelay, err := mqConfig.GetRetryDelayDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq retry_delay: %w", err)
+	}
+
+	drainTimeout, err := mqConfig.GetDrainTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq drain_timeout: %w", err)
+	}
+
+	return &inMemoryConsumer{
+		broker:                    broker,
+		deadLetterQueue:           deadLetterQueue,
+		maxDeliveryCount:          mqConfig.MaxDeliveryCount,
+		retryDelay:                retryDelay,
+		drainTimeout:              drainTimeout,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *inMemoryConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c inMemoryConsumer) handleMessage(ctx context.Context, handlerFunc HandlerFunc, message inmemory.Message) {
+	message.DeliveryCount++
+	err := handlerFunc(ctx, message.QueueName, message.Headers, message.Payload)
+	if err == nil {
+		return
+	}
+
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", message.QueueName)).
+		With(zap.Int("delivery_count", message.DeliveryCount)).
+		With(zap.Error(err))
+	if message.DeliveryCount >= c.maxDeliveryCount {
+		deadLetterErr := c.deadLetterQueue.deadLetter(
+			ctx, message.QueueName, message.Payload, message.Headers, message.DeliveryCount, err)
+		if deadLetterErr == nil {
+			return
+		}
+
+		logger = logger.With(zap.NamedError("dead_letter_error", deadLetterErr))
+	}
+
+	logger.Warn("consumer handler failed, message will be delivered again")
+	time.AfterFunc(c.retryDelay, func() {
+		if publishErr := c.broker.Publish(ctx, message); publishErr != nil {
+			logger.With(zap.NamedError("publish_error", publishErr)).Error("failed to redeliver message")
+		}
+	})
+}
+
+func (c inMemoryConsumer) Start(ctx context.Context) error {
+	handlerCtx, cancelHandlers := newHandlerContext(ctx, c.drainTimeout)
+	defer cancelHandlers()
+
+	var waitGroup sync.WaitGroup
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		waitGroup.Add(1)
+		go func(messageChannel <-chan inmemory.Message, handlerFunc HandlerFunc) {
+			defer waitGroup.Done()
+			for {
+				select {
+				case message := <-messageChannel:
+					c.handleMessage(handlerCtx, handlerFunc, message)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(c.broker.Subscribe(queueName), handlerFunc)
+	}
+
+	<-ctx.Done()
+	waitGroup.Wait()
+	return nil
+}
@@ -0,0 +1,355 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// Failed messages of a topic wait in its retry topic until their retry_at header is reached
+	kafkaRetryTopicSuffix = ".retry"
+
+	kafkaHeaderKeyDeliveryCount = "delivery_count"
+	kafkaHeaderKeyRetryAt       = "retry_at"
+	kafkaHeaderKeyError         = "error"
+
+	kafkaConsumeRestartDelay = time.Second
+)
+
+func getKafkaRetryTopic(queueName string) string {
+	return queueName + kafkaRetryTopicSuffix
+}
+
+// getKafkaHeaders returns the headers of the message, without the ones added when it is retried
+func getKafkaHeaders(message *sarama.ConsumerMessage) map[string]string {
+	headers := make(map[string]string)
+	for _, header := range message.Headers {
+		switch key := string(header.Key); key {
+		case kafkaHeaderKeyDeliveryCount, kafkaHeaderKeyRetryAt, kafkaHeaderKeyError:
+		default:
+			headers[key] = string(header.Value)
+		}
+	}
+
+	return headers
+}
+
+func getKafkaHeaderInt64(message *sarama.ConsumerMessage, key string) int64 {
+	for _, header := range message.Headers {
+		if string(header.Key) == key {
+			value, err := strconv.ParseInt(string(header.Value), 10, 64)
+			if err != nil {
+				return 0
+			}
+
+			return value
+		}
+	}
+
+	return 0
+}
+
+// waitUntilRetryAt returns false if ctx is done before a message of a retry topic is due, messages of other topics
+// are due right away
+func waitUntilRetryAt(ctx context.Context, message *sarama.ConsumerMessage) bool {
+	if !strings.HasSuffix(message.Topic, kafkaRetryTopicSuffix) {
+		return true
+	}
+
+	retryAt := time.UnixMilli(getKafkaHeaderInt64(message, kafkaHeaderKeyRetryAt))
+	select {
+	case <-time.After(time.Until(retryAt)):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+type consumerHandler struct {
+	consumer *kafkaConsumer
+	logger   *zap.Logger
+}
+
+func (h consumerHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h consumerHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim hands messages to at most mq.kafka.worker_count workers, no message is read from the claim while every
+// worker is busy. A message is done once it is handled, retried or dead lettered. If it can be neither retried nor
+// dead lettered the claim stops without marking it, so it is consumed again when the session restarts
+func (h consumerHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	h.logger.Info("Consumer is ready...")
+
+	offsetTracker := newKafkaOffsetTracker(session)
+	workerSemaphore := make(chan struct{}, h.consumer.workerCount)
+	errChannel := make(chan error, 1)
+	// Handlers are not canceled as soon as the session ends, so they get a chance to finish while draining
+	handlerCtx, cancelHandlers := context.WithCancel(context.WithoutCancel(session.Context()))
+	defer cancelHandlers()
+
+	var waitGroup sync.WaitGroup
+	handleMessage := func(message *sarama.ConsumerMessage) {
+		defer waitGroup.Done()
+		defer func() { <-workerSemaphore }()
+
+		// Messages of a retry topic all wait for the same delay, so they are due in the order they are consumed
+		if !waitUntilRetryAt(session.Context(), message) {
+			return
+		}
+
+		if err := h.consumer.handleMessage(handlerCtx, message); err != nil {
+			select {
+			case errChannel <- err:
+			default:
+			}
+
+			return
+		}
+
+		offsetTracker.done(message)
+	}
+
+	err := func() error {
+		for {
+			select {
+			case workerSemaphore <- struct{}{}:
+			case <-session.Context().Done():
+				return nil
+			case err := <-errChannel:
+				return err
+			}
+
+			select {
+			case message, ok := <-claim.Messages():
+				if !ok {
+					<-workerSemaphore
+					return nil
+				}
+
+				offsetTracker.add(message)
+				waitGroup.Add(1)
+				go handleMessage(message)
+
+			case <-session.Context().Done():
+				<-workerSemaphore
+				return nil
+
+			case err := <-errChannel:
+				<-workerSemaphore
+				return err
+			}
+		}
+	}()
+
+	h.consumer.drain(&waitGroup, cancelHandlers)
+	return err
+}
+
+type kafkaConsumer struct {
+	saramaConsumer            sarama.ConsumerGroup
+	saramaSyncProducer        sarama.SyncProducer
+	deadLetterQueue           deadLetterQueue
+	maxDeliveryCount          int
+	retryDelay                time.Duration
+	workerCount               int
+	drainTimeout              time.Duration
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.ClientID = mqConfig.ClientID
+	saramaConfig.Metadata.Full = true
+	// The producer moves failed messages to retry topics
+	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	saramaConfig.Producer.Return.Successes = true
+	return saramaConfig
+}
+
+func newKafkaConsumer(
+	mqConfig configs.MQ,
+	deadLetterQueue deadLetterQueue,
+	logger *zap.Logger,
+) (Consumer, error) {
+	retryDelay, err := mqConfig.GetRetryDelayDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq retry_delay: %w", err)
+	}
+
+	drainTimeout, err := mqConfig.GetDrainTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq drain_timeout: %w", err)
+	}
+
+	workerCount := mqConfig.Kafka.WorkerCount
+	if workerCount <= 0 {
+		workerCount = 1
+	}
+
+	saramaConsumer, err := sarama.NewConsumerGroup(mqConfig.Addresses, mqConfig.ClientID, newSaramaConfig(mqConfig))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sarama consumer: %w", err)
+	}
+
+	saramaSyncProducer, err := sarama.NewSyncProducer(mqConfig.Addresses, newSaramaConfig(mqConfig))
+	if err != nil {
+		saramaConsumer.Close()
+		return nil, fmt.Errorf("failed to create sarama producer: %w", err)
+	}
+
+	return &kafkaConsumer{
+		saramaConsumer:            saramaConsumer,
+		saramaSyncProducer:        saramaSyncProducer,
+		deadLetterQueue:           deadLetterQueue,
+		maxDeliveryCount:          mqConfig.MaxDeliveryCount,
+		retryDelay:                retryDelay,
+		workerCount:               workerCount,
+		drainTimeout:              drainTimeout,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *kafkaConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	// Each queueName(Topic) will be consumed by one handler (consumer) in consumer group [clientID]
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func (c kafkaConsumer) retryMessage(message *sarama.ConsumerMessage, queueName string, deliveryCount int, handlerErr error) error {
+	headerList := make([]sarama.RecordHeader, 0, len(message.Headers)+3)
+	for key, value := range getKafkaHeaders(message) {
+		headerList = append(headerList, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+
+	headerList = append(headerList,
+		sarama.RecordHeader{
+			Key:   []byte(kafkaHeaderKeyDeliveryCount),
+			Value: []byte(strconv.Itoa(deliveryCount)),
+		},
+		sarama.RecordHeader{
+			Key:   []byte(kafkaHeaderKeyRetryAt),
+			Value: []byte(strconv.FormatInt(time.Now().Add(c.retryDelay).UnixMilli(), 10)),
+		},
+		sarama.RecordHeader{
+			Key:   []byte(kafkaHeaderKeyError),
+			Value: []byte(handlerErr.Error()),
+		},
+	)
+
+	_, _, err := c.saramaSyncProducer.SendMessage(&sarama.ProducerMessage{
+		Topic:   getKafkaRetryTopic(queueName),
+		Key:     sarama.ByteEncoder(message.Key),
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headerList,
+	})
+	return err
+}
+
+// handleMessage returns an error only if the message failed and could be neither retried nor dead lettered
+func (c kafkaConsumer) handleMessage(ctx context.Context, message *sarama.ConsumerMessage) error {
+	queueName := strings.TrimSuffix(message.Topic, kafkaRetryTopicSuffix)
+	handlerFunc, ok := c.queueNameToHandlerFuncMap[queueName]
+	if !ok {
+		return nil
+	}
+
+	err := handlerFunc(ctx, queueName, getKafkaHeaders(message), message.Value)
+	if err == nil {
+		return nil
+	}
+
+	deliveryCount := int(getKafkaHeaderInt64(message, kafkaHeaderKeyDeliveryCount)) + 1
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.Int("delivery_count", deliveryCount)).
+		With(zap.Error(err))
+	if deliveryCount >= c.maxDeliveryCount {
+		deadLetterErr := c.deadLetterQueue.deadLetter(ctx, queueName, message.Value, getKafkaHeaders(message), deliveryCount, err)
+		if deadLetterErr == nil {
+			return nil
+		}
+
+		logger = logger.With(zap.NamedError("dead_letter_error", deadLetterErr))
+	}
+
+	logger.Warn("consumer handler failed, message will be delivered again")
+	if retryErr := c.retryMessage(message, queueName, deliveryCount, err); retryErr != nil {
+		logger.With(zap.NamedError("retry_error", retryErr)).Error("failed to produce message to retry topic")
+		return retryErr
+	}
+
+	return nil
+}
+
+// drain waits for the messages being handled, their handlers are canceled if they do not finish within drainTimeout
+func (c kafkaConsumer) drain(waitGroup *sync.WaitGroup, cancelHandlers context.CancelFunc) {
+	doneChannel := make(chan struct{})
+	go func() {
+		waitGroup.Wait()
+		close(doneChannel)
+	}()
+
+	select {
+	case <-doneChannel:
+	case <-time.After(c.drainTimeout):
+		c.logger.Warn("messages are still being handled after drain timeout, canceling their handlers")
+		cancelHandlers()
+		<-doneChannel
+	}
+}
+
+func (c kafkaConsumer) Start(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, c.logger)
+	defer func() {
+		if err := c.saramaConsumer.Close(); err != nil {
+			logger.With(zap.Error(err)).Error("failed to close sarama consumer")
+		}
+
+		if err := c.saramaSyncProducer.Close(); err != nil {
+			logger.With(zap.Error(err)).Error("failed to close sarama producer")
+		}
+	}()
+
+	topicList := make([]string, 0, 2*len(c.queueNameToHandlerFuncMap))
+	for queueName := range c.queueNameToHandlerFuncMap {
+		topicList = append(topicList, queueName, getKafkaRetryTopic(queueName))
+	}
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		handler := consumerHandler{consumer: &c, logger: logger}
+		// Consume returns whenever the consumer group rebalances or a claim ends with an error, it is called again
+		// to join the next session until the consumer is stopped
+		for {
+			if err := c.saramaConsumer.Consume(ctx, topicList, handler); err != nil {
+				logger.With(zap.Error(err)).Error("failed to consume message from queue")
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(kafkaConsumeRestartDelay):
+			}
+		}
+	}()
+
+	<-ctx.Done()
+	waitGroup.Wait()
+	return nil
+}
@@ -0,0 +1,50 @@
+package consumer
+
+import (
+	"sync"
+
+	"github.com/IBM/sarama"
+)
+
+// kafkaOffsetTracker marks the messages of a claim in offset order even though they are handled concurrently, a
+// message is only marked once it and every message before it are done. This way a message still being handled is
+// never skipped when the session restarts from the committed offset
+type kafkaOffsetTracker struct {
+	session            sarama.ConsumerGroupSession
+	lock               sync.Mutex
+	pendingMessageList []*sarama.ConsumerMessage
+	doneOffsetSet      map[int64]struct{}
+}
+
+func newKafkaOffsetTracker(session sarama.ConsumerGroupSession) *kafkaOffsetTracker {
+	return &kafkaOffsetTracker{
+		session:            session,
+		pendingMessageList: make([]*sarama.ConsumerMessage, 0),
+		doneOffsetSet:      make(map[int64]struct{}),
+	}
+}
+
+// add is called in the order messages are consumed from the claim, which is the order of their offsets
+func (t *kafkaOffsetTracker) add(message *sarama.ConsumerMessage) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.pendingMessageList = append(t.pendingMessageList, message)
+}
+
+func (t *kafkaOffsetTracker) done(message *sarama.ConsumerMessage) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.doneOffsetSet[message.Offset] = struct{}{}
+	for len(t.pendingMessageList) > 0 {
+		firstMessage := t.pendingMessageList[0]
+		if _, ok := t.doneOffsetSet[firstMessage.Offset]; !ok {
+			return
+		}
+
+		t.session.MarkMessage(firstMessage, "")
+		delete(t.doneOffsetSet, firstMessage.Offset)
+		t.pendingMessageList = t.pendingMessageList[1:]
+	}
+}
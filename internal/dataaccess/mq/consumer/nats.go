@@ -0,0 +1,162 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/natsjs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type natsConsumer struct {
+	conn                      *nats.Conn
+	jetStream                 jetstream.JetStream
+	mqConfig                  configs.MQ
+	deadLetterQueue           deadLetterQueue
+	ackWait                   time.Duration
+	retryDelay                time.Duration
+	drainTimeout              time.Duration
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+func newNATSConsumer(
+	mqConfig configs.MQ,
+	deadLetterQueue deadLetterQueue,
+	logger *zap.Logger,
+) (Consumer, error) {
+	ackWait, err := mqConfig.NATS.GetAckWaitDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq nats ack_wait: %w", err)
+	}
+
+	retryDelay, err := mqConfig.GetRetryDelayDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq retry_delay: %w", err)
+	}
+
+	drainTimeout, err := mqConfig.GetDrainTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq drain_timeout: %w", err)
+	}
+
+	conn, jetStream, err := natsjs.Connect(context.Background(), mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsConsumer{
+		conn:                      conn,
+		jetStream:                 jetStream,
+		mqConfig:                  mqConfig,
+		deadLetterQueue:           deadLetterQueue,
+		ackWait:                   ackWait,
+		retryDelay:                retryDelay,
+		drainTimeout:              drainTimeout,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *natsConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+func getNATSHeaders(message jetstream.Msg) map[string]string {
+	headers := make(map[string]string)
+	for key := range message.Headers() {
+		headers[key] = message.Headers().Get(key)
+	}
+
+	return headers
+}
+
+func (c natsConsumer) handleMessage(ctx context.Context, queueName string, handlerFunc HandlerFunc, message jetstream.Msg) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	err := handlerFunc(ctx, queueName, getNATSHeaders(message), message.Data())
+	if err == nil {
+		if ackErr := message.Ack(); ackErr != nil {
+			logger.With(zap.Error(ackErr)).Error("failed to ack message")
+		}
+
+		return
+	}
+
+	metadata, metadataErr := message.Metadata()
+	if metadataErr != nil {
+		logger.With(zap.Error(metadataErr)).Error("failed to get message metadata")
+		return
+	}
+
+	logger = logger.With(zap.Uint64("delivery_count", metadata.NumDelivered)).With(zap.Error(err))
+	if int(metadata.NumDelivered) >= c.mqConfig.MaxDeliveryCount {
+		deadLetterErr := c.deadLetterQueue.deadLetter(
+			ctx, queueName, message.Data(), getNATSHeaders(message), int(metadata.NumDelivered), err,
+		)
+		if deadLetterErr == nil {
+			if termErr := message.Term(); termErr != nil {
+				logger.With(zap.NamedError("term_error", termErr)).Error("failed to terminate message")
+			}
+
+			return
+		}
+
+		logger = logger.With(zap.NamedError("dead_letter_error", deadLetterErr))
+	}
+
+	logger.Warn("consumer handler failed, message will be delivered again")
+	if nakErr := message.NakWithDelay(c.retryDelay); nakErr != nil {
+		logger.With(zap.NamedError("nak_error", nakErr)).Error("failed to nack message")
+	}
+}
+
+func (c natsConsumer) Start(ctx context.Context) error {
+	handlerCtx, cancelHandlers := newHandlerContext(ctx, c.drainTimeout)
+	defer cancelHandlers()
+
+	consumeContextList := make([]jetstream.ConsumeContext, 0, len(c.queueNameToHandlerFuncMap))
+	defer func() {
+		// Messages fetched but not handled yet are dropped by Stop, they are delivered again after ack_wait
+		for _, consumeContext := range consumeContextList {
+			consumeContext.Stop()
+		}
+
+		for _, consumeContext := range consumeContextList {
+			<-consumeContext.Closed()
+		}
+
+		if err := c.conn.Drain(); err != nil {
+			c.logger.With(zap.Error(err)).Error("failed to drain nats connection")
+		}
+	}()
+
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		jetStreamConsumer, err := c.jetStream.CreateOrUpdateConsumer(ctx, c.mqConfig.NATS.StreamName, jetstream.ConsumerConfig{
+			Durable:       natsjs.GetDurableConsumerName(c.mqConfig, queueName),
+			FilterSubject: natsjs.GetSubject(c.mqConfig, queueName),
+			AckPolicy:     jetstream.AckExplicitPolicy,
+			AckWait:       c.ackWait,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create or update jetstream consumer of queue %s: %w", queueName, err)
+		}
+
+		consumeContext, err := jetStreamConsumer.Consume(func(message jetstream.Msg) {
+			c.handleMessage(handlerCtx, queueName, handlerFunc, message)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to consume queue %s: %w", queueName, err)
+		}
+
+		consumeContextList = append(consumeContextList, consumeContext)
+	}
+
+	<-ctx.Done()
+	return nil
+}
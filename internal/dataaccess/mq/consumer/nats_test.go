@@ -0,0 +1,70 @@
+package consumer
+
+import (
+	"testing"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
+	"go.uber.org/zap"
+)
+
+// startTestNATSServer starts an in-process nats server with jetstream enabled, it is shut down when the test ends
+func startTestNATSServer(t *testing.T) string {
+	natsServer, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("failed to create nats server: %v", err)
+	}
+
+	go natsServer.Start()
+	t.Cleanup(natsServer.Shutdown)
+	if !natsServer.ReadyForConnections(testWaitTimeout) {
+		t.Fatal("nats server is not ready for connections")
+	}
+
+	return natsServer.ClientURL()
+}
+
+func TestNATSConsumer(t *testing.T) {
+	mqConfig := configs.MQ{
+		Type:             configs.MQTypeNATS,
+		ClientID:         "goload_test",
+		MaxDeliveryCount: testMaxDeliveryCount,
+		RetryDelay:       testRetryDelay,
+		DrainTimeout:     "1s",
+		NATS: configs.MQNATS{
+			Addresses:  []string{startTestNATSServer(t)},
+			StreamName: "GOLOAD_TEST",
+			// Longer than the test waits for, so messages are only delivered again because they were nacked
+			AckWait: (2 * testWaitTimeout).String(),
+		},
+	}
+
+	client, err := producer.NewClient(mqConfig, nil, nil, zap.NewNop())
+	if err != nil {
+		t.Fatalf("failed to create nats producer client: %v", err)
+	}
+
+	testConsumer(t, testConsumerOptions{
+		newConsumer: func(t *testing.T, deadLetterQueue deadLetterQueue) Consumer {
+			consumer, err := newNATSConsumer(mqConfig, deadLetterQueue, zap.NewNop())
+			if err != nil {
+				t.Fatalf("failed to create nats consumer: %v", err)
+			}
+
+			return consumer
+		},
+		client: client,
+		newQueueName: func(_ *testing.T, name string) string {
+			// The stream is dropped along with the server at the end of the test
+			return name
+		},
+	})
+}
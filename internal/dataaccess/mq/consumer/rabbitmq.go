@@ -0,0 +1,253 @@
+package consumer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/rabbitmq"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+)
+
+type rabbitMQConsumer struct {
+	connection                *amqp.Connection
+	mqConfig                  configs.MQ
+	deadLetterQueue           deadLetterQueue
+	retryDelay                time.Duration
+	drainTimeout              time.Duration
+	logger                    *zap.Logger
+	queueNameToHandlerFuncMap map[string]HandlerFunc
+}
+
+func newRabbitMQConsumer(
+	mqConfig configs.MQ,
+	deadLetterQueue deadLetterQueue,
+	logger *zap.Logger,
+) (Consumer, error) {
+	retryDelay, err := mqConfig.GetRetryDelayDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq retry_delay: %w", err)
+	}
+
+	drainTimeout, err := mqConfig.GetDrainTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mq drain_timeout: %w", err)
+	}
+
+	connection, err := amqp.Dial(mqConfig.RabbitMQ.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to rabbitmq: %w", err)
+	}
+
+	return &rabbitMQConsumer{
+		connection:                connection,
+		mqConfig:                  mqConfig,
+		deadLetterQueue:           deadLetterQueue,
+		retryDelay:                retryDelay,
+		drainTimeout:              drainTimeout,
+		logger:                    logger,
+		queueNameToHandlerFuncMap: make(map[string]HandlerFunc),
+	}, nil
+}
+
+func (c *rabbitMQConsumer) RegisterHandler(queueName string, handlerFunc HandlerFunc) {
+	c.queueNameToHandlerFuncMap[queueName] = handlerFunc
+}
+
+// retryDelivery moves the delivery to the retry queue of its queue, it is acked only after the copy is confirmed so
+// the message is never lost, at worst it is delivered twice
+func (c rabbitMQConsumer) retryDelivery(
+	ctx context.Context,
+	channel *amqp.Channel,
+	queueName string,
+	delivery amqp.Delivery,
+	deliveryCount int,
+) error {
+	headers := amqp.Table{}
+	for key, value := range delivery.Headers {
+		headers[key] = value
+	}
+	headers[rabbitmq.DeliveryCountHeaderKey] = int32(deliveryCount)
+
+	deferredConfirmation, err := channel.PublishWithDeferredConfirmWithContext(
+		ctx, "", rabbitmq.GetRetryQueueName(queueName), false, false, amqp.Publishing{
+			Headers:      headers,
+			DeliveryMode: amqp.Persistent,
+			Expiration:   strconv.FormatInt(c.retryDelay.Milliseconds(), 10),
+			Body:         delivery.Body,
+		})
+	if err != nil {
+		return err
+	}
+
+	acked, err := deferredConfirmation.WaitContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !acked {
+		return fmt.Errorf("message was not confirmed by rabbitmq")
+	}
+
+	return delivery.Ack(false)
+}
+
+func getRabbitMQHeaders(delivery amqp.Delivery) map[string]string {
+	headers := make(map[string]string)
+	for key, value := range delivery.Headers {
+		if key != rabbitmq.DeliveryCountHeaderKey {
+			headers[key] = fmt.Sprint(value)
+		}
+	}
+
+	return headers
+}
+
+func (c rabbitMQConsumer) handleDelivery(
+	ctx context.Context,
+	channel *amqp.Channel,
+	queueName string,
+	handlerFunc HandlerFunc,
+	delivery amqp.Delivery,
+) {
+	logger := utils.LoggerWithContext(ctx, c.logger).With(zap.String("queue_name", queueName))
+
+	err := handlerFunc(ctx, queueName, getRabbitMQHeaders(delivery), delivery.Body)
+	if err == nil {
+		if ackErr := delivery.Ack(false); ackErr != nil {
+			logger.With(zap.Error(ackErr)).Error("failed to ack message")
+		}
+
+		return
+	}
+
+	deliveryCount := rabbitmq.GetDeliveryCount(delivery.Headers) + 1
+	logger = logger.With(zap.Int("delivery_count", deliveryCount)).With(zap.Error(err))
+	if deliveryCount >= c.mqConfig.MaxDeliveryCount {
+		deadLetterErr := c.deadLetterQueue.deadLetter(
+			ctx, queueName, delivery.Body, getRabbitMQHeaders(delivery), deliveryCount, err,
+		)
+		if deadLetterErr == nil {
+			if ackErr := delivery.Ack(false); ackErr != nil {
+				logger.With(zap.NamedError("ack_error", ackErr)).Error("failed to ack message")
+			}
+
+			return
+		}
+
+		logger = logger.With(zap.NamedError("dead_letter_error", deadLetterErr))
+	}
+
+	logger.Warn("consumer handler failed, message will be delivered again")
+	if retryErr := c.retryDelivery(ctx, channel, queueName, delivery, deliveryCount); retryErr != nil {
+		logger.With(zap.NamedError("retry_error", retryErr)).Error("failed to move message to retry queue, requeuing it")
+		if nackErr := delivery.Nack(false, true); nackErr != nil {
+			logger.With(zap.NamedError("nack_error", nackErr)).Error("failed to nack message")
+		}
+	}
+}
+
+func (c rabbitMQConsumer) openChannel(queueName string) (*amqp.Channel, error) {
+	channel, err := c.connection.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = rabbitmq.DeclareQueue(channel, queueName); err != nil {
+		return nil, err
+	}
+
+	if err = rabbitmq.DeclareRetryQueue(channel, queueName); err != nil {
+		return nil, err
+	}
+
+	if err = channel.Qos(c.mqConfig.RabbitMQ.PrefetchCount, 0, false); err != nil {
+		return nil, err
+	}
+
+	// Publisher confirms are needed to move nacked messages to the retry queue safely
+	if err = channel.Confirm(false); err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+// Start returns an error if the connection or one of the channels is closed while consuming, so the process exits
+// instead of running without consuming anything
+func (c rabbitMQConsumer) Start(ctx context.Context) error {
+	defer c.connection.Close()
+	connectionCloseChannel := c.connection.NotifyClose(make(chan *amqp.Error, 1))
+
+	handlerCtx, cancelHandlers := newHandlerContext(ctx, c.drainTimeout)
+	defer cancelHandlers()
+
+	var waitGroup sync.WaitGroup
+	stoppedQueueNameChannel := make(chan string, len(c.queueNameToHandlerFuncMap))
+	consumerTagToChannelMap := make(map[string]*amqp.Channel)
+	defer func() {
+		// Cancelling the consumers lets the messages being handled finish before the channels are closed
+		for consumerTag, channel := range consumerTagToChannelMap {
+			if err := channel.Cancel(consumerTag, false); err != nil {
+				c.logger.With(zap.Error(err)).Error("failed to cancel rabbitmq consumer")
+			}
+		}
+
+		waitGroup.Wait()
+	}()
+
+	for queueName, handlerFunc := range c.queueNameToHandlerFuncMap {
+		channel, err := c.openChannel(queueName)
+		if err != nil {
+			return fmt.Errorf("failed to open rabbitmq channel of queue %s: %w", queueName, err)
+		}
+
+		consumerTag := c.mqConfig.ClientID + "_" + queueName
+		deliveryChannel, err := channel.Consume(queueName, consumerTag, false, false, false, false, nil)
+		if err != nil {
+			return fmt.Errorf("failed to consume queue %s: %w", queueName, err)
+		}
+
+		consumerTagToChannelMap[consumerTag] = channel
+		waitGroup.Add(1)
+		go func(queueName string, handlerFunc HandlerFunc) {
+			defer waitGroup.Done()
+			for delivery := range deliveryChannel {
+				// Deliveries prefetched after the consumer stopped are given back to the queue right away
+				if ctx.Err() != nil {
+					if err := delivery.Nack(false, true); err != nil {
+						c.logger.With(zap.Error(err)).Error("failed to requeue message")
+					}
+
+					continue
+				}
+
+				c.handleDelivery(handlerCtx, channel, queueName, handlerFunc, delivery)
+			}
+
+			// Deliveries only stop before ctx is done when the channel or the connection is closed
+			if ctx.Err() == nil {
+				stoppedQueueNameChannel <- queueName
+			}
+		}(queueName, handlerFunc)
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+
+	case closeErr := <-connectionCloseChannel:
+		// Messages being handled cannot be acked anymore, they are delivered again once reconnected
+		cancelHandlers()
+		return fmt.Errorf("rabbitmq connection closed: %v", closeErr)
+
+	case queueName := <-stoppedQueueNameChannel:
+		cancelHandlers()
+		return fmt.Errorf("rabbitmq channel of queue %s closed", queueName)
+	}
+}
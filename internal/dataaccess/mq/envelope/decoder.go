@@ -0,0 +1,73 @@
+package envelope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+)
+
+// DecodeFunc decodes the payload of one schema version of an event
+type DecodeFunc[T any] func(payload []byte) (T, error)
+
+type decodeFuncKey struct {
+	eventType     string
+	schemaVersion int
+}
+
+// Decoder dispatches payloads to the decode func registered for the event type and schema version in their
+// envelope. Schema versions are only increased for breaking changes, so a message of an unknown schema version is
+// an error, it is retried and then dead lettered until consumers that understand it are deployed
+type Decoder[T any] struct {
+	decodeFuncMap map[decodeFuncKey]DecodeFunc[T]
+	// legacyDecodeFunc decodes messages without envelope, it is nil if the event never had such messages
+	legacyDecodeFunc DecodeFunc[T]
+}
+
+func NewDecoder[T any](legacyDecodeFunc DecodeFunc[T]) *Decoder[T] {
+	return &Decoder[T]{
+		decodeFuncMap:    make(map[decodeFuncKey]DecodeFunc[T]),
+		legacyDecodeFunc: legacyDecodeFunc,
+	}
+}
+
+func (d *Decoder[T]) Register(eventType string, schemaVersion int, decodeFunc DecodeFunc[T]) *Decoder[T] {
+	d.decodeFuncMap[decodeFuncKey{eventType: eventType, schemaVersion: schemaVersion}] = decodeFunc
+	return d
+}
+
+// Decode returns ctx with the trace id of the envelope, so everything done while handling the event is part of the
+// same trace. Messages without envelope have a zero Envelope
+func (d *Decoder[T]) Decode(
+	ctx context.Context,
+	headers map[string]string,
+	payload []byte,
+) (context.Context, Envelope, T, error) {
+	var event T
+	messageEnvelope, err := FromHeaders(headers)
+	if err != nil {
+		if !errors.Is(err, ErrNoEnvelope) || d.legacyDecodeFunc == nil {
+			return ctx, Envelope{}, event, err
+		}
+
+		event, err = d.legacyDecodeFunc(payload)
+		return ctx, Envelope{}, event, err
+	}
+
+	decodeFunc, ok := d.decodeFuncMap[decodeFuncKey{
+		eventType:     messageEnvelope.EventType,
+		schemaVersion: messageEnvelope.SchemaVersion,
+	}]
+	if !ok {
+		return ctx, messageEnvelope, event, fmt.Errorf(
+			"unsupported event type %s with schema version %d", messageEnvelope.EventType, messageEnvelope.SchemaVersion)
+	}
+
+	if messageEnvelope.TraceID != "" {
+		ctx = utils.ContextWithTraceID(ctx, messageEnvelope.TraceID)
+	}
+
+	event, err = decodeFunc(payload)
+	return ctx, messageEnvelope, event, err
+}
@@ -0,0 +1,88 @@
+package envelope
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+)
+
+const (
+	HeaderKeyEventType     = "event_type"
+	HeaderKeySchemaVersion = "schema_version"
+	HeaderKeyEventID       = "event_id"
+	HeaderKeyTimestamp     = "timestamp"
+	HeaderKeyTraceID       = "trace_id"
+)
+
+// ErrNoEnvelope is returned for messages produced before envelopes were added, their payload is encoded with the
+// legacy json encoding of their event
+var ErrNoEnvelope = errors.New("message has no envelope")
+
+// Envelope describes the event in the payload of a message, it is sent in the headers of the message so the payload
+// only has to be decoded by consumers that understand its event type and schema version
+type Envelope struct {
+	EventType     string
+	SchemaVersion int
+	// EventID is unique to each event, consumers can use it to drop messages that are delivered more than once
+	EventID   string
+	Timestamp time.Time
+	TraceID   string
+}
+
+// New returns the envelope of an event produced now, it keeps the trace id of ctx or starts a new trace
+func New(ctx context.Context, eventType string, schemaVersion int) Envelope {
+	traceID := utils.TraceIDFromContext(ctx)
+	if traceID == "" {
+		traceID = uuid.NewString()
+	}
+
+	return Envelope{
+		EventType:     eventType,
+		SchemaVersion: schemaVersion,
+		EventID:       uuid.NewString(),
+		Timestamp:     time.Now(),
+		TraceID:       traceID,
+	}
+}
+
+func (e Envelope) ToHeaders() map[string]string {
+	return map[string]string{
+		HeaderKeyEventType:     e.EventType,
+		HeaderKeySchemaVersion: strconv.Itoa(e.SchemaVersion),
+		HeaderKeyEventID:       e.EventID,
+		HeaderKeyTimestamp:     e.Timestamp.UTC().Format(time.RFC3339Nano),
+		HeaderKeyTraceID:       e.TraceID,
+	}
+}
+
+// FromHeaders returns ErrNoEnvelope if the headers have no event type, other headers of the message queue are
+// ignored
+func FromHeaders(headers map[string]string) (Envelope, error) {
+	eventType, ok := headers[HeaderKeyEventType]
+	if !ok {
+		return Envelope{}, ErrNoEnvelope
+	}
+
+	schemaVersion, err := strconv.Atoi(headers[HeaderKeySchemaVersion])
+	if err != nil {
+		return Envelope{}, fmt.Errorf("invalid schema version header of event type %s: %w", eventType, err)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339Nano, headers[HeaderKeyTimestamp])
+	if err != nil {
+		return Envelope{}, fmt.Errorf("invalid timestamp header of event type %s: %w", eventType, err)
+	}
+
+	return Envelope{
+		EventType:     eventType,
+		SchemaVersion: schemaVersion,
+		EventID:       headers[HeaderKeyEventID],
+		Timestamp:     timestamp,
+		TraceID:       headers[HeaderKeyTraceID],
+	}, nil
+}
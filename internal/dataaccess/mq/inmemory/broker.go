@@ -0,0 +1,70 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+)
+
+const defaultBufferSize = 1000
+
+type Message struct {
+	QueueName string
+	Headers   map[string]string
+	Payload   []byte
+	// DeliveryCount is how many times the message has been delivered to a consumer before
+	DeliveryCount int
+}
+
+// Broker passes messages between the in memory producer and consumer through one buffered channel per queue, so
+// both of them have to share the same Broker
+type Broker interface {
+	// Publish blocks while the buffer of the queue is full
+	Publish(ctx context.Context, message Message) error
+	Subscribe(queueName string) <-chan Message
+}
+
+type broker struct {
+	bufferSize                int
+	queueNameToChannelMap     map[string]chan Message
+	queueNameToChannelMapLock sync.Mutex
+}
+
+func NewBroker(mqConfig configs.MQ) Broker {
+	bufferSize := mqConfig.InMemory.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultBufferSize
+	}
+
+	return &broker{
+		bufferSize:            bufferSize,
+		queueNameToChannelMap: make(map[string]chan Message),
+	}
+}
+
+func (b *broker) getChannel(queueName string) chan Message {
+	b.queueNameToChannelMapLock.Lock()
+	defer b.queueNameToChannelMapLock.Unlock()
+
+	channel, ok := b.queueNameToChannelMap[queueName]
+	if !ok {
+		channel = make(chan Message, b.bufferSize)
+		b.queueNameToChannelMap[queueName] = channel
+	}
+
+	return channel
+}
+
+func (b *broker) Publish(ctx context.Context, message Message) error {
+	select {
+	case b.getChannel(message.QueueName) <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *broker) Subscribe(queueName string) <-chan Message {
+	return b.getChannel(queueName)
+}
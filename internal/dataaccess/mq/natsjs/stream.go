@@ -0,0 +1,48 @@
+package natsjs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+)
+
+// Connect connects to the nats servers and makes sure the stream of mqConfig exists. The stream uses the work queue
+// retention policy, so a message is removed once a consumer acks it
+func Connect(ctx context.Context, mqConfig configs.MQ) (*nats.Conn, jetstream.JetStream, error) {
+	conn, err := nats.Connect(strings.Join(mqConfig.NATS.Addresses, ","), nats.Name(mqConfig.ClientID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	jetStream, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to create jetstream context: %w", err)
+	}
+
+	if _, err = jetStream.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:      mqConfig.NATS.StreamName,
+		Subjects:  []string{mqConfig.NATS.StreamName + ".>"},
+		Retention: jetstream.WorkQueuePolicy,
+		Storage:   jetstream.FileStorage,
+	}); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to create or update jetstream stream: %w", err)
+	}
+
+	return conn, jetStream, nil
+}
+
+func GetSubject(mqConfig configs.MQ, queueName string) string {
+	return mqConfig.NATS.StreamName + "." + queueName
+}
+
+// GetDurableConsumerName returns the name of the consumer every instance of the application shares to consume
+// the queue, so each message is only handled by one of them
+func GetDurableConsumerName(mqConfig configs.MQ, queueName string) string {
+	return mqConfig.ClientID + "_" + queueName
+}
@@ -2,64 +2,53 @@ package producer
 
 import (
 	"context"
+	"fmt"
 
-	"github.com/IBM/sarama"
 	"github.com/nhtuan0700/GoLoad/internal/configs"
-	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
 	"go.uber.org/zap"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 type Client interface {
-	Produce(ctx context.Context, queueName string, payload []byte) error
+	// Produce sends the headers along with the payload, consumers get the same headers back
+	Produce(ctx context.Context, queueName string, headers map[string]string, payload []byte) error
 }
 
-type client struct {
-	samaraSyncProducer sarama.SyncProducer
-	logger             *zap.Logger
-}
+// Every event shares the same schema versions, messages produced before envelopes were added have none and are
+// decoded as schema version 1
+const (
+	SchemaVersionJSON  = 1
+	SchemaVersionProto = 2
+)
 
-func newSaramaConfig(mqConfig configs.MQ) *sarama.Config {
-	saramaConfig := sarama.NewConfig()
-	saramaConfig.Version = sarama.MaxVersion
-	saramaConfig.Producer.Retry.Max = 1
-	saramaConfig.Producer.RequiredAcks = sarama.WaitForAll
-	saramaConfig.Producer.Return.Successes = true
-	saramaConfig.ClientID = mqConfig.ClientID
-	saramaConfig.Metadata.Full = true
+func getProducedSchemaVersion(mqConfig configs.MQ) (int, error) {
+	schemaVersion := mqConfig.GetProducedSchemaVersion()
+	if schemaVersion != SchemaVersionJSON && schemaVersion != SchemaVersionProto {
+		return 0, fmt.Errorf("unsupported mq produced_schema_version: %d", schemaVersion)
+	}
 
-	return saramaConfig
+	return schemaVersion, nil
 }
 
 func NewClient(
 	mqConfig configs.MQ,
+	broker inmemory.Broker,
+	mqMessageDataAccessor database.MQMessageDataAccessor,
 	logger *zap.Logger,
 ) (Client, error) {
-	saramaProducer, err := sarama.NewSyncProducer(mqConfig.Addresses, newSaramaConfig(mqConfig))
-	if err != nil {
-		return nil, err
+	switch mqConfig.Type {
+	case configs.MQTypeKafka, "":
+		return newKafkaClient(mqConfig, logger)
+	case configs.MQTypeInMemory:
+		return newInMemoryClient(broker, logger), nil
+	case configs.MQTypeDatabase:
+		return newDatabaseClient(mqMessageDataAccessor), nil
+	case configs.MQTypeNATS:
+		return newNATSClient(mqConfig, logger)
+	case configs.MQTypeRabbitMQ:
+		return newRabbitMQClient(mqConfig, logger)
+	default:
+		return nil, fmt.Errorf("unsupported mq type: %s", mqConfig.Type)
 	}
-
-	return &client{
-		samaraSyncProducer: saramaProducer,
-		logger:             logger,
-	}, nil
-}
-
-func (c client) Produce(ctx context.Context, queueName string, payload []byte) error {
-	logger := utils.LoggerWithContext(ctx, c.logger).
-		With(zap.String("queue_name", queueName)).
-		With(zap.ByteString("payload", payload))
-
-	_, _, err := c.samaraSyncProducer.SendMessage(&sarama.ProducerMessage{
-		Topic: queueName,
-		Value: sarama.ByteEncoder(payload),
-	})
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to produce message")
-		return status.Error(codes.Internal, "failed to produce message")
-	}
-
-	return nil
 }
@@ -0,0 +1,36 @@
+package producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+)
+
+type databaseClient struct {
+	mqMessageDataAccessor database.MQMessageDataAccessor
+}
+
+func newDatabaseClient(
+	mqMessageDataAccessor database.MQMessageDataAccessor,
+) Client {
+	return &databaseClient{
+		mqMessageDataAccessor: mqMessageDataAccessor,
+	}
+}
+
+func (c databaseClient) Produce(ctx context.Context, queueName string, headers map[string]string, payload []byte) error {
+	now := time.Now()
+	mqMessage := database.MQMessage{
+		QueueName:   queueName,
+		Payload:     payload,
+		CreatedAt:   now,
+		AvailableAt: now,
+	}
+	if len(headers) > 0 {
+		mqMessage.Headers = database.JSON{Data: headers}
+	}
+
+	_, err := c.mqMessageDataAccessor.CreateMQMessage(ctx, mqMessage)
+	return err
+}
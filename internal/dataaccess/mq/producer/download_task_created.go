@@ -4,50 +4,95 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/envelope"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
 	MessageQueueDownloadTaskCreated = "download_task_created"
 )
 
+// DownloadTaskCreated is json encoded for schema version 1, and encoded as go_load.DownloadTaskCreatedEvent for
+// schema version 2
 type DownloadTaskCreated struct {
 	ID uint64 `json:"id"`
 }
 
+// NewDownloadTaskCreatedDecoder decodes every schema version of download task created events
+func NewDownloadTaskCreatedDecoder() *envelope.Decoder[DownloadTaskCreated] {
+	decodeJSONFunc := func(payload []byte) (DownloadTaskCreated, error) {
+		var event DownloadTaskCreated
+		err := json.Unmarshal(payload, &event)
+		return event, err
+	}
+
+	return envelope.NewDecoder(decodeJSONFunc).
+		Register(MessageQueueDownloadTaskCreated, SchemaVersionJSON, decodeJSONFunc).
+		Register(MessageQueueDownloadTaskCreated, SchemaVersionProto, func(payload []byte) (DownloadTaskCreated, error) {
+			var protoEvent go_load.DownloadTaskCreatedEvent
+			if err := proto.Unmarshal(payload, &protoEvent); err != nil {
+				return DownloadTaskCreated{}, err
+			}
+
+			return DownloadTaskCreated{ID: protoEvent.GetId()}, nil
+		})
+}
+
 type DownloadTaskCreatedProducer interface {
 	Produce(ctx context.Context, event DownloadTaskCreated) error
 }
 
 type downloadTaskCreatedProducer struct {
-	client Client
-	logger *zap.Logger
+	client        Client
+	schemaVersion int
+	logger        *zap.Logger
 }
 
 func NewDownloadTaskCreatedProducer(
 	client Client,
+	mqConfig configs.MQ,
 	logger *zap.Logger,
-) DownloadTaskCreatedProducer {
+) (DownloadTaskCreatedProducer, error) {
+	schemaVersion, err := getProducedSchemaVersion(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	return &downloadTaskCreatedProducer{
-		client: client,
-		logger: logger,
+		client:        client,
+		schemaVersion: schemaVersion,
+		logger:        logger,
+	}, nil
+}
+
+func (d downloadTaskCreatedProducer) marshal(event DownloadTaskCreated) ([]byte, error) {
+	if d.schemaVersion == SchemaVersionJSON {
+		return json.Marshal(event)
 	}
+
+	return proto.Marshal(&go_load.DownloadTaskCreatedEvent{
+		Id: event.ID,
+	})
 }
 
 func (d downloadTaskCreatedProducer) Produce(ctx context.Context, event DownloadTaskCreated) error {
 	logger := utils.LoggerWithContext(ctx, d.logger).
 		With(zap.Any("event", event))
 
-	eventBytes, err := json.Marshal(event)
+	eventBytes, err := d.marshal(event)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to marshal download task created event")
 		return status.Error(codes.Internal, "failed to marshal download task created event")
 	}
 
-	err = d.client.Produce(ctx, MessageQueueDownloadTaskCreated, eventBytes)
+	messageEnvelope := envelope.New(ctx, MessageQueueDownloadTaskCreated, d.schemaVersion)
+	err = d.client.Produce(ctx, MessageQueueDownloadTaskCreated, messageEnvelope.ToHeaders(), eventBytes)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to produce download task created event")
 		return status.Error(codes.Internal, "failed to produce download task created event")
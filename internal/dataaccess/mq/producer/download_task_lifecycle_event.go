@@ -0,0 +1,190 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/envelope"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Every type of lifecycle event is produced to the queue of the same name, so subscribers only register handlers for
+// the transitions they are interested in
+const (
+	MessageQueueDownloadTaskStarted                  = "download_task_started"
+	MessageQueueDownloadTaskProgressMilestoneReached = "download_task_progress_milestone_reached"
+	MessageQueueDownloadTaskSucceeded                = "download_task_succeeded"
+	MessageQueueDownloadTaskQuarantined              = "download_task_quarantined"
+	MessageQueueDownloadTaskFailed                   = "download_task_failed"
+	MessageQueueDownloadTaskCanceled                 = "download_task_canceled"
+	MessageQueueDownloadTaskRetried                  = "download_task_retried"
+	// MessageQueueDownloadTaskRequeued is produced when a worker stops before finishing a download, which is started
+	// again from the beginning by the next worker
+	MessageQueueDownloadTaskRequeued = "download_task_requeued"
+	MessageQueueDownloadTaskExpired  = "download_task_expired"
+	MessageQueueDownloadTaskDeleted  = "download_task_deleted"
+)
+
+var downloadTaskLifecycleEventTypeList = []string{
+	MessageQueueDownloadTaskStarted,
+	MessageQueueDownloadTaskProgressMilestoneReached,
+	MessageQueueDownloadTaskSucceeded,
+	MessageQueueDownloadTaskQuarantined,
+	MessageQueueDownloadTaskFailed,
+	MessageQueueDownloadTaskCanceled,
+	MessageQueueDownloadTaskRetried,
+	MessageQueueDownloadTaskRequeued,
+	MessageQueueDownloadTaskExpired,
+	MessageQueueDownloadTaskDeleted,
+}
+
+// DownloadTaskLifecycleEvent is json encoded for schema version 1, and encoded as go_load.DownloadTaskLifecycleEvent
+// for schema version 2. Schema versions are only increased for changes subscribers cannot ignore, such as removing or
+// changing the meaning of a field; adding fields keeps the version
+type DownloadTaskLifecycleEvent struct {
+	// Type is the name of the queue the event is produced to
+	Type        string  `json:"type"`
+	ID          uint64  `json:"id"`
+	OfAccountID uint64  `json:"of_account_id"`
+	OfTeamID    *uint64 `json:"of_team_id,omitempty"`
+	// DownloadStatus is the name of the download status of the task after the transition, such as
+	// DOWNLOAD_STATUS_SUCCESS
+	DownloadStatus string `json:"download_status"`
+	// ProgressPercent is only set for progress milestone events
+	ProgressPercent int `json:"progress_percent,omitempty"`
+	// FailureReason is only set for failed events
+	FailureReason string    `json:"failure_reason,omitempty"`
+	OccurredAt    time.Time `json:"occurred_at"`
+}
+
+func downloadTaskLifecycleEventToProto(event DownloadTaskLifecycleEvent) *go_load.DownloadTaskLifecycleEvent {
+	return &go_load.DownloadTaskLifecycleEvent{
+		Id:              event.ID,
+		OfAccountId:     event.OfAccountID,
+		OfTeamId:        lo.FromPtr(event.OfTeamID),
+		DownloadStatus:  go_load.DownloadStatus(go_load.DownloadStatus_value[event.DownloadStatus]),
+		ProgressPercent: uint32(event.ProgressPercent),
+		FailureReason:   event.FailureReason,
+		OccurredAt:      timestamppb.New(event.OccurredAt),
+	}
+}
+
+func downloadTaskLifecycleEventFromProto(eventType string, protoEvent *go_load.DownloadTaskLifecycleEvent) DownloadTaskLifecycleEvent {
+	event := DownloadTaskLifecycleEvent{
+		Type:            eventType,
+		ID:              protoEvent.GetId(),
+		OfAccountID:     protoEvent.GetOfAccountId(),
+		DownloadStatus:  protoEvent.GetDownloadStatus().String(),
+		ProgressPercent: int(protoEvent.GetProgressPercent()),
+		FailureReason:   protoEvent.GetFailureReason(),
+		OccurredAt:      protoEvent.GetOccurredAt().AsTime(),
+	}
+	if protoEvent.GetOfTeamId() != 0 {
+		event.OfTeamID = lo.ToPtr(protoEvent.GetOfTeamId())
+	}
+
+	return event
+}
+
+// NewDownloadTaskLifecycleEventDecoder decodes every schema version of every type of lifecycle event
+func NewDownloadTaskLifecycleEventDecoder() *envelope.Decoder[DownloadTaskLifecycleEvent] {
+	decodeJSONFunc := func(payload []byte) (DownloadTaskLifecycleEvent, error) {
+		var event DownloadTaskLifecycleEvent
+		err := json.Unmarshal(payload, &event)
+		return event, err
+	}
+
+	decoder := envelope.NewDecoder(decodeJSONFunc)
+	for _, eventType := range downloadTaskLifecycleEventTypeList {
+		decoder.Register(eventType, SchemaVersionJSON, decodeJSONFunc)
+		decoder.Register(eventType, SchemaVersionProto, func(payload []byte) (DownloadTaskLifecycleEvent, error) {
+			var protoEvent go_load.DownloadTaskLifecycleEvent
+			if err := proto.Unmarshal(payload, &protoEvent); err != nil {
+				return DownloadTaskLifecycleEvent{}, err
+			}
+
+			return downloadTaskLifecycleEventFromProto(eventType, &protoEvent), nil
+		})
+	}
+
+	return decoder
+}
+
+type DownloadTaskLifecycleEventProducer interface {
+	// Produce does nothing when mq.publish_lifecycle_events is disabled
+	Produce(ctx context.Context, event DownloadTaskLifecycleEvent) error
+}
+
+type downloadTaskLifecycleEventProducer struct {
+	client        Client
+	enabled       bool
+	schemaVersion int
+	logger        *zap.Logger
+}
+
+// NewDownloadTaskLifecycleEventProducer fails for the in_memory and database types, nothing subscribes to lifecycle
+// events yet, so their queues would fill up and block or grow without limit
+func NewDownloadTaskLifecycleEventProducer(
+	client Client,
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (DownloadTaskLifecycleEventProducer, error) {
+	if mqConfig.PublishLifecycleEvents &&
+		(mqConfig.Type == configs.MQTypeInMemory || mqConfig.Type == configs.MQTypeDatabase) {
+		return nil, fmt.Errorf("mq publish_lifecycle_events is not supported with mq type %s", mqConfig.Type)
+	}
+
+	schemaVersion, err := getProducedSchemaVersion(mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &downloadTaskLifecycleEventProducer{
+		client:        client,
+		enabled:       mqConfig.PublishLifecycleEvents,
+		schemaVersion: schemaVersion,
+		logger:        logger,
+	}, nil
+}
+
+func (d downloadTaskLifecycleEventProducer) marshal(event DownloadTaskLifecycleEvent) ([]byte, error) {
+	if d.schemaVersion == SchemaVersionJSON {
+		return json.Marshal(event)
+	}
+
+	return proto.Marshal(downloadTaskLifecycleEventToProto(event))
+}
+
+func (d downloadTaskLifecycleEventProducer) Produce(ctx context.Context, event DownloadTaskLifecycleEvent) error {
+	if !d.enabled {
+		return nil
+	}
+
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Any("event", event))
+
+	eventBytes, err := d.marshal(event)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to marshal download task lifecycle event")
+		return status.Error(codes.Internal, "failed to marshal download task lifecycle event")
+	}
+
+	messageEnvelope := envelope.New(ctx, event.Type, d.schemaVersion)
+	err = d.client.Produce(ctx, event.Type, messageEnvelope.ToHeaders(), eventBytes)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce download task lifecycle event")
+		return status.Error(codes.Internal, "failed to produce download task lifecycle event")
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type inMemoryClient struct {
+	broker inmemory.Broker
+	logger *zap.Logger
+}
+
+func newInMemoryClient(
+	broker inmemory.Broker,
+	logger *zap.Logger,
+) Client {
+	return &inMemoryClient{
+		broker: broker,
+		logger: logger,
+	}
+}
+
+func (c inMemoryClient) Produce(ctx context.Context, queueName string, headers map[string]string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	if err := c.broker.Publish(ctx, inmemory.Message{
+		QueueName: queueName,
+		Headers:   headers,
+		Payload:   payload,
+	}); err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/natsjs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type natsClient struct {
+	jetStream jetstream.JetStream
+	mqConfig  configs.MQ
+	logger    *zap.Logger
+}
+
+func newNATSClient(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Client, error) {
+	_, jetStream, err := natsjs.Connect(context.Background(), mqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &natsClient{
+		jetStream: jetStream,
+		mqConfig:  mqConfig,
+		logger:    logger,
+	}, nil
+}
+
+func (c natsClient) Produce(ctx context.Context, queueName string, headers map[string]string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	// Publish waits for the server to ack that the message is stored in the stream
+	message := nats.NewMsg(natsjs.GetSubject(c.mqConfig, queueName))
+	message.Data = payload
+	for key, value := range headers {
+		message.Header.Set(key, value)
+	}
+
+	if _, err := c.jetStream.PublishMsg(ctx, message); err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
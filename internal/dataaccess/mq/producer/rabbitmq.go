@@ -0,0 +1,100 @@
+package producer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/rabbitmq"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type rabbitMQClient struct {
+	channel              *amqp.Channel
+	channelLock          sync.Mutex
+	declaredQueueNameSet map[string]struct{}
+	logger               *zap.Logger
+}
+
+func newRabbitMQClient(
+	mqConfig configs.MQ,
+	logger *zap.Logger,
+) (Client, error) {
+	connection, err := amqp.Dial(mqConfig.RabbitMQ.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to rabbitmq: %w", err)
+	}
+
+	channel, err := connection.Channel()
+	if err != nil {
+		connection.Close()
+		return nil, fmt.Errorf("failed to open rabbitmq channel: %w", err)
+	}
+
+	// Publisher confirms make the server ack each message once it is stored
+	if err = channel.Confirm(false); err != nil {
+		connection.Close()
+		return nil, fmt.Errorf("failed to enable rabbitmq publisher confirms: %w", err)
+	}
+
+	return &rabbitMQClient{
+		channel:              channel,
+		declaredQueueNameSet: make(map[string]struct{}),
+		logger:               logger,
+	}, nil
+}
+
+func (c *rabbitMQClient) publish(
+	ctx context.Context,
+	queueName string,
+	headers map[string]string,
+	payload []byte,
+) (*amqp.DeferredConfirmation, error) {
+	c.channelLock.Lock()
+	defer c.channelLock.Unlock()
+
+	// Messages published before the queue exists would be dropped by the default exchange
+	if _, ok := c.declaredQueueNameSet[queueName]; !ok {
+		if err := rabbitmq.DeclareQueue(c.channel, queueName); err != nil {
+			return nil, err
+		}
+
+		c.declaredQueueNameSet[queueName] = struct{}{}
+	}
+
+	headerTable := amqp.Table{}
+	for key, value := range headers {
+		headerTable[key] = value
+	}
+
+	return c.channel.PublishWithDeferredConfirmWithContext(ctx, "", queueName, false, false, amqp.Publishing{
+		Headers:      headerTable,
+		DeliveryMode: amqp.Persistent,
+		Body:         payload,
+	})
+}
+
+func (c *rabbitMQClient) Produce(ctx context.Context, queueName string, headers map[string]string, payload []byte) error {
+	logger := utils.LoggerWithContext(ctx, c.logger).
+		With(zap.String("queue_name", queueName)).
+		With(zap.ByteString("payload", payload))
+
+	deferredConfirmation, err := c.publish(ctx, queueName, headers, payload)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to produce message")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	acked, err := deferredConfirmation.WaitContext(ctx)
+	if err != nil || !acked {
+		logger.With(zap.Error(err)).Error("message was not confirmed by rabbitmq")
+		return status.Error(codes.Internal, "failed to produce message")
+	}
+
+	return nil
+}
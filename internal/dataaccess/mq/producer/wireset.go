@@ -5,4 +5,5 @@ import "github.com/google/wire"
 var WireSet = wire.NewSet(
 	NewClient,
 	NewDownloadTaskCreatedProducer,
+	NewDownloadTaskLifecycleEventProducer,
 )
@@ -0,0 +1,41 @@
+package rabbitmq
+
+import (
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// DeliveryCountHeaderKey is the header keeping how many times a message has been delivered before, rabbitmq does
+// not count it for classic queues
+const DeliveryCountHeaderKey = "delivery_count"
+
+func DeclareQueue(channel *amqp.Channel, queueName string) error {
+	_, err := channel.QueueDeclare(queueName, true, false, false, false, nil)
+	return err
+}
+
+func GetRetryQueueName(queueName string) string {
+	return queueName + ".retry"
+}
+
+// DeclareRetryQueue declares the queue nacked messages of queueName wait in, nothing consumes it, instead each
+// message is moved back to queueName once its expiration is reached
+func DeclareRetryQueue(channel *amqp.Channel, queueName string) error {
+	_, err := channel.QueueDeclare(GetRetryQueueName(queueName), true, false, false, false, amqp.Table{
+		"x-dead-letter-exchange":    "",
+		"x-dead-letter-routing-key": queueName,
+	})
+	return err
+}
+
+func GetDeliveryCount(headers amqp.Table) int {
+	switch deliveryCount := headers[DeliveryCountHeaderKey].(type) {
+	case int32:
+		return int(deliveryCount)
+	case int64:
+		return int(deliveryCount)
+	case int:
+		return deliveryCount
+	default:
+		return 0
+	}
+}
@@ -3,10 +3,12 @@ package mq
 import (
 	"github.com/google/wire"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/consumer"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
 )
 
 var WireSet = wire.NewSet(
+	inmemory.WireSet,
 	producer.WireSet,
 	consumer.WireSet,
 )
@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type logNotifier struct {
+	logger *zap.Logger
+}
+
+func NewLogNotifier(
+	logger *zap.Logger,
+) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+func (l logNotifier) Send(ctx context.Context, message Message) error {
+	utils.LoggerWithContext(ctx, l.logger).
+		With(zap.String("to", message.To)).
+		With(zap.String("subject", message.Subject)).
+		With(zap.String("body", message.Body)).
+		Info("notification sent to log")
+	return nil
+}
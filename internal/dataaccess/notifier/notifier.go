@@ -0,0 +1,34 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"go.uber.org/zap"
+)
+
+type Message struct {
+	// To is the address of the recipient, an email address for the smtp notifier
+	To      string
+	Subject string
+	Body    string
+}
+
+type Notifier interface {
+	Send(ctx context.Context, message Message) error
+}
+
+func NewNotifier(
+	notifierConfig configs.Notifier,
+	logger *zap.Logger,
+) (Notifier, error) {
+	switch notifierConfig.Type {
+	case configs.NotifierTypeLog:
+		return NewLogNotifier(logger), nil
+	case configs.NotifierTypeSMTP:
+		return NewSMTPNotifier(notifierConfig, logger)
+	default:
+		return nil, fmt.Errorf("unsupported notifier type: %s", notifierConfig.Type)
+	}
+}
@@ -0,0 +1,166 @@
+package notifier
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type smtpNotifier struct {
+	host        string
+	address     string
+	auth        smtp.Auth
+	fromAddress string
+	fromHeader  string
+	timeout     time.Duration
+	logger      *zap.Logger
+}
+
+func NewSMTPNotifier(
+	notifierConfig configs.Notifier,
+	logger *zap.Logger,
+) (Notifier, error) {
+	smtpConfig := notifierConfig.SMTP
+
+	from, err := mail.ParseAddress(smtpConfig.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notifier.smtp.from: %w", err)
+	}
+
+	timeout, err := smtpConfig.GetTimeoutDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse notifier.smtp.timeout: %w", err)
+	}
+
+	var auth smtp.Auth
+	if smtpConfig.Username != "" {
+		auth = smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, smtpConfig.Host)
+	}
+
+	return &smtpNotifier{
+		host:        smtpConfig.Host,
+		address:     net.JoinHostPort(smtpConfig.Host, strconv.Itoa(smtpConfig.Port)),
+		auth:        auth,
+		fromAddress: from.Address,
+		fromHeader:  from.String(),
+		timeout:     timeout,
+		logger:      logger,
+	}, nil
+}
+
+func (s smtpNotifier) buildMail(to *mail.Address, message Message) []byte {
+	headerList := []string{
+		"From: " + s.fromHeader,
+		"To: " + to.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: 8bit",
+	}
+
+	body := strings.ReplaceAll(message.Body, "\n", "\r\n")
+	return []byte(strings.Join(headerList, "\r\n") + "\r\n\r\n" + body + "\r\n")
+}
+
+// sendMail does what smtp.SendMail does on a connection whose deadline is the earliest of ctx and the configured
+// timeout, so an unresponsive mail server cannot block the caller
+func (s smtpNotifier) sendMail(ctx context.Context, to string, data []byte) error {
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	dialer := net.Dialer{Deadline: deadline}
+	connection, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+
+	if err = connection.SetDeadline(deadline); err != nil {
+		connection.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(connection, s.host)
+	if err != nil {
+		connection.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return err
+		}
+	}
+
+	if s.auth != nil {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err = client.Auth(s.auth); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = client.Mail(s.fromAddress); err != nil {
+		return err
+	}
+
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err = writer.Write(data); err != nil {
+		writer.Close()
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s smtpNotifier) Send(ctx context.Context, message Message) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).
+		With(zap.String("to", message.To)).
+		With(zap.String("subject", message.Subject))
+
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid recipient address")
+		return status.Error(codes.InvalidArgument, "invalid recipient address")
+	}
+
+	// The subject is written as a header, line breaks would let it inject other headers
+	if strings.ContainsAny(message.Subject, "\r\n") {
+		return status.Error(codes.InvalidArgument, "subject must not contain line breaks")
+	}
+
+	if err = s.sendMail(ctx, to.Address, s.buildMail(to, message)); err != nil {
+		logger.With(zap.Error(err)).Error("failed to send mail")
+		return status.Error(codes.Internal, "failed to send mail")
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package notifier
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewNotifier,
+)
@@ -3,9 +3,11 @@ package dataaccess
 import (
 	"github.com/google/wire"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/clamd"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/notifier"
 )
 
 var WireSet = wire.NewSet(
@@ -13,4 +15,6 @@ var WireSet = wire.NewSet(
 	cache.WireSet,
 	mq.WireSet,
 	file.WireSet,
+	clamd.WireSet,
+	notifier.WireSet,
 )
@@ -60,6 +60,97 @@ func (m *Account) validate(all bool) error {
 
 	// no validation rules for AccountName
 
+	if all {
+		switch v := interface{}(m.GetDownloadTaskRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "DownloadTaskRetention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "DownloadTaskRetention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTaskRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountValidationError{
+				field:  "DownloadTaskRetention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPostProcessorList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPostProcessorList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountValidationError{
+				field:  "PostProcessorList",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetDisabledAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AccountValidationError{
+					field:  "DisabledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDisabledAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AccountValidationError{
+				field:  "DisabledAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Email
+
 	if len(errors) > 0 {
 		return AccountMultiError(errors)
 	}
@@ -137,6 +228,108 @@ var _ interface {
 	ErrorName() string
 } = AccountValidationError{}
 
+// Validate checks the field values on PostProcessorList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PostProcessorList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostProcessorList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostProcessorListMultiError, or nil if none found.
+func (m *PostProcessorList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostProcessorList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PostProcessorListMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostProcessorListMultiError is an error wrapping multiple validation errors
+// returned by PostProcessorList.ValidateAll() if the designated constraints
+// aren't met.
+type PostProcessorListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostProcessorListMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostProcessorListMultiError) AllErrors() []error { return m }
+
+// PostProcessorListValidationError is the validation error returned by
+// PostProcessorList.Validate if the designated constraints aren't met.
+type PostProcessorListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostProcessorListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostProcessorListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostProcessorListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostProcessorListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostProcessorListValidationError) ErrorName() string {
+	return "PostProcessorListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostProcessorListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostProcessorList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostProcessorListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostProcessorListValidationError{}
+
 // Validate checks the field values on CreateAccountRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -170,10 +363,10 @@ func (m *CreateAccountRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CreateAccountRequest_Password_Pattern.MatchString(m.GetPassword()) {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 1024 {
 		err := CreateAccountRequestValidationError{
 			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -181,6 +374,33 @@ func (m *CreateAccountRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 256 {
+			err := CreateAccountRequestValidationError{
+				field:  "Email",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = CreateAccountRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return CreateAccountRequestMultiError(errors)
 	}
@@ -188,6 +408,56 @@ func (m *CreateAccountRequest) validate(all bool) error {
 	return nil
 }
 
+func (m *CreateAccountRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateAccountRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
 // CreateAccountRequestMultiError is an error wrapping multiple validation
 // errors returned by CreateAccountRequest.ValidateAll() if the designated
 // constraints aren't met.
@@ -263,8 +533,6 @@ var _ interface {
 
 var _CreateAccountRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
 
-var _CreateAccountRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
-
 // Validate checks the field values on CreateAccountResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -402,10 +670,10 @@ func (m *CreateSessionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if !_CreateSessionRequest_Password_Pattern.MatchString(m.GetPassword()) {
+	if l := utf8.RuneCountInString(m.GetPassword()); l < 1 || l > 1024 {
 		err := CreateSessionRequestValidationError{
 			field:  "Password",
-			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -495,8 +763,6 @@ var _ interface {
 
 var _CreateSessionRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
 
-var _CreateSessionRequest_Password_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
-
 // Validate checks the field values on CreateSessionResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -548,6 +814,8 @@ func (m *CreateSessionResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ChallengeToken
+
 	if len(errors) > 0 {
 		return CreateSessionResponseMultiError(errors)
 	}
@@ -628,22 +896,21 @@ var _ interface {
 	ErrorName() string
 } = CreateSessionResponseValidationError{}
 
-// Validate checks the field values on DownloadTask with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *DownloadTask) Validate() error {
+func (m *Session) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DownloadTask with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DownloadTaskMultiError, or
-// nil if none found.
-func (m *DownloadTask) ValidateAll() error {
+// ValidateAll checks the field values on Session with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SessionMultiError, or nil if none found.
+func (m *Session) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DownloadTask) validate(all bool) error {
+func (m *Session) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -652,63 +919,110 @@ func (m *DownloadTask) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for UserAgent
+
 	if all {
-		switch v := interface{}(m.GetOfAccount()).(type) {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DownloadTaskValidationError{
-					field:  "OfAccount",
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DownloadTaskValidationError{
-					field:  "OfAccount",
+				errors = append(errors, SessionValidationError{
+					field:  "CreatedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOfAccount()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DownloadTaskValidationError{
-				field:  "OfAccount",
+			return SessionValidationError{
+				field:  "CreatedAt",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if _, ok := DownloadType_name[int32(m.GetDownloadType())]; !ok {
-		err := DownloadTaskValidationError{
-			field:  "DownloadType",
-			reason: "value must be one of the defined enum values",
+	if all {
+		switch v := interface{}(m.GetLastRefreshedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastRefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "LastRefreshedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetLastRefreshedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "LastRefreshedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
-	// no validation rules for Url
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SessionValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SessionValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for DownloadStatus
+	// no validation rules for Current
 
 	if len(errors) > 0 {
-		return DownloadTaskMultiError(errors)
+		return SessionMultiError(errors)
 	}
 
 	return nil
 }
 
-// DownloadTaskMultiError is an error wrapping multiple validation errors
-// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
-type DownloadTaskMultiError []error
+// SessionMultiError is an error wrapping multiple validation errors returned
+// by Session.ValidateAll() if the designated constraints aren't met.
+type SessionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DownloadTaskMultiError) Error() string {
+func (m SessionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -717,11 +1031,11 @@ func (m DownloadTaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DownloadTaskMultiError) AllErrors() []error { return m }
+func (m SessionMultiError) AllErrors() []error { return m }
 
-// DownloadTaskValidationError is the validation error returned by
-// DownloadTask.Validate if the designated constraints aren't met.
-type DownloadTaskValidationError struct {
+// SessionValidationError is the validation error returned by Session.Validate
+// if the designated constraints aren't met.
+type SessionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -729,22 +1043,22 @@ type DownloadTaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e DownloadTaskValidationError) Field() string { return e.field }
+func (e SessionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DownloadTaskValidationError) Reason() string { return e.reason }
+func (e SessionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DownloadTaskValidationError) Cause() error { return e.cause }
+func (e SessionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DownloadTaskValidationError) Key() bool { return e.key }
+func (e SessionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+func (e SessionValidationError) ErrorName() string { return "SessionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DownloadTaskValidationError) Error() string {
+func (e SessionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -756,14 +1070,14 @@ func (e DownloadTaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDownloadTask.%s: %s%s",
+		"invalid %sSession.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DownloadTaskValidationError{}
+var _ error = SessionValidationError{}
 
 var _ interface {
 	Field() string
@@ -771,57 +1085,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DownloadTaskValidationError{}
+} = SessionValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// Validate checks the field values on RefreshSessionRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskRequest) Validate() error {
+func (m *RefreshSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RefreshSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskRequestMultiError, or nil if none found.
-func (m *CreateDownloadTaskRequest) ValidateAll() error {
+// RefreshSessionRequestMultiError, or nil if none found.
+func (m *RefreshSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskRequest) validate(all bool) error {
+func (m *RefreshSessionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadType
-
-	if l := utf8.RuneCountInString(m.GetUrl()); l < 10 || l > 200 {
-		err := CreateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value length must be between 10 and 200 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for RefreshToken
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskRequestMultiError(errors)
+		return RefreshSessionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type CreateDownloadTaskRequestMultiError []error
+// RefreshSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskRequestMultiError) Error() string {
+func (m RefreshSessionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -830,11 +1133,11 @@ func (m CreateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m RefreshSessionRequestMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskRequestValidationError is the validation error returned by
-// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type CreateDownloadTaskRequestValidationError struct {
+// RefreshSessionRequestValidationError is the validation error returned by
+// RefreshSessionRequest.Validate if the designated constraints aren't met.
+type RefreshSessionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -842,24 +1145,24 @@ type CreateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e RefreshSessionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e RefreshSessionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e RefreshSessionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e RefreshSessionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
-	return "CreateDownloadTaskRequestValidationError"
+func (e RefreshSessionRequestValidationError) ErrorName() string {
+	return "RefreshSessionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskRequestValidationError) Error() string {
+func (e RefreshSessionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -871,14 +1174,14 @@ func (e CreateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		"invalid %sRefreshSessionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskRequestValidationError{}
+var _ error = RefreshSessionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -886,24 +1189,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskRequestValidationError{}
+} = RefreshSessionRequestValidationError{}
 
-// Validate checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateDownloadTaskResponse) Validate() error {
+func (m *RefreshSessionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RefreshSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateDownloadTaskResponseMultiError, or nil if none found.
-func (m *CreateDownloadTaskResponse) ValidateAll() error {
+// RefreshSessionResponseMultiError, or nil if none found.
+func (m *RefreshSessionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateDownloadTaskResponse) validate(all bool) error {
+func (m *RefreshSessionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -911,28 +1214,28 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
+		switch v := interface{}(m.GetSession()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Session",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
+				errors = append(errors, RefreshSessionResponseValidationError{
+					field:  "Session",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
+			return RefreshSessionResponseValidationError{
+				field:  "Session",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -940,19 +1243,19 @@ func (m *CreateDownloadTaskResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateDownloadTaskResponseMultiError(errors)
+		return RefreshSessionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type CreateDownloadTaskResponseMultiError []error
+// RefreshSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RefreshSessionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateDownloadTaskResponseMultiError) Error() string {
+func (m RefreshSessionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -961,11 +1264,11 @@ func (m CreateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m RefreshSessionResponseMultiError) AllErrors() []error { return m }
 
-// CreateDownloadTaskResponseValidationError is the validation error returned
-// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type CreateDownloadTaskResponseValidationError struct {
+// RefreshSessionResponseValidationError is the validation error returned by
+// RefreshSessionResponse.Validate if the designated constraints aren't met.
+type RefreshSessionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -973,24 +1276,24 @@ type CreateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e RefreshSessionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e RefreshSessionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e RefreshSessionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e RefreshSessionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
-	return "CreateDownloadTaskResponseValidationError"
+func (e RefreshSessionResponseValidationError) ErrorName() string {
+	return "RefreshSessionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateDownloadTaskResponseValidationError) Error() string {
+func (e RefreshSessionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1002,14 +1305,14 @@ func (e CreateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		"invalid %sRefreshSessionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateDownloadTaskResponseValidationError{}
+var _ error = RefreshSessionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1017,57 +1320,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateDownloadTaskResponseValidationError{}
+} = RefreshSessionResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListRequest) Validate() error {
+func (m *DeleteSessionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskListRequest) ValidateAll() error {
+// DeleteSessionRequestMultiError, or nil if none found.
+func (m *DeleteSessionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListRequest) validate(all bool) error {
+func (m *DeleteSessionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Limit
-
-	if m.GetOffset() > 100 {
-		err := GetDownloadTaskListRequestValidationError{
-			field:  "Offset",
-			reason: "value must be less than or equal to 100",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return GetDownloadTaskListRequestMultiError(errors)
+		return DeleteSessionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListRequestMultiError []error
+// DeleteSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListRequestMultiError) Error() string {
+func (m DeleteSessionRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1076,11 +1366,11 @@ func (m GetDownloadTaskListRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+func (m DeleteSessionRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListRequestValidationError is the validation error returned
-// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskListRequestValidationError struct {
+// DeleteSessionRequestValidationError is the validation error returned by
+// DeleteSessionRequest.Validate if the designated constraints aren't met.
+type DeleteSessionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1088,24 +1378,24 @@ type GetDownloadTaskListRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+func (e DeleteSessionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+func (e DeleteSessionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+func (e DeleteSessionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+func (e DeleteSessionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskListRequestValidationError"
+func (e DeleteSessionRequestValidationError) ErrorName() string {
+	return "DeleteSessionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListRequestValidationError) Error() string {
+func (e DeleteSessionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1117,14 +1407,14 @@ func (e GetDownloadTaskListRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		"invalid %sDeleteSessionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListRequestValidationError{}
+var _ error = DeleteSessionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1132,80 +1422,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListRequestValidationError{}
+} = DeleteSessionRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskListResponse) Validate() error {
+func (m *DeleteSessionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskListResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskListResponse) ValidateAll() error {
+// DeleteSessionResponseMultiError, or nil if none found.
+func (m *DeleteSessionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskListResponse) validate(all bool) error {
+func (m *DeleteSessionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDownloadTaskList() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetDownloadTaskListResponseValidationError{
-						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetDownloadTaskListResponseValidationError{
-					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for TotalCount
-
 	if len(errors) > 0 {
-		return GetDownloadTaskListResponseMultiError(errors)
+		return DeleteSessionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskListResponseMultiError []error
+// DeleteSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteSessionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskListResponseMultiError) Error() string {
+func (m DeleteSessionResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1214,12 +1468,11 @@ func (m GetDownloadTaskListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+func (m DeleteSessionResponseMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskListResponseValidationError is the validation error returned
-// by GetDownloadTaskListResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskListResponseValidationError struct {
+// DeleteSessionResponseValidationError is the validation error returned by
+// DeleteSessionResponse.Validate if the designated constraints aren't met.
+type DeleteSessionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1227,24 +1480,24 @@ type GetDownloadTaskListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+func (e DeleteSessionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+func (e DeleteSessionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+func (e DeleteSessionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+func (e DeleteSessionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskListResponseValidationError"
+func (e DeleteSessionResponseValidationError) ErrorName() string {
+	return "DeleteSessionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskListResponseValidationError) Error() string {
+func (e DeleteSessionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1256,14 +1509,14 @@ func (e GetDownloadTaskListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		"invalid %sDeleteSessionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskListResponseValidationError{}
+var _ error = DeleteSessionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1271,46 +1524,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskListResponseValidationError{}
+} = DeleteSessionResponseValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileRequest) Validate() error {
+func (m *ListSessionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSessionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileRequestMultiError, or nil if none found.
-func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+// ListSessionsRequestMultiError, or nil if none found.
+func (m *ListSessionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+func (m *ListSessionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
-
 	if len(errors) > 0 {
-		return GetDownloadTaskFileRequestMultiError(errors)
+		return ListSessionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
-// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
-// the designated constraints aren't met.
-type GetDownloadTaskFileRequestMultiError []error
+// ListSessionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileRequestMultiError) Error() string {
+func (m ListSessionsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1319,11 +1570,11 @@ func (m GetDownloadTaskFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+func (m ListSessionsRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileRequestValidationError is the validation error returned
-// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
-type GetDownloadTaskFileRequestValidationError struct {
+// ListSessionsRequestValidationError is the validation error returned by
+// ListSessionsRequest.Validate if the designated constraints aren't met.
+type ListSessionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1331,24 +1582,24 @@ type GetDownloadTaskFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+func (e ListSessionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+func (e ListSessionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+func (e ListSessionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+func (e ListSessionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
-	return "GetDownloadTaskFileRequestValidationError"
+func (e ListSessionsRequestValidationError) ErrorName() string {
+	return "ListSessionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileRequestValidationError) Error() string {
+func (e ListSessionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1360,14 +1611,14 @@ func (e GetDownloadTaskFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		"invalid %sListSessionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileRequestValidationError{}
+var _ error = ListSessionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1375,46 +1626,14320 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileRequestValidationError{}
+} = ListSessionsRequestValidationError{}
 
-// Validate checks the field values on GetDownloadTaskFileResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetDownloadTaskFileResponse) Validate() error {
+func (m *ListSessionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListSessionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetDownloadTaskFileResponseMultiError, or nil if none found.
-func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+// ListSessionsResponseMultiError, or nil if none found.
+func (m *ListSessionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+func (m *ListSessionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
-
-	if len(errors) > 0 {
-		return GetDownloadTaskFileResponseMultiError(errors)
-	}
-
-	return nil
-}
+	for idx, item := range m.GetSessionList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSessionsResponseValidationError{
+						field:  fmt.Sprintf("SessionList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSessionsResponseValidationError{
+					field:  fmt.Sprintf("SessionList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSessionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSessionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSessionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSessionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSessionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSessionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSessionsResponseValidationError is the validation error returned by
+// ListSessionsResponse.Validate if the designated constraints aren't met.
+type ListSessionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSessionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSessionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSessionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSessionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSessionsResponseValidationError) ErrorName() string {
+	return "ListSessionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSessionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSessionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSessionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSessionsResponseValidationError{}
+
+// Validate checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionRequestMultiError, or nil if none found.
+func (m *RevokeSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	if len(errors) > 0 {
+		return RevokeSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSessionRequestValidationError is the validation error returned by
+// RevokeSessionRequest.Validate if the designated constraints aren't met.
+type RevokeSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionRequestValidationError) ErrorName() string {
+	return "RevokeSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionRequestValidationError{}
+
+// Validate checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSessionResponseMultiError, or nil if none found.
+func (m *RevokeSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSessionResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeSessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSessionResponseMultiError) AllErrors() []error { return m }
+
+// RevokeSessionResponseValidationError is the validation error returned by
+// RevokeSessionResponse.Validate if the designated constraints aren't met.
+type RevokeSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSessionResponseValidationError) ErrorName() string {
+	return "RevokeSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSessionResponseValidationError{}
+
+// Validate checks the field values on ListAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccountsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccountsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccountsRequestMultiError, or nil if none found.
+func (m *ListAccountsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccountsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAccountNameContains()) > 32 {
+		err := ListAccountsRequestValidationError{
+			field:  "AccountNameContains",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AccountRole_name[int32(m.GetRole())]; !ok {
+		err := ListAccountsRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := ListAccountsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListAccountsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccountsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAccountsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAccountsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccountsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccountsRequestMultiError) AllErrors() []error { return m }
+
+// ListAccountsRequestValidationError is the validation error returned by
+// ListAccountsRequest.Validate if the designated constraints aren't met.
+type ListAccountsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccountsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccountsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccountsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccountsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccountsRequestValidationError) ErrorName() string {
+	return "ListAccountsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccountsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccountsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccountsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccountsRequestValidationError{}
+
+// Validate checks the field values on ListAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAccountsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAccountsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAccountsResponseMultiError, or nil if none found.
+func (m *ListAccountsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAccountsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAccountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAccountsResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAccountsResponseValidationError{
+						field:  fmt.Sprintf("AccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAccountsResponseValidationError{
+					field:  fmt.Sprintf("AccountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListAccountsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAccountsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAccountsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAccountsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAccountsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAccountsResponseMultiError) AllErrors() []error { return m }
+
+// ListAccountsResponseValidationError is the validation error returned by
+// ListAccountsResponse.Validate if the designated constraints aren't met.
+type ListAccountsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAccountsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAccountsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAccountsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAccountsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAccountsResponseValidationError) ErrorName() string {
+	return "ListAccountsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAccountsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAccountsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAccountsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAccountsResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountRoleRequestMultiError, or nil if none found.
+func (m *UpdateAccountRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if _, ok := _UpdateAccountRoleRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := UpdateAccountRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [ACCOUNT_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AccountRole_name[int32(m.GetRole())]; !ok {
+		err := UpdateAccountRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRoleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountRoleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRoleRequestValidationError is the validation error returned by
+// UpdateAccountRoleRequest.Validate if the designated constraints aren't met.
+type UpdateAccountRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRoleRequestValidationError) ErrorName() string {
+	return "UpdateAccountRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRoleRequestValidationError{}
+
+var _UpdateAccountRoleRequest_Role_NotInLookup = map[AccountRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdateAccountRoleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountRoleResponseMultiError, or nil if none found.
+func (m *UpdateAccountRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountRoleResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountRoleResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRoleResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountRoleResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAccountRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRoleResponseValidationError is the validation error returned by
+// UpdateAccountRoleResponse.Validate if the designated constraints aren't met.
+type UpdateAccountRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRoleResponseValidationError) ErrorName() string {
+	return "UpdateAccountRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRoleResponseValidationError{}
+
+// Validate checks the field values on DisableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAccountRequestMultiError, or nil if none found.
+func (m *DisableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return DisableAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAccountRequestMultiError) AllErrors() []error { return m }
+
+// DisableAccountRequestValidationError is the validation error returned by
+// DisableAccountRequest.Validate if the designated constraints aren't met.
+type DisableAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAccountRequestValidationError) ErrorName() string {
+	return "DisableAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAccountRequestValidationError{}
+
+// Validate checks the field values on DisableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableAccountResponseMultiError, or nil if none found.
+func (m *DisableAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DisableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DisableAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DisableAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableAccountResponseMultiError) AllErrors() []error { return m }
+
+// DisableAccountResponseValidationError is the validation error returned by
+// DisableAccountResponse.Validate if the designated constraints aren't met.
+type DisableAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableAccountResponseValidationError) ErrorName() string {
+	return "DisableAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableAccountResponseValidationError{}
+
+// Validate checks the field values on EnableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableAccountRequestMultiError, or nil if none found.
+func (m *EnableAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return EnableAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by EnableAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type EnableAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableAccountRequestMultiError) AllErrors() []error { return m }
+
+// EnableAccountRequestValidationError is the validation error returned by
+// EnableAccountRequest.Validate if the designated constraints aren't met.
+type EnableAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableAccountRequestValidationError) ErrorName() string {
+	return "EnableAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableAccountRequestValidationError{}
+
+// Validate checks the field values on EnableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnableAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnableAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnableAccountResponseMultiError, or nil if none found.
+func (m *EnableAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnableAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EnableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EnableAccountResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EnableAccountResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EnableAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnableAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by EnableAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type EnableAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnableAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnableAccountResponseMultiError) AllErrors() []error { return m }
+
+// EnableAccountResponseValidationError is the validation error returned by
+// EnableAccountResponse.Validate if the designated constraints aren't met.
+type EnableAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnableAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnableAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnableAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnableAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnableAccountResponseValidationError) ErrorName() string {
+	return "EnableAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnableAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnableAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnableAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnableAccountResponseValidationError{}
+
+// Validate checks the field values on ListAllDownloadTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAllDownloadTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllDownloadTasksRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAllDownloadTasksRequestMultiError, or nil if none found.
+func (m *ListAllDownloadTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllDownloadTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OfAccountId
+
+	_ListAllDownloadTasksRequest_DownloadStatusList_Unique := make(map[DownloadStatus]struct{}, len(m.GetDownloadStatusList()))
+
+	for idx, item := range m.GetDownloadStatusList() {
+		_, _ = idx, item
+
+		if _, exists := _ListAllDownloadTasksRequest_DownloadStatusList_Unique[item]; exists {
+			err := ListAllDownloadTasksRequestValidationError{
+				field:  fmt.Sprintf("DownloadStatusList[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ListAllDownloadTasksRequest_DownloadStatusList_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _ListAllDownloadTasksRequest_DownloadStatusList_NotInLookup[item]; ok {
+			err := ListAllDownloadTasksRequestValidationError{
+				field:  fmt.Sprintf("DownloadStatusList[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := DownloadStatus_name[int32(item)]; !ok {
+			err := ListAllDownloadTasksRequestValidationError{
+				field:  fmt.Sprintf("DownloadStatusList[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetUrlContains()) > 200 {
+		err := ListAllDownloadTasksRequestValidationError{
+			field:  "UrlContains",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := ListAllDownloadTasksRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListAllDownloadTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllDownloadTasksRequestMultiError is an error wrapping multiple
+// validation errors returned by ListAllDownloadTasksRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListAllDownloadTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllDownloadTasksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllDownloadTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListAllDownloadTasksRequestValidationError is the validation error returned
+// by ListAllDownloadTasksRequest.Validate if the designated constraints
+// aren't met.
+type ListAllDownloadTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllDownloadTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllDownloadTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllDownloadTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllDownloadTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllDownloadTasksRequestValidationError) ErrorName() string {
+	return "ListAllDownloadTasksRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllDownloadTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllDownloadTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllDownloadTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllDownloadTasksRequestValidationError{}
+
+var _ListAllDownloadTasksRequest_DownloadStatusList_NotInLookup = map[DownloadStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListAllDownloadTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAllDownloadTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAllDownloadTasksResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAllDownloadTasksResponseMultiError, or nil if none found.
+func (m *ListAllDownloadTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAllDownloadTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAllDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAllDownloadTasksResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAllDownloadTasksResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListAllDownloadTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAllDownloadTasksResponseMultiError is an error wrapping multiple
+// validation errors returned by ListAllDownloadTasksResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListAllDownloadTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAllDownloadTasksResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAllDownloadTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListAllDownloadTasksResponseValidationError is the validation error returned
+// by ListAllDownloadTasksResponse.Validate if the designated constraints
+// aren't met.
+type ListAllDownloadTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAllDownloadTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAllDownloadTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAllDownloadTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAllDownloadTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAllDownloadTasksResponseValidationError) ErrorName() string {
+	return "ListAllDownloadTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAllDownloadTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAllDownloadTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAllDownloadTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAllDownloadTasksResponseValidationError{}
+
+// Validate checks the field values on RetryDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDownloadTaskRequestMultiError, or nil if none found.
+func (m *RetryDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return RetryDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by RetryDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetryDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// RetryDownloadTaskRequestValidationError is the validation error returned by
+// RetryDownloadTaskRequest.Validate if the designated constraints aren't met.
+type RetryDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDownloadTaskRequestValidationError) ErrorName() string {
+	return "RetryDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on RetryDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetryDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetryDownloadTaskResponseMultiError, or nil if none found.
+func (m *RetryDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetryDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetryDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetryDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetryDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetryDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by RetryDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RetryDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetryDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetryDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// RetryDownloadTaskResponseValidationError is the validation error returned by
+// RetryDownloadTaskResponse.Validate if the designated constraints aren't met.
+type RetryDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetryDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetryDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetryDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetryDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetryDownloadTaskResponseValidationError) ErrorName() string {
+	return "RetryDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetryDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetryDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetryDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetryDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskRequestMultiError, or nil if none found.
+func (m *CancelDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskRequestValidationError is the validation error returned by
+// CancelDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CancelDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskRequestValidationError) ErrorName() string {
+	return "CancelDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelDownloadTaskResponseMultiError, or nil if none found.
+func (m *CancelDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CancelDownloadTaskResponseValidationError is the validation error returned
+// by CancelDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CancelDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelDownloadTaskResponseValidationError) ErrorName() string {
+	return "CancelDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetSystemStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemStatsRequestMultiError, or nil if none found.
+func (m *GetSystemStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSystemStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSystemStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSystemStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetSystemStatsRequestValidationError is the validation error returned by
+// GetSystemStatsRequest.Validate if the designated constraints aren't met.
+type GetSystemStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemStatsRequestValidationError) ErrorName() string {
+	return "GetSystemStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemStatsRequestValidationError{}
+
+// Validate checks the field values on DownloadStatusCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadStatusCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadStatusCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadStatusCountMultiError, or nil if none found.
+func (m *DownloadStatusCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadStatusCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadStatus
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return DownloadStatusCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadStatusCountMultiError is an error wrapping multiple validation
+// errors returned by DownloadStatusCount.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadStatusCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadStatusCountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadStatusCountMultiError) AllErrors() []error { return m }
+
+// DownloadStatusCountValidationError is the validation error returned by
+// DownloadStatusCount.Validate if the designated constraints aren't met.
+type DownloadStatusCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadStatusCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadStatusCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadStatusCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadStatusCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadStatusCountValidationError) ErrorName() string {
+	return "DownloadStatusCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadStatusCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadStatusCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadStatusCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadStatusCountValidationError{}
+
+// Validate checks the field values on GetSystemStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSystemStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSystemStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSystemStatsResponseMultiError, or nil if none found.
+func (m *GetSystemStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSystemStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AccountCount
+
+	// no validation rules for DisabledAccountCount
+
+	// no validation rules for AdminAccountCount
+
+	// no validation rules for DownloadTaskCount
+
+	for idx, item := range m.GetDownloadStatusCountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSystemStatsResponseValidationError{
+						field:  fmt.Sprintf("DownloadStatusCountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSystemStatsResponseValidationError{
+						field:  fmt.Sprintf("DownloadStatusCountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSystemStatsResponseValidationError{
+					field:  fmt.Sprintf("DownloadStatusCountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetSystemStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSystemStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSystemStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSystemStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSystemStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSystemStatsResponseMultiError) AllErrors() []error { return m }
+
+// GetSystemStatsResponseValidationError is the validation error returned by
+// GetSystemStatsResponse.Validate if the designated constraints aren't met.
+type GetSystemStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSystemStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSystemStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSystemStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSystemStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSystemStatsResponseValidationError) ErrorName() string {
+	return "GetSystemStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSystemStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetSystemStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetSystemStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSystemStatsResponseValidationError{}
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogMultiError, or nil
+// if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OfAccountId
+
+	// no validation rules for Action
+
+	// no validation rules for TargetType
+
+	// no validation rules for TargetId
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuditLogValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuditLogValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by
+// AuditLog.Validate if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
+
+// Validate checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsRequestMultiError, or nil if none found.
+func (m *ListAuditLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OfAccountId
+
+	if utf8.RuneCountInString(m.GetTargetType()) > 64 {
+		err := ListAuditLogsRequestValidationError{
+			field:  "TargetType",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TargetId
+
+	if m.GetLimit() > 100 {
+		err := ListAuditLogsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListAuditLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsRequestMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsRequestValidationError is the validation error returned by
+// ListAuditLogsRequest.Validate if the designated constraints aren't met.
+type ListAuditLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsRequestValidationError) ErrorName() string {
+	return "ListAuditLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsRequestValidationError{}
+
+// Validate checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAuditLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAuditLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAuditLogsResponseMultiError, or nil if none found.
+func (m *ListAuditLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAuditLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAuditLogList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAuditLogsResponseValidationError{
+						field:  fmt.Sprintf("AuditLogList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAuditLogsResponseValidationError{
+					field:  fmt.Sprintf("AuditLogList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListAuditLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAuditLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAuditLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAuditLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAuditLogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAuditLogsResponseMultiError) AllErrors() []error { return m }
+
+// ListAuditLogsResponseValidationError is the validation error returned by
+// ListAuditLogsResponse.Validate if the designated constraints aren't met.
+type ListAuditLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAuditLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAuditLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAuditLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAuditLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAuditLogsResponseValidationError) ErrorName() string {
+	return "ListAuditLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAuditLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAuditLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAuditLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAuditLogsResponseValidationError{}
+
+// Validate checks the field values on DeadLetterMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeadLetterMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetterMessage with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeadLetterMessageMultiError, or nil if none found.
+func (m *DeadLetterMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetterMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for QueueName
+
+	// no validation rules for Payload
+
+	// no validation rules for Headers
+
+	// no validation rules for Error
+
+	// no validation rules for DeliveryCount
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterMessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeadLetterMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMessageMultiError is an error wrapping multiple validation errors
+// returned by DeadLetterMessage.ValidateAll() if the designated constraints
+// aren't met.
+type DeadLetterMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMessageMultiError) AllErrors() []error { return m }
+
+// DeadLetterMessageValidationError is the validation error returned by
+// DeadLetterMessage.Validate if the designated constraints aren't met.
+type DeadLetterMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterMessageValidationError) ErrorName() string {
+	return "DeadLetterMessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeadLetterMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterMessageValidationError{}
+
+// Validate checks the field values on ListDeadLetterMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLetterMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLetterMessagesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeadLetterMessagesRequestMultiError, or nil if none found.
+func (m *ListDeadLetterMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLetterMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQueueName()) > 256 {
+		err := ListDeadLetterMessagesRequestValidationError{
+			field:  "QueueName",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() > 100 {
+		err := ListDeadLetterMessagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return ListDeadLetterMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLetterMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListDeadLetterMessagesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeadLetterMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLetterMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLetterMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListDeadLetterMessagesRequestValidationError is the validation error
+// returned by ListDeadLetterMessagesRequest.Validate if the designated
+// constraints aren't met.
+type ListDeadLetterMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLetterMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLetterMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLetterMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLetterMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLetterMessagesRequestValidationError) ErrorName() string {
+	return "ListDeadLetterMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLetterMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLetterMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLetterMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLetterMessagesRequestValidationError{}
+
+// Validate checks the field values on ListDeadLetterMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLetterMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLetterMessagesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListDeadLetterMessagesResponseMultiError, or nil if none found.
+func (m *ListDeadLetterMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLetterMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeadLetterMessageList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeadLetterMessagesResponseValidationError{
+						field:  fmt.Sprintf("DeadLetterMessageList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeadLetterMessagesResponseValidationError{
+						field:  fmt.Sprintf("DeadLetterMessageList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLetterMessagesResponseValidationError{
+					field:  fmt.Sprintf("DeadLetterMessageList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return ListDeadLetterMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLetterMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListDeadLetterMessagesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListDeadLetterMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLetterMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLetterMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListDeadLetterMessagesResponseValidationError is the validation error
+// returned by ListDeadLetterMessagesResponse.Validate if the designated
+// constraints aren't met.
+type ListDeadLetterMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLetterMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLetterMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLetterMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLetterMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLetterMessagesResponseValidationError) ErrorName() string {
+	return "ListDeadLetterMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLetterMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLetterMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLetterMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLetterMessagesResponseValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLetterMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLetterMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetterMessageRequestMultiError, or nil if none found.
+func (m *ReplayDeadLetterMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLetterMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeadLetterMessageId
+
+	if len(errors) > 0 {
+		return ReplayDeadLetterMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by ReplayDeadLetterMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReplayDeadLetterMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLetterMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLetterMessageRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLetterMessageRequestValidationError is the validation error
+// returned by ReplayDeadLetterMessageRequest.Validate if the designated
+// constraints aren't met.
+type ReplayDeadLetterMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterMessageRequestValidationError) ErrorName() string {
+	return "ReplayDeadLetterMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterMessageRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLetterMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLetterMessageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReplayDeadLetterMessageResponseMultiError, or nil if none found.
+func (m *ReplayDeadLetterMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLetterMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReplayDeadLetterMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterMessageResponseMultiError is an error wrapping multiple
+// validation errors returned by ReplayDeadLetterMessageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReplayDeadLetterMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLetterMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLetterMessageResponseMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLetterMessageResponseValidationError is the validation error
+// returned by ReplayDeadLetterMessageResponse.Validate if the designated
+// constraints aren't met.
+type ReplayDeadLetterMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterMessageResponseValidationError) ErrorName() string {
+	return "ReplayDeadLetterMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterMessageResponseValidationError{}
+
+// Validate checks the field values on DeleteDeadLetterMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDeadLetterMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDeadLetterMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteDeadLetterMessageRequestMultiError, or nil if none found.
+func (m *DeleteDeadLetterMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDeadLetterMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeadLetterMessageId
+
+	if len(errors) > 0 {
+		return DeleteDeadLetterMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDeadLetterMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteDeadLetterMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteDeadLetterMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDeadLetterMessageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDeadLetterMessageRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDeadLetterMessageRequestValidationError is the validation error
+// returned by DeleteDeadLetterMessageRequest.Validate if the designated
+// constraints aren't met.
+type DeleteDeadLetterMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDeadLetterMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDeadLetterMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDeadLetterMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDeadLetterMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDeadLetterMessageRequestValidationError) ErrorName() string {
+	return "DeleteDeadLetterMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDeadLetterMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDeadLetterMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDeadLetterMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDeadLetterMessageRequestValidationError{}
+
+// Validate checks the field values on DeleteDeadLetterMessageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDeadLetterMessageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDeadLetterMessageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteDeadLetterMessageResponseMultiError, or nil if none found.
+func (m *DeleteDeadLetterMessageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDeadLetterMessageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteDeadLetterMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDeadLetterMessageResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDeadLetterMessageResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteDeadLetterMessageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDeadLetterMessageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDeadLetterMessageResponseMultiError) AllErrors() []error { return m }
+
+// DeleteDeadLetterMessageResponseValidationError is the validation error
+// returned by DeleteDeadLetterMessageResponse.Validate if the designated
+// constraints aren't met.
+type DeleteDeadLetterMessageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDeadLetterMessageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDeadLetterMessageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDeadLetterMessageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDeadLetterMessageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDeadLetterMessageResponseValidationError) ErrorName() string {
+	return "DeleteDeadLetterMessageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDeadLetterMessageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDeadLetterMessageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDeadLetterMessageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDeadLetterMessageResponseValidationError{}
+
+// Validate checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *APIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on APIKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in APIKeyMultiError, or nil if none found.
+func (m *APIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *APIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for KeyPrefix
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, APIKeyValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return APIKeyValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return APIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// APIKeyMultiError is an error wrapping multiple validation errors returned by
+// APIKey.ValidateAll() if the designated constraints aren't met.
+type APIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m APIKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m APIKeyMultiError) AllErrors() []error { return m }
+
+// APIKeyValidationError is the validation error returned by APIKey.Validate if
+// the designated constraints aren't met.
+type APIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyValidationError) ErrorName() string { return "APIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyValidationError{}
+
+// Validate checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyRequestMultiError, or nil if none found.
+func (m *CreateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetScopeList()) < 1 {
+		err := CreateAPIKeyRequestValidationError{
+			field:  "ScopeList",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateAPIKeyRequest_ScopeList_Unique := make(map[APIKeyScope]struct{}, len(m.GetScopeList()))
+
+	for idx, item := range m.GetScopeList() {
+		_, _ = idx, item
+
+		if _, exists := _CreateAPIKeyRequest_ScopeList_Unique[item]; exists {
+			err := CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("ScopeList[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateAPIKeyRequest_ScopeList_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _CreateAPIKeyRequest_ScopeList_NotInLookup[item]; ok {
+			err := CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("ScopeList[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := APIKeyScope_name[int32(item)]; !ok {
+			err := CreateAPIKeyRequestValidationError{
+				field:  fmt.Sprintf("ScopeList[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = CreateAPIKeyRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := CreateAPIKeyRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyRequestValidationError is the validation error returned by
+// CreateAPIKeyRequest.Validate if the designated constraints aren't met.
+type CreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyRequestValidationError) ErrorName() string {
+	return "CreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyRequestValidationError{}
+
+var _CreateAPIKeyRequest_ScopeList_NotInLookup = map[APIKeyScope]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAPIKeyResponseMultiError, or nil if none found.
+func (m *CreateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApiKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAPIKeyResponseValidationError{
+					field:  "ApiKey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAPIKeyResponseValidationError{
+				field:  "ApiKey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return CreateAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// CreateAPIKeyResponseValidationError is the validation error returned by
+// CreateAPIKeyResponse.Validate if the designated constraints aren't met.
+type CreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAPIKeyResponseValidationError) ErrorName() string {
+	return "CreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysRequestMultiError, or nil if none found.
+func (m *ListAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListAPIKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAPIKeysRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysRequestValidationError is the validation error returned by
+// ListAPIKeysRequest.Validate if the designated constraints aren't met.
+type ListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysRequestValidationError) ErrorName() string {
+	return "ListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysRequestValidationError{}
+
+// Validate checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAPIKeysResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAPIKeysResponseMultiError, or nil if none found.
+func (m *ListAPIKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApiKeyList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("ApiKeyList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAPIKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAPIKeysResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIKeysResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAPIKeysResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAPIKeysResponseMultiError) AllErrors() []error { return m }
+
+// ListAPIKeysResponseValidationError is the validation error returned by
+// ListAPIKeysResponse.Validate if the designated constraints aren't met.
+type ListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAPIKeysResponseValidationError) ErrorName() string {
+	return "ListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *RevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ApiKeyId
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyRequestValidationError is the validation error returned by
+// RevokeAPIKeyRequest.Validate if the designated constraints aren't met.
+type RevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "RevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyRequestValidationError{}
+
+// Validate checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeAPIKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *RevokeAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeAPIKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeAPIKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeAPIKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// RevokeAPIKeyResponseValidationError is the validation error returned by
+// RevokeAPIKeyResponse.Validate if the designated constraints aren't met.
+type RevokeAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "RevokeAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeAPIKeyResponseValidationError{}
+
+// Validate checks the field values on JSONWebKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JSONWebKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JSONWebKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JSONWebKeyMultiError, or
+// nil if none found.
+func (m *JSONWebKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JSONWebKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kty
+
+	// no validation rules for Use
+
+	// no validation rules for Alg
+
+	// no validation rules for Kid
+
+	// no validation rules for N
+
+	// no validation rules for E
+
+	if len(errors) > 0 {
+		return JSONWebKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// JSONWebKeyMultiError is an error wrapping multiple validation errors
+// returned by JSONWebKey.ValidateAll() if the designated constraints aren't met.
+type JSONWebKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JSONWebKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JSONWebKeyMultiError) AllErrors() []error { return m }
+
+// JSONWebKeyValidationError is the validation error returned by
+// JSONWebKey.Validate if the designated constraints aren't met.
+type JSONWebKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JSONWebKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JSONWebKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JSONWebKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JSONWebKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JSONWebKeyValidationError) ErrorName() string { return "JSONWebKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JSONWebKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJSONWebKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JSONWebKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JSONWebKeyValidationError{}
+
+// Validate checks the field values on GetJSONWebKeySetRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJSONWebKeySetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJSONWebKeySetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJSONWebKeySetRequestMultiError, or nil if none found.
+func (m *GetJSONWebKeySetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJSONWebKeySetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetJSONWebKeySetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJSONWebKeySetRequestMultiError is an error wrapping multiple validation
+// errors returned by GetJSONWebKeySetRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetJSONWebKeySetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJSONWebKeySetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJSONWebKeySetRequestMultiError) AllErrors() []error { return m }
+
+// GetJSONWebKeySetRequestValidationError is the validation error returned by
+// GetJSONWebKeySetRequest.Validate if the designated constraints aren't met.
+type GetJSONWebKeySetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJSONWebKeySetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJSONWebKeySetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJSONWebKeySetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJSONWebKeySetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJSONWebKeySetRequestValidationError) ErrorName() string {
+	return "GetJSONWebKeySetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJSONWebKeySetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJSONWebKeySetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJSONWebKeySetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJSONWebKeySetRequestValidationError{}
+
+// Validate checks the field values on GetJSONWebKeySetResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetJSONWebKeySetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetJSONWebKeySetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetJSONWebKeySetResponseMultiError, or nil if none found.
+func (m *GetJSONWebKeySetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetJSONWebKeySetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetJSONWebKeySetResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetJSONWebKeySetResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetJSONWebKeySetResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetJSONWebKeySetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetJSONWebKeySetResponseMultiError is an error wrapping multiple validation
+// errors returned by GetJSONWebKeySetResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetJSONWebKeySetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetJSONWebKeySetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetJSONWebKeySetResponseMultiError) AllErrors() []error { return m }
+
+// GetJSONWebKeySetResponseValidationError is the validation error returned by
+// GetJSONWebKeySetResponse.Validate if the designated constraints aren't met.
+type GetJSONWebKeySetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetJSONWebKeySetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetJSONWebKeySetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetJSONWebKeySetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetJSONWebKeySetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetJSONWebKeySetResponseValidationError) ErrorName() string {
+	return "GetJSONWebKeySetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetJSONWebKeySetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetJSONWebKeySetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetJSONWebKeySetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetJSONWebKeySetResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountRetentionPolicyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *UpdateAccountRetentionPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRetentionPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateAccountRetentionPolicyRequestMultiError, or nil if none found.
+func (m *UpdateAccountRetentionPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRetentionPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetDownloadTaskRetention(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateAccountRetentionPolicyRequestValidationError{
+				field:  "DownloadTaskRetention",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := UpdateAccountRetentionPolicyRequestValidationError{
+					field:  "DownloadTaskRetention",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountRetentionPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRetentionPolicyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateAccountRetentionPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountRetentionPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRetentionPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRetentionPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRetentionPolicyRequestValidationError is the validation error
+// returned by UpdateAccountRetentionPolicyRequest.Validate if the designated
+// constraints aren't met.
+type UpdateAccountRetentionPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRetentionPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRetentionPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRetentionPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRetentionPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRetentionPolicyRequestValidationError) ErrorName() string {
+	return "UpdateAccountRetentionPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRetentionPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRetentionPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRetentionPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRetentionPolicyRequestValidationError{}
+
+// Validate checks the field values on UpdateAccountRetentionPolicyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateAccountRetentionPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountRetentionPolicyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateAccountRetentionPolicyResponseMultiError, or nil if none found.
+func (m *UpdateAccountRetentionPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountRetentionPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountRetentionPolicyResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountRetentionPolicyResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountRetentionPolicyResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountRetentionPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountRetentionPolicyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// UpdateAccountRetentionPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountRetentionPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountRetentionPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountRetentionPolicyResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountRetentionPolicyResponseValidationError is the validation error
+// returned by UpdateAccountRetentionPolicyResponse.Validate if the designated
+// constraints aren't met.
+type UpdateAccountRetentionPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountRetentionPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountRetentionPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountRetentionPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountRetentionPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountRetentionPolicyResponseValidationError) ErrorName() string {
+	return "UpdateAccountRetentionPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountRetentionPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountRetentionPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountRetentionPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountRetentionPolicyResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountPostProcessorListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateAccountPostProcessorListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountPostProcessorListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// UpdateAccountPostProcessorListRequestMultiError, or nil if none found.
+func (m *UpdateAccountPostProcessorListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountPostProcessorListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPostProcessorList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountPostProcessorListRequestValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountPostProcessorListRequestValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPostProcessorList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountPostProcessorListRequestValidationError{
+				field:  "PostProcessorList",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountPostProcessorListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountPostProcessorListRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateAccountPostProcessorListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountPostProcessorListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountPostProcessorListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountPostProcessorListRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountPostProcessorListRequestValidationError is the validation error
+// returned by UpdateAccountPostProcessorListRequest.Validate if the
+// designated constraints aren't met.
+type UpdateAccountPostProcessorListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountPostProcessorListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountPostProcessorListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountPostProcessorListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountPostProcessorListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountPostProcessorListRequestValidationError) ErrorName() string {
+	return "UpdateAccountPostProcessorListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountPostProcessorListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountPostProcessorListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountPostProcessorListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountPostProcessorListRequestValidationError{}
+
+// Validate checks the field values on UpdateAccountPostProcessorListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateAccountPostProcessorListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateAccountPostProcessorListResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateAccountPostProcessorListResponseMultiError, or nil if none found.
+func (m *UpdateAccountPostProcessorListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountPostProcessorListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountPostProcessorListResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountPostProcessorListResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountPostProcessorListResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountPostProcessorListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountPostProcessorListResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateAccountPostProcessorListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAccountPostProcessorListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountPostProcessorListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountPostProcessorListResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountPostProcessorListResponseValidationError is the validation
+// error returned by UpdateAccountPostProcessorListResponse.Validate if the
+// designated constraints aren't met.
+type UpdateAccountPostProcessorListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountPostProcessorListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountPostProcessorListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountPostProcessorListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountPostProcessorListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountPostProcessorListResponseValidationError) ErrorName() string {
+	return "UpdateAccountPostProcessorListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountPostProcessorListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountPostProcessorListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountPostProcessorListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountPostProcessorListResponseValidationError{}
+
+// Validate checks the field values on UpdateAccountEmailRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountEmailRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountEmailRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountEmailRequestMultiError, or nil if none found.
+func (m *UpdateAccountEmailRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountEmailRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 256 {
+			err := UpdateAccountEmailRequestValidationError{
+				field:  "Email",
+				reason: "value length must be at most 256 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UpdateAccountEmailRequestValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountEmailRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UpdateAccountEmailRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UpdateAccountEmailRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UpdateAccountEmailRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAccountEmailRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAccountEmailRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountEmailRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountEmailRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAccountEmailRequestValidationError is the validation error returned by
+// UpdateAccountEmailRequest.Validate if the designated constraints aren't met.
+type UpdateAccountEmailRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountEmailRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountEmailRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountEmailRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountEmailRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountEmailRequestValidationError) ErrorName() string {
+	return "UpdateAccountEmailRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountEmailRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountEmailRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountEmailRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountEmailRequestValidationError{}
+
+// Validate checks the field values on UpdateAccountEmailResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAccountEmailResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAccountEmailResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAccountEmailResponseMultiError, or nil if none found.
+func (m *UpdateAccountEmailResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAccountEmailResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAccountEmailResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAccountEmailResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAccountEmailResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAccountEmailResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAccountEmailResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateAccountEmailResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateAccountEmailResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAccountEmailResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAccountEmailResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAccountEmailResponseValidationError is the validation error returned
+// by UpdateAccountEmailResponse.Validate if the designated constraints aren't met.
+type UpdateAccountEmailResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAccountEmailResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAccountEmailResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAccountEmailResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAccountEmailResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAccountEmailResponseValidationError) ErrorName() string {
+	return "UpdateAccountEmailResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAccountEmailResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAccountEmailResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAccountEmailResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAccountEmailResponseValidationError{}
+
+// Validate checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordRequestMultiError, or nil if none found.
+func (m *ChangePasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetOldPassword()) < 1 {
+		err := ChangePasswordRequestValidationError{
+			field:  "OldPassword",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 1 || l > 1024 {
+		err := ChangePasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangePasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordRequestMultiError) AllErrors() []error { return m }
+
+// ChangePasswordRequestValidationError is the validation error returned by
+// ChangePasswordRequest.Validate if the designated constraints aren't met.
+type ChangePasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordRequestValidationError) ErrorName() string {
+	return "ChangePasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordRequestValidationError{}
+
+// Validate checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePasswordResponseMultiError, or nil if none found.
+func (m *ChangePasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ChangePasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangePasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangePasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePasswordResponseMultiError) AllErrors() []error { return m }
+
+// ChangePasswordResponseValidationError is the validation error returned by
+// ChangePasswordResponse.Validate if the designated constraints aren't met.
+type ChangePasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePasswordResponseValidationError) ErrorName() string {
+	return "ChangePasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangePasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePasswordResponseValidationError{}
+
+// Validate checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetRequestMultiError, or nil if none found.
+func (m *RequestPasswordResetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RequestPasswordResetRequest_AccountName_Pattern.MatchString(m.GetAccountName()) {
+		err := RequestPasswordResetRequestValidationError{
+			field:  "AccountName",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{6,32}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RequestPasswordResetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetRequestMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetRequestMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetRequestValidationError is the validation error returned
+// by RequestPasswordResetRequest.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetRequestValidationError) ErrorName() string {
+	return "RequestPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetRequestValidationError{}
+
+var _RequestPasswordResetRequest_AccountName_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{6,32}$")
+
+// Validate checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RequestPasswordResetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RequestPasswordResetResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RequestPasswordResetResponseMultiError, or nil if none found.
+func (m *RequestPasswordResetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RequestPasswordResetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RequestPasswordResetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RequestPasswordResetResponseMultiError is an error wrapping multiple
+// validation errors returned by RequestPasswordResetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RequestPasswordResetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RequestPasswordResetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RequestPasswordResetResponseMultiError) AllErrors() []error { return m }
+
+// RequestPasswordResetResponseValidationError is the validation error returned
+// by RequestPasswordResetResponse.Validate if the designated constraints
+// aren't met.
+type RequestPasswordResetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestPasswordResetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestPasswordResetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestPasswordResetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestPasswordResetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestPasswordResetResponseValidationError) ErrorName() string {
+	return "RequestPasswordResetResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestPasswordResetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestPasswordResetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestPasswordResetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestPasswordResetResponseValidationError{}
+
+// Validate checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordRequestMultiError, or nil if none found.
+func (m *ResetPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetResetToken()) < 1 {
+		err := ResetPasswordRequestValidationError{
+			field:  "ResetToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNewPassword()); l < 1 || l > 1024 {
+		err := ResetPasswordRequestValidationError{
+			field:  "NewPassword",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordRequestMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetPasswordRequestValidationError is the validation error returned by
+// ResetPasswordRequest.Validate if the designated constraints aren't met.
+type ResetPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordRequestValidationError) ErrorName() string {
+	return "ResetPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordRequestValidationError{}
+
+// Validate checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResetPasswordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetPasswordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetPasswordResponseMultiError, or nil if none found.
+func (m *ResetPasswordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetPasswordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ResetPasswordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetPasswordResponseMultiError is an error wrapping multiple validation
+// errors returned by ResetPasswordResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResetPasswordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetPasswordResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetPasswordResponseMultiError) AllErrors() []error { return m }
+
+// ResetPasswordResponseValidationError is the validation error returned by
+// ResetPasswordResponse.Validate if the designated constraints aren't met.
+type ResetPasswordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetPasswordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetPasswordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetPasswordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetPasswordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetPasswordResponseValidationError) ErrorName() string {
+	return "ResetPasswordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetPasswordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetPasswordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetPasswordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetPasswordResponseValidationError{}
+
+// Validate checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountRequestMultiError, or nil if none found.
+func (m *DeleteAccountRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := DeleteAccountRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAccountRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAccountRequestValidationError is the validation error returned by
+// DeleteAccountRequest.Validate if the designated constraints aren't met.
+type DeleteAccountRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountRequestValidationError) ErrorName() string {
+	return "DeleteAccountRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountRequestValidationError{}
+
+// Validate checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAccountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAccountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAccountResponseMultiError, or nil if none found.
+func (m *DeleteAccountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAccountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAccountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAccountResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAccountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAccountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAccountResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAccountResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAccountResponseValidationError is the validation error returned by
+// DeleteAccountResponse.Validate if the designated constraints aren't met.
+type DeleteAccountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAccountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAccountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAccountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAccountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAccountResponseValidationError) ErrorName() string {
+	return "DeleteAccountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAccountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAccountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAccountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAccountResponseValidationError{}
+
+// Validate checks the field values on VerifySessionChallengeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifySessionChallengeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifySessionChallengeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VerifySessionChallengeRequestMultiError, or nil if none found.
+func (m *VerifySessionChallengeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifySessionChallengeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetChallengeToken()) < 1 {
+		err := VerifySessionChallengeRequestValidationError{
+			field:  "ChallengeToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofCodePresent := false
+	switch v := m.Code.(type) {
+	case *VerifySessionChallengeRequest_TotpCode:
+		if v == nil {
+			err := VerifySessionChallengeRequestValidationError{
+				field:  "Code",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofCodePresent = true
+
+		if !_VerifySessionChallengeRequest_TotpCode_Pattern.MatchString(m.GetTotpCode()) {
+			err := VerifySessionChallengeRequestValidationError{
+				field:  "TotpCode",
+				reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *VerifySessionChallengeRequest_RecoveryCode:
+		if v == nil {
+			err := VerifySessionChallengeRequestValidationError{
+				field:  "Code",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofCodePresent = true
+
+		if l := utf8.RuneCountInString(m.GetRecoveryCode()); l < 1 || l > 32 {
+			err := VerifySessionChallengeRequestValidationError{
+				field:  "RecoveryCode",
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofCodePresent {
+		err := VerifySessionChallengeRequestValidationError{
+			field:  "Code",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifySessionChallengeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifySessionChallengeRequestMultiError is an error wrapping multiple
+// validation errors returned by VerifySessionChallengeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type VerifySessionChallengeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifySessionChallengeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifySessionChallengeRequestMultiError) AllErrors() []error { return m }
+
+// VerifySessionChallengeRequestValidationError is the validation error
+// returned by VerifySessionChallengeRequest.Validate if the designated
+// constraints aren't met.
+type VerifySessionChallengeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifySessionChallengeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifySessionChallengeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifySessionChallengeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifySessionChallengeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifySessionChallengeRequestValidationError) ErrorName() string {
+	return "VerifySessionChallengeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifySessionChallengeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifySessionChallengeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifySessionChallengeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifySessionChallengeRequestValidationError{}
+
+var _VerifySessionChallengeRequest_TotpCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on VerifySessionChallengeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifySessionChallengeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifySessionChallengeResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// VerifySessionChallengeResponseMultiError, or nil if none found.
+func (m *VerifySessionChallengeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifySessionChallengeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifySessionChallengeResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifySessionChallengeResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifySessionChallengeResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VerifySessionChallengeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifySessionChallengeResponseMultiError is an error wrapping multiple
+// validation errors returned by VerifySessionChallengeResponse.ValidateAll()
+// if the designated constraints aren't met.
+type VerifySessionChallengeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifySessionChallengeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifySessionChallengeResponseMultiError) AllErrors() []error { return m }
+
+// VerifySessionChallengeResponseValidationError is the validation error
+// returned by VerifySessionChallengeResponse.Validate if the designated
+// constraints aren't met.
+type VerifySessionChallengeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifySessionChallengeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifySessionChallengeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifySessionChallengeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifySessionChallengeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifySessionChallengeResponseValidationError) ErrorName() string {
+	return "VerifySessionChallengeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifySessionChallengeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifySessionChallengeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifySessionChallengeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifySessionChallengeResponseValidationError{}
+
+// Validate checks the field values on StartOIDCLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartOIDCLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartOIDCLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartOIDCLoginRequestMultiError, or nil if none found.
+func (m *StartOIDCLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartOIDCLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StartOIDCLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartOIDCLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by StartOIDCLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartOIDCLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartOIDCLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartOIDCLoginRequestMultiError) AllErrors() []error { return m }
+
+// StartOIDCLoginRequestValidationError is the validation error returned by
+// StartOIDCLoginRequest.Validate if the designated constraints aren't met.
+type StartOIDCLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartOIDCLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartOIDCLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartOIDCLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartOIDCLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartOIDCLoginRequestValidationError) ErrorName() string {
+	return "StartOIDCLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartOIDCLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartOIDCLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartOIDCLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartOIDCLoginRequestValidationError{}
+
+// Validate checks the field values on StartOIDCLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartOIDCLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartOIDCLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartOIDCLoginResponseMultiError, or nil if none found.
+func (m *StartOIDCLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartOIDCLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuthorizationUrl
+
+	// no validation rules for State
+
+	if len(errors) > 0 {
+		return StartOIDCLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartOIDCLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by StartOIDCLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartOIDCLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartOIDCLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartOIDCLoginResponseMultiError) AllErrors() []error { return m }
+
+// StartOIDCLoginResponseValidationError is the validation error returned by
+// StartOIDCLoginResponse.Validate if the designated constraints aren't met.
+type StartOIDCLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartOIDCLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartOIDCLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartOIDCLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartOIDCLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartOIDCLoginResponseValidationError) ErrorName() string {
+	return "StartOIDCLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartOIDCLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartOIDCLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartOIDCLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartOIDCLoginResponseValidationError{}
+
+// Validate checks the field values on FinishOIDCLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishOIDCLoginRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishOIDCLoginRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishOIDCLoginRequestMultiError, or nil if none found.
+func (m *FinishOIDCLoginRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishOIDCLoginRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetState()); l < 1 || l > 256 {
+		err := FinishOIDCLoginRequestValidationError{
+			field:  "State",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 2048 {
+		err := FinishOIDCLoginRequestValidationError{
+			field:  "Code",
+			reason: "value length must be between 1 and 2048 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FinishOIDCLoginRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishOIDCLoginRequestMultiError is an error wrapping multiple validation
+// errors returned by FinishOIDCLoginRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FinishOIDCLoginRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishOIDCLoginRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishOIDCLoginRequestMultiError) AllErrors() []error { return m }
+
+// FinishOIDCLoginRequestValidationError is the validation error returned by
+// FinishOIDCLoginRequest.Validate if the designated constraints aren't met.
+type FinishOIDCLoginRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishOIDCLoginRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishOIDCLoginRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishOIDCLoginRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishOIDCLoginRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishOIDCLoginRequestValidationError) ErrorName() string {
+	return "FinishOIDCLoginRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishOIDCLoginRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishOIDCLoginRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishOIDCLoginRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishOIDCLoginRequestValidationError{}
+
+// Validate checks the field values on FinishOIDCLoginResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinishOIDCLoginResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinishOIDCLoginResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FinishOIDCLoginResponseMultiError, or nil if none found.
+func (m *FinishOIDCLoginResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinishOIDCLoginResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FinishOIDCLoginResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FinishOIDCLoginResponseValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FinishOIDCLoginResponseValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FinishOIDCLoginResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinishOIDCLoginResponseMultiError is an error wrapping multiple validation
+// errors returned by FinishOIDCLoginResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FinishOIDCLoginResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinishOIDCLoginResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinishOIDCLoginResponseMultiError) AllErrors() []error { return m }
+
+// FinishOIDCLoginResponseValidationError is the validation error returned by
+// FinishOIDCLoginResponse.Validate if the designated constraints aren't met.
+type FinishOIDCLoginResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinishOIDCLoginResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinishOIDCLoginResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinishOIDCLoginResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinishOIDCLoginResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinishOIDCLoginResponseValidationError) ErrorName() string {
+	return "FinishOIDCLoginResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinishOIDCLoginResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinishOIDCLoginResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinishOIDCLoginResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinishOIDCLoginResponseValidationError{}
+
+// Validate checks the field values on EnrollTOTPRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPRequestMultiError, or nil if none found.
+func (m *EnrollTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return EnrollTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPRequestMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPRequestValidationError is the validation error returned by
+// EnrollTOTPRequest.Validate if the designated constraints aren't met.
+type EnrollTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPRequestValidationError) ErrorName() string {
+	return "EnrollTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPRequestValidationError{}
+
+// Validate checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EnrollTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EnrollTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EnrollTOTPResponseMultiError, or nil if none found.
+func (m *EnrollTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EnrollTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Secret
+
+	// no validation rules for OtpauthUri
+
+	if len(errors) > 0 {
+		return EnrollTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// EnrollTOTPResponseMultiError is an error wrapping multiple validation errors
+// returned by EnrollTOTPResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EnrollTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EnrollTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EnrollTOTPResponseMultiError) AllErrors() []error { return m }
+
+// EnrollTOTPResponseValidationError is the validation error returned by
+// EnrollTOTPResponse.Validate if the designated constraints aren't met.
+type EnrollTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EnrollTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EnrollTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EnrollTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EnrollTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EnrollTOTPResponseValidationError) ErrorName() string {
+	return "EnrollTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EnrollTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEnrollTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EnrollTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EnrollTOTPResponseValidationError{}
+
+// Validate checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPRequestMultiError, or nil if none found.
+func (m *ConfirmTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ConfirmTOTPRequest_TotpCode_Pattern.MatchString(m.GetTotpCode()) {
+		err := ConfirmTOTPRequestValidationError{
+			field:  "TotpCode",
+			reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by ConfirmTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ConfirmTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPRequestValidationError is the validation error returned by
+// ConfirmTOTPRequest.Validate if the designated constraints aren't met.
+type ConfirmTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPRequestValidationError) ErrorName() string {
+	return "ConfirmTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPRequestValidationError{}
+
+var _ConfirmTOTPRequest_TotpCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTOTPResponseMultiError, or nil if none found.
+func (m *ConfirmTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ConfirmTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTOTPResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTOTPResponseValidationError is the validation error returned by
+// ConfirmTOTPResponse.Validate if the designated constraints aren't met.
+type ConfirmTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTOTPResponseValidationError) ErrorName() string {
+	return "ConfirmTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTOTPResponseValidationError{}
+
+// Validate checks the field values on DisableTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTPRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTPRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTPRequestMultiError, or nil if none found.
+func (m *DisableTOTPRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTPRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPassword()) < 1 {
+		err := DisableTOTPRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofCodePresent := false
+	switch v := m.Code.(type) {
+	case *DisableTOTPRequest_TotpCode:
+		if v == nil {
+			err := DisableTOTPRequestValidationError{
+				field:  "Code",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofCodePresent = true
+
+		if !_DisableTOTPRequest_TotpCode_Pattern.MatchString(m.GetTotpCode()) {
+			err := DisableTOTPRequestValidationError{
+				field:  "TotpCode",
+				reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *DisableTOTPRequest_RecoveryCode:
+		if v == nil {
+			err := DisableTOTPRequestValidationError{
+				field:  "Code",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofCodePresent = true
+
+		if l := utf8.RuneCountInString(m.GetRecoveryCode()); l < 1 || l > 32 {
+			err := DisableTOTPRequestValidationError{
+				field:  "RecoveryCode",
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofCodePresent {
+		err := DisableTOTPRequestValidationError{
+			field:  "Code",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DisableTOTPRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTPRequestMultiError is an error wrapping multiple validation errors
+// returned by DisableTOTPRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DisableTOTPRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTPRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTPRequestMultiError) AllErrors() []error { return m }
+
+// DisableTOTPRequestValidationError is the validation error returned by
+// DisableTOTPRequest.Validate if the designated constraints aren't met.
+type DisableTOTPRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTPRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTPRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTPRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTPRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTPRequestValidationError) ErrorName() string {
+	return "DisableTOTPRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTPRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTPRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTPRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTPRequestValidationError{}
+
+var _DisableTOTPRequest_TotpCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on DisableTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DisableTOTPResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DisableTOTPResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DisableTOTPResponseMultiError, or nil if none found.
+func (m *DisableTOTPResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DisableTOTPResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DisableTOTPResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DisableTOTPResponseMultiError is an error wrapping multiple validation
+// errors returned by DisableTOTPResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DisableTOTPResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DisableTOTPResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DisableTOTPResponseMultiError) AllErrors() []error { return m }
+
+// DisableTOTPResponseValidationError is the validation error returned by
+// DisableTOTPResponse.Validate if the designated constraints aren't met.
+type DisableTOTPResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DisableTOTPResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DisableTOTPResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DisableTOTPResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DisableTOTPResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DisableTOTPResponseValidationError) ErrorName() string {
+	return "DisableTOTPResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DisableTOTPResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDisableTOTPResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DisableTOTPResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DisableTOTPResponseValidationError{}
+
+// Validate checks the field values on Team with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Team) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Team with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TeamMultiError, or nil if none found.
+func (m *Team) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Team) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if len(errors) > 0 {
+		return TeamMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamMultiError is an error wrapping multiple validation errors returned by
+// Team.ValidateAll() if the designated constraints aren't met.
+type TeamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamMultiError) AllErrors() []error { return m }
+
+// TeamValidationError is the validation error returned by Team.Validate if the
+// designated constraints aren't met.
+type TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamValidationError) ErrorName() string { return "TeamValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeam.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamValidationError{}
+
+// Validate checks the field values on TeamMember with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamMember with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamMemberMultiError, or
+// nil if none found.
+func (m *TeamMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamMemberValidationError{
+					field:  "Account",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamMemberValidationError{
+				field:  "Account",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamMemberValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamMemberValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TeamMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamMemberMultiError is an error wrapping multiple validation errors
+// returned by TeamMember.ValidateAll() if the designated constraints aren't met.
+type TeamMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamMemberMultiError) AllErrors() []error { return m }
+
+// TeamMemberValidationError is the validation error returned by
+// TeamMember.Validate if the designated constraints aren't met.
+type TeamMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamMemberValidationError) ErrorName() string { return "TeamMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamMemberValidationError{}
+
+// Validate checks the field values on TeamInvitation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TeamInvitation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TeamInvitation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TeamInvitationMultiError,
+// or nil if none found.
+func (m *TeamInvitation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TeamInvitation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for TeamId
+
+	// no validation rules for TeamName
+
+	if all {
+		switch v := interface{}(m.GetInvitee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "Invitee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "Invitee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInvitee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamInvitationValidationError{
+				field:  "Invitee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Role
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamInvitationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TeamInvitationValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TeamInvitationValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TeamInvitationMultiError(errors)
+	}
+
+	return nil
+}
+
+// TeamInvitationMultiError is an error wrapping multiple validation errors
+// returned by TeamInvitation.ValidateAll() if the designated constraints
+// aren't met.
+type TeamInvitationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TeamInvitationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TeamInvitationMultiError) AllErrors() []error { return m }
+
+// TeamInvitationValidationError is the validation error returned by
+// TeamInvitation.Validate if the designated constraints aren't met.
+type TeamInvitationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TeamInvitationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TeamInvitationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TeamInvitationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TeamInvitationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TeamInvitationValidationError) ErrorName() string { return "TeamInvitationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TeamInvitationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTeamInvitation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TeamInvitationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TeamInvitationValidationError{}
+
+// Validate checks the field values on CreateTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamRequestMultiError, or nil if none found.
+func (m *CreateTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := CreateTeamRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamRequestMultiError) AllErrors() []error { return m }
+
+// CreateTeamRequestValidationError is the validation error returned by
+// CreateTeamRequest.Validate if the designated constraints aren't met.
+type CreateTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamRequestValidationError) ErrorName() string {
+	return "CreateTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamRequestValidationError{}
+
+// Validate checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamResponseMultiError, or nil if none found.
+func (m *CreateTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTeamResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTeamResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamResponseMultiError) AllErrors() []error { return m }
+
+// CreateTeamResponseValidationError is the validation error returned by
+// CreateTeamResponse.Validate if the designated constraints aren't met.
+type CreateTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamResponseValidationError) ErrorName() string {
+	return "CreateTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamResponseValidationError{}
+
+// Validate checks the field values on ListTeamsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsRequestMultiError, or nil if none found.
+func (m *ListTeamsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTeamsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamsRequestValidationError is the validation error returned by
+// ListTeamsRequest.Validate if the designated constraints aren't met.
+type ListTeamsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsRequestValidationError) ErrorName() string { return "ListTeamsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTeamsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsRequestValidationError{}
+
+// Validate checks the field values on ListTeamsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamsResponseMultiError, or nil if none found.
+func (m *ListTeamsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeamList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("TeamList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamsResponseValidationError{
+						field:  fmt.Sprintf("TeamList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamsResponseValidationError{
+					field:  fmt.Sprintf("TeamList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTeamsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTeamsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamsResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamsResponseValidationError is the validation error returned by
+// ListTeamsResponse.Validate if the designated constraints aren't met.
+type ListTeamsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamsResponseValidationError) ErrorName() string {
+	return "ListTeamsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamsResponseValidationError{}
+
+// Validate checks the field values on DeleteTeamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamRequestMultiError, or nil if none found.
+func (m *DeleteTeamRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return DeleteTeamRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTeamRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTeamRequestValidationError is the validation error returned by
+// DeleteTeamRequest.Validate if the designated constraints aren't met.
+type DeleteTeamRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamRequestValidationError) ErrorName() string {
+	return "DeleteTeamRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamRequestValidationError{}
+
+// Validate checks the field values on DeleteTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamResponseMultiError, or nil if none found.
+func (m *DeleteTeamResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTeamResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTeamResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteTeamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteTeamResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTeamResponseValidationError is the validation error returned by
+// DeleteTeamResponse.Validate if the designated constraints aren't met.
+type DeleteTeamResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamResponseValidationError) ErrorName() string {
+	return "DeleteTeamResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamResponseValidationError{}
+
+// Validate checks the field values on ListTeamMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamMembersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamMembersRequestMultiError, or nil if none found.
+func (m *ListTeamMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return ListTeamMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamMembersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTeamMembersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTeamMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamMembersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamMembersRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamMembersRequestValidationError is the validation error returned by
+// ListTeamMembersRequest.Validate if the designated constraints aren't met.
+type ListTeamMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamMembersRequestValidationError) ErrorName() string {
+	return "ListTeamMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamMembersRequestValidationError{}
+
+// Validate checks the field values on ListTeamMembersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamMembersResponseMultiError, or nil if none found.
+func (m *ListTeamMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeamMemberList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamMembersResponseValidationError{
+						field:  fmt.Sprintf("TeamMemberList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamMembersResponseValidationError{
+						field:  fmt.Sprintf("TeamMemberList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamMembersResponseValidationError{
+					field:  fmt.Sprintf("TeamMemberList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamMembersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTeamMembersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTeamMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamMembersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamMembersResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamMembersResponseValidationError is the validation error returned by
+// ListTeamMembersResponse.Validate if the designated constraints aren't met.
+type ListTeamMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamMembersResponseValidationError) ErrorName() string {
+	return "ListTeamMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamMembersResponseValidationError{}
+
+// Validate checks the field values on UpdateTeamMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamMemberRoleRequestMultiError, or nil if none found.
+func (m *UpdateTeamMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	// no validation rules for AccountId
+
+	if _, ok := _UpdateTeamMemberRoleRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := UpdateTeamMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [TEAM_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TeamRole_name[int32(m.GetRole())]; !ok {
+		err := UpdateTeamMemberRoleRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTeamMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateTeamMemberRoleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTeamMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamMemberRoleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTeamMemberRoleRequestValidationError is the validation error returned
+// by UpdateTeamMemberRoleRequest.Validate if the designated constraints
+// aren't met.
+type UpdateTeamMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamMemberRoleRequestValidationError) ErrorName() string {
+	return "UpdateTeamMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamMemberRoleRequestValidationError{}
+
+var _UpdateTeamMemberRoleRequest_Role_NotInLookup = map[TeamRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on UpdateTeamMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTeamMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTeamMemberRoleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTeamMemberRoleResponseMultiError, or nil if none found.
+func (m *UpdateTeamMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTeamMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeamMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTeamMemberRoleResponseValidationError{
+					field:  "TeamMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTeamMemberRoleResponseValidationError{
+					field:  "TeamMember",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeamMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTeamMemberRoleResponseValidationError{
+				field:  "TeamMember",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateTeamMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTeamMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateTeamMemberRoleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTeamMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTeamMemberRoleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTeamMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// UpdateTeamMemberRoleResponseValidationError is the validation error returned
+// by UpdateTeamMemberRoleResponse.Validate if the designated constraints
+// aren't met.
+type UpdateTeamMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTeamMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTeamMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTeamMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTeamMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTeamMemberRoleResponseValidationError) ErrorName() string {
+	return "UpdateTeamMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTeamMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTeamMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTeamMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTeamMemberRoleResponseValidationError{}
+
+// Validate checks the field values on RemoveTeamMemberRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTeamMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTeamMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTeamMemberRequestMultiError, or nil if none found.
+func (m *RemoveTeamMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTeamMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	// no validation rules for AccountId
+
+	if len(errors) > 0 {
+		return RemoveTeamMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTeamMemberRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveTeamMemberRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveTeamMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTeamMemberRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTeamMemberRequestMultiError) AllErrors() []error { return m }
+
+// RemoveTeamMemberRequestValidationError is the validation error returned by
+// RemoveTeamMemberRequest.Validate if the designated constraints aren't met.
+type RemoveTeamMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTeamMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTeamMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTeamMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTeamMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTeamMemberRequestValidationError) ErrorName() string {
+	return "RemoveTeamMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTeamMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTeamMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTeamMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTeamMemberRequestValidationError{}
+
+// Validate checks the field values on RemoveTeamMemberResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveTeamMemberResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveTeamMemberResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveTeamMemberResponseMultiError, or nil if none found.
+func (m *RemoveTeamMemberResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveTeamMemberResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveTeamMemberResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveTeamMemberResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveTeamMemberResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveTeamMemberResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveTeamMemberResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveTeamMemberResponseMultiError) AllErrors() []error { return m }
+
+// RemoveTeamMemberResponseValidationError is the validation error returned by
+// RemoveTeamMemberResponse.Validate if the designated constraints aren't met.
+type RemoveTeamMemberResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveTeamMemberResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveTeamMemberResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveTeamMemberResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveTeamMemberResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveTeamMemberResponseValidationError) ErrorName() string {
+	return "RemoveTeamMemberResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveTeamMemberResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveTeamMemberResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveTeamMemberResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveTeamMemberResponseValidationError{}
+
+// Validate checks the field values on CreateTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamInvitationRequestMultiError, or nil if none found.
+func (m *CreateTeamInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	if l := utf8.RuneCountInString(m.GetInviteeAccountName()); l < 1 || l > 256 {
+		err := CreateTeamInvitationRequestValidationError{
+			field:  "InviteeAccountName",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateTeamInvitationRequest_Role_NotInLookup[m.GetRole()]; ok {
+		err := CreateTeamInvitationRequestValidationError{
+			field:  "Role",
+			reason: "value must not be in list [TEAM_ROLE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TeamRole_name[int32(m.GetRole())]; !ok {
+		err := CreateTeamInvitationRequestValidationError{
+			field:  "Role",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTeamInvitationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTeamInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamInvitationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamInvitationRequestMultiError) AllErrors() []error { return m }
+
+// CreateTeamInvitationRequestValidationError is the validation error returned
+// by CreateTeamInvitationRequest.Validate if the designated constraints
+// aren't met.
+type CreateTeamInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamInvitationRequestValidationError) ErrorName() string {
+	return "CreateTeamInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamInvitationRequestValidationError{}
+
+var _CreateTeamInvitationRequest_Role_NotInLookup = map[TeamRole]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTeamInvitationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTeamInvitationResponseMultiError, or nil if none found.
+func (m *CreateTeamInvitationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTeamInvitationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeamInvitation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTeamInvitationResponseValidationError{
+					field:  "TeamInvitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTeamInvitationResponseValidationError{
+					field:  "TeamInvitation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeamInvitation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTeamInvitationResponseValidationError{
+				field:  "TeamInvitation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTeamInvitationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTeamInvitationResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTeamInvitationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTeamInvitationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTeamInvitationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTeamInvitationResponseMultiError) AllErrors() []error { return m }
+
+// CreateTeamInvitationResponseValidationError is the validation error returned
+// by CreateTeamInvitationResponse.Validate if the designated constraints
+// aren't met.
+type CreateTeamInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTeamInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTeamInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTeamInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTeamInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTeamInvitationResponseValidationError) ErrorName() string {
+	return "CreateTeamInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTeamInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTeamInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTeamInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTeamInvitationResponseValidationError{}
+
+// Validate checks the field values on ListTeamInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamInvitationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamInvitationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamInvitationsRequestMultiError, or nil if none found.
+func (m *ListTeamInvitationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamInvitationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return ListTeamInvitationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamInvitationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListTeamInvitationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListTeamInvitationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamInvitationsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamInvitationsRequestMultiError) AllErrors() []error { return m }
+
+// ListTeamInvitationsRequestValidationError is the validation error returned
+// by ListTeamInvitationsRequest.Validate if the designated constraints aren't met.
+type ListTeamInvitationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamInvitationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamInvitationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamInvitationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamInvitationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamInvitationsRequestValidationError) ErrorName() string {
+	return "ListTeamInvitationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamInvitationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamInvitationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamInvitationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamInvitationsRequestValidationError{}
+
+// Validate checks the field values on ListTeamInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTeamInvitationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTeamInvitationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTeamInvitationsResponseMultiError, or nil if none found.
+func (m *ListTeamInvitationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTeamInvitationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeamInvitationList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTeamInvitationsResponseValidationError{
+						field:  fmt.Sprintf("TeamInvitationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTeamInvitationsResponseValidationError{
+						field:  fmt.Sprintf("TeamInvitationList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTeamInvitationsResponseValidationError{
+					field:  fmt.Sprintf("TeamInvitationList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTeamInvitationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTeamInvitationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTeamInvitationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListTeamInvitationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTeamInvitationsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTeamInvitationsResponseMultiError) AllErrors() []error { return m }
+
+// ListTeamInvitationsResponseValidationError is the validation error returned
+// by ListTeamInvitationsResponse.Validate if the designated constraints
+// aren't met.
+type ListTeamInvitationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTeamInvitationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTeamInvitationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTeamInvitationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTeamInvitationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTeamInvitationsResponseValidationError) ErrorName() string {
+	return "ListTeamInvitationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTeamInvitationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTeamInvitationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTeamInvitationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTeamInvitationsResponseValidationError{}
+
+// Validate checks the field values on AcceptTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptTeamInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptTeamInvitationRequestMultiError, or nil if none found.
+func (m *AcceptTeamInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptTeamInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamInvitationId
+
+	if len(errors) > 0 {
+		return AcceptTeamInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptTeamInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by AcceptTeamInvitationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AcceptTeamInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptTeamInvitationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptTeamInvitationRequestMultiError) AllErrors() []error { return m }
+
+// AcceptTeamInvitationRequestValidationError is the validation error returned
+// by AcceptTeamInvitationRequest.Validate if the designated constraints
+// aren't met.
+type AcceptTeamInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptTeamInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptTeamInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptTeamInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptTeamInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptTeamInvitationRequestValidationError) ErrorName() string {
+	return "AcceptTeamInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptTeamInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptTeamInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptTeamInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptTeamInvitationRequestValidationError{}
+
+// Validate checks the field values on AcceptTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptTeamInvitationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptTeamInvitationResponseMultiError, or nil if none found.
+func (m *AcceptTeamInvitationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptTeamInvitationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptTeamInvitationResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptTeamInvitationResponseValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptTeamInvitationResponseValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptTeamInvitationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptTeamInvitationResponseMultiError is an error wrapping multiple
+// validation errors returned by AcceptTeamInvitationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AcceptTeamInvitationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptTeamInvitationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptTeamInvitationResponseMultiError) AllErrors() []error { return m }
+
+// AcceptTeamInvitationResponseValidationError is the validation error returned
+// by AcceptTeamInvitationResponse.Validate if the designated constraints
+// aren't met.
+type AcceptTeamInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptTeamInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptTeamInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptTeamInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptTeamInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptTeamInvitationResponseValidationError) ErrorName() string {
+	return "AcceptTeamInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptTeamInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptTeamInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptTeamInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptTeamInvitationResponseValidationError{}
+
+// Validate checks the field values on DeleteTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamInvitationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamInvitationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamInvitationRequestMultiError, or nil if none found.
+func (m *DeleteTeamInvitationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamInvitationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TeamInvitationId
+
+	if len(errors) > 0 {
+		return DeleteTeamInvitationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTeamInvitationRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteTeamInvitationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteTeamInvitationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamInvitationRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamInvitationRequestMultiError) AllErrors() []error { return m }
+
+// DeleteTeamInvitationRequestValidationError is the validation error returned
+// by DeleteTeamInvitationRequest.Validate if the designated constraints
+// aren't met.
+type DeleteTeamInvitationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamInvitationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamInvitationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamInvitationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamInvitationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamInvitationRequestValidationError) ErrorName() string {
+	return "DeleteTeamInvitationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamInvitationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamInvitationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamInvitationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamInvitationRequestValidationError{}
+
+// Validate checks the field values on DeleteTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteTeamInvitationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteTeamInvitationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteTeamInvitationResponseMultiError, or nil if none found.
+func (m *DeleteTeamInvitationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteTeamInvitationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteTeamInvitationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteTeamInvitationResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteTeamInvitationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteTeamInvitationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteTeamInvitationResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteTeamInvitationResponseMultiError) AllErrors() []error { return m }
+
+// DeleteTeamInvitationResponseValidationError is the validation error returned
+// by DeleteTeamInvitationResponse.Validate if the designated constraints
+// aren't met.
+type DeleteTeamInvitationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteTeamInvitationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteTeamInvitationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteTeamInvitationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteTeamInvitationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteTeamInvitationResponseValidationError) ErrorName() string {
+	return "DeleteTeamInvitationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteTeamInvitationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteTeamInvitationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteTeamInvitationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteTeamInvitationResponseValidationError{}
+
+// Validate checks the field values on PostProcessorResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PostProcessorResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PostProcessorResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PostProcessorResultMultiError, or nil if none found.
+func (m *PostProcessorResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PostProcessorResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetOutput()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostProcessorResultValidationError{
+					field:  "Output",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostProcessorResultValidationError{
+					field:  "Output",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutput()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostProcessorResultValidationError{
+				field:  "Output",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PostProcessorResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PostProcessorResultMultiError is an error wrapping multiple validation
+// errors returned by PostProcessorResult.ValidateAll() if the designated
+// constraints aren't met.
+type PostProcessorResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PostProcessorResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PostProcessorResultMultiError) AllErrors() []error { return m }
+
+// PostProcessorResultValidationError is the validation error returned by
+// PostProcessorResult.Validate if the designated constraints aren't met.
+type PostProcessorResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PostProcessorResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PostProcessorResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PostProcessorResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PostProcessorResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PostProcessorResultValidationError) ErrorName() string {
+	return "PostProcessorResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PostProcessorResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPostProcessorResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PostProcessorResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PostProcessorResultValidationError{}
+
+// Validate checks the field values on DownloadTask with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DownloadTask) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTask with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DownloadTaskMultiError, or
+// nil if none found.
+func (m *DownloadTask) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTask) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetOfAccount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "OfAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "OfAccount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOfAccount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "OfAccount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := DownloadType_name[int32(m.GetDownloadType())]; !ok {
+		err := DownloadTaskValidationError{
+			field:  "DownloadType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Url
+
+	// no validation rules for DownloadStatus
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPostProcessorResultList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DownloadTaskValidationError{
+						field:  fmt.Sprintf("PostProcessorResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DownloadTaskValidationError{
+						field:  fmt.Sprintf("PostProcessorResultList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DownloadTaskValidationError{
+					field:  fmt.Sprintf("PostProcessorResultList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for OfTeamId
+
+	if len(errors) > 0 {
+		return DownloadTaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskMultiError is an error wrapping multiple validation errors
+// returned by DownloadTask.ValidateAll() if the designated constraints aren't met.
+type DownloadTaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskMultiError) AllErrors() []error { return m }
+
+// DownloadTaskValidationError is the validation error returned by
+// DownloadTask.Validate if the designated constraints aren't met.
+type DownloadTaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskValidationError) ErrorName() string { return "DownloadTaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskRequestMultiError, or nil if none found.
+func (m *CreateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadType
+
+	if l := utf8.RuneCountInString(m.GetUrl()); l < 10 || l > 200 {
+		err := CreateDownloadTaskRequestValidationError{
+			field:  "Url",
+			reason: "value length must be between 10 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = CreateDownloadTaskRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := CreateDownloadTaskRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for ExtractArchive
+
+	if all {
+		switch v := interface{}(m.GetPostProcessorList()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskRequestValidationError{
+					field:  "PostProcessorList",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPostProcessorList()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskRequestValidationError{
+				field:  "PostProcessorList",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskRequestValidationError is the validation error returned by
+// CreateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type CreateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskRequestValidationError) ErrorName() string {
+	return "CreateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateDownloadTaskResponseMultiError, or nil if none found.
+func (m *CreateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// CreateDownloadTaskResponseValidationError is the validation error returned
+// by CreateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type CreateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateDownloadTaskResponseValidationError) ErrorName() string {
+	return "CreateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	if m.GetOffset() > 100 {
+		err := GetDownloadTaskListRequestValidationError{
+			field:  "Offset",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TeamId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListRequestValidationError is the validation error returned
+// by GetDownloadTaskListRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskListResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return GetDownloadTaskListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskListResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskListResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskListResponseValidationError is the validation error returned
+// by GetDownloadTaskListResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskListResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskFileRequestMultiError is an error wrapping multiple
+// validation errors returned by GetDownloadTaskFileRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetDownloadTaskFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileRequestValidationError is the validation error returned
+// by GetDownloadTaskFileRequest.Validate if the designated constraints aren't met.
+type GetDownloadTaskFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDownloadTaskFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskFileResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskFileResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetDownloadTaskFileResponseMultiError(errors)
+	}
+
+	return nil
+}
 
 // GetDownloadTaskFileResponseMultiError is an error wrapping multiple
 // validation errors returned by GetDownloadTaskFileResponse.ValidateAll() if
 // the designated constraints aren't met.
-type GetDownloadTaskFileResponseMultiError []error
+type GetDownloadTaskFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskFileResponseValidationError is the validation error returned
+// by GetDownloadTaskFileResponse.Validate if the designated constraints
+// aren't met.
+type GetDownloadTaskFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskFileResponseValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskRequestMultiError, or nil if none found.
+func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = UpdateDownloadTaskRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := UpdateDownloadTaskRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskRequestValidationError is the validation error returned by
+// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
+	return "UpdateDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateDownloadTaskResponseMultiError, or nil if none found.
+func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDownloadTaskResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDownloadTaskResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// UpdateDownloadTaskResponseValidationError is the validation error returned
+// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
+type UpdateDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
+	return "UpdateDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskRequestMultiError, or nil if none found.
+func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskRequestValidationError is the validation error returned by
+// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
+	return "DeleteDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteDownloadTaskResponseMultiError, or nil if none found.
+func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// DeleteDownloadTaskResponseValidationError is the validation error returned
+// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
+type DeleteDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
+	return "DeleteDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on ExtendDownloadTaskExpiryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendDownloadTaskExpiryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendDownloadTaskExpiryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExtendDownloadTaskExpiryRequestMultiError, or nil if none found.
+func (m *ExtendDownloadTaskExpiryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendDownloadTaskExpiryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if m.GetExpiresAt() == nil {
+		err := ExtendDownloadTaskExpiryRequestValidationError{
+			field:  "ExpiresAt",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if t := m.GetExpiresAt(); t != nil {
+		ts, err := t.AsTime(), t.CheckValid()
+		if err != nil {
+			err = ExtendDownloadTaskExpiryRequestValidationError{
+				field:  "ExpiresAt",
+				reason: "value is not a valid timestamp",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			now := time.Now()
+
+			if ts.Sub(now) <= 0 {
+				err := ExtendDownloadTaskExpiryRequestValidationError{
+					field:  "ExpiresAt",
+					reason: "value must be greater than now",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExtendDownloadTaskExpiryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendDownloadTaskExpiryRequestMultiError is an error wrapping multiple
+// validation errors returned by ExtendDownloadTaskExpiryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ExtendDownloadTaskExpiryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendDownloadTaskExpiryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendDownloadTaskExpiryRequestMultiError) AllErrors() []error { return m }
+
+// ExtendDownloadTaskExpiryRequestValidationError is the validation error
+// returned by ExtendDownloadTaskExpiryRequest.Validate if the designated
+// constraints aren't met.
+type ExtendDownloadTaskExpiryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendDownloadTaskExpiryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendDownloadTaskExpiryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendDownloadTaskExpiryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendDownloadTaskExpiryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendDownloadTaskExpiryRequestValidationError) ErrorName() string {
+	return "ExtendDownloadTaskExpiryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendDownloadTaskExpiryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendDownloadTaskExpiryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendDownloadTaskExpiryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendDownloadTaskExpiryRequestValidationError{}
+
+// Validate checks the field values on ExtendDownloadTaskExpiryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExtendDownloadTaskExpiryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendDownloadTaskExpiryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExtendDownloadTaskExpiryResponseMultiError, or nil if none found.
+func (m *ExtendDownloadTaskExpiryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendDownloadTaskExpiryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDownloadTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendDownloadTaskExpiryResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendDownloadTaskExpiryResponseValidationError{
+					field:  "DownloadTask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendDownloadTaskExpiryResponseValidationError{
+				field:  "DownloadTask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExtendDownloadTaskExpiryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendDownloadTaskExpiryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ExtendDownloadTaskExpiryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendDownloadTaskExpiryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendDownloadTaskExpiryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendDownloadTaskExpiryResponseMultiError) AllErrors() []error { return m }
+
+// ExtendDownloadTaskExpiryResponseValidationError is the validation error
+// returned by ExtendDownloadTaskExpiryResponse.Validate if the designated
+// constraints aren't met.
+type ExtendDownloadTaskExpiryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendDownloadTaskExpiryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendDownloadTaskExpiryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendDownloadTaskExpiryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendDownloadTaskExpiryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendDownloadTaskExpiryResponseValidationError) ErrorName() string {
+	return "ExtendDownloadTaskExpiryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendDownloadTaskExpiryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendDownloadTaskExpiryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendDownloadTaskExpiryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendDownloadTaskExpiryResponseValidationError{}
+
+// Validate checks the field values on ExtractedFile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExtractedFile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtractedFile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExtractedFileMultiError, or
+// nil if none found.
+func (m *ExtractedFile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtractedFile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return ExtractedFileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtractedFileMultiError is an error wrapping multiple validation errors
+// returned by ExtractedFile.ValidateAll() if the designated constraints
+// aren't met.
+type ExtractedFileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtractedFileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtractedFileMultiError) AllErrors() []error { return m }
+
+// ExtractedFileValidationError is the validation error returned by
+// ExtractedFile.Validate if the designated constraints aren't met.
+type ExtractedFileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtractedFileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtractedFileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtractedFileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtractedFileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtractedFileValidationError) ErrorName() string { return "ExtractedFileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExtractedFileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtractedFile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtractedFileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtractedFileValidationError{}
+
+// Validate checks the field values on GetDownloadTaskExtractedFileListRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetDownloadTaskExtractedFileListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetDownloadTaskExtractedFileListRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GetDownloadTaskExtractedFileListRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskExtractedFileListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskExtractedFileListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if len(errors) > 0 {
+		return GetDownloadTaskExtractedFileListRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskExtractedFileListRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// GetDownloadTaskExtractedFileListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileListRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskExtractedFileListRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskExtractedFileListRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskExtractedFileListRequestValidationError is the validation
+// error returned by GetDownloadTaskExtractedFileListRequest.Validate if the
+// designated constraints aren't met.
+type GetDownloadTaskExtractedFileListRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskExtractedFileListRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskExtractedFileListRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskExtractedFileListRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskExtractedFileListRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskExtractedFileListRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskExtractedFileListRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskExtractedFileListRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskExtractedFileListRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskExtractedFileListRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskExtractedFileListRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskExtractedFileListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetDownloadTaskExtractedFileListResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GetDownloadTaskExtractedFileListResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// GetDownloadTaskExtractedFileListResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskExtractedFileListResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskExtractedFileListResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetExtractedFileList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDownloadTaskExtractedFileListResponseValidationError{
+						field:  fmt.Sprintf("ExtractedFileList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDownloadTaskExtractedFileListResponseValidationError{
+						field:  fmt.Sprintf("ExtractedFileList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDownloadTaskExtractedFileListResponseValidationError{
+					field:  fmt.Sprintf("ExtractedFileList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTaskExtractedFileListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskExtractedFileListResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// GetDownloadTaskExtractedFileListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskExtractedFileListResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskExtractedFileListResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskExtractedFileListResponseValidationError is the validation
+// error returned by GetDownloadTaskExtractedFileListResponse.Validate if the
+// designated constraints aren't met.
+type GetDownloadTaskExtractedFileListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskExtractedFileListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskExtractedFileListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskExtractedFileListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskExtractedFileListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskExtractedFileListResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskExtractedFileListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskExtractedFileListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskExtractedFileListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskExtractedFileListResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskExtractedFileListResponseValidationError{}
+
+// Validate checks the field values on GetDownloadTaskExtractedFileRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetDownloadTaskExtractedFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskExtractedFileRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskExtractedFileRequestMultiError, or nil if none found.
+func (m *GetDownloadTaskExtractedFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskExtractedFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := GetDownloadTaskExtractedFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetDownloadTaskExtractedFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskExtractedFileRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDownloadTaskExtractedFileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskExtractedFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskExtractedFileRequestMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskExtractedFileRequestValidationError is the validation error
+// returned by GetDownloadTaskExtractedFileRequest.Validate if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskExtractedFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskExtractedFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskExtractedFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskExtractedFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskExtractedFileRequestValidationError) ErrorName() string {
+	return "GetDownloadTaskExtractedFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskExtractedFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskExtractedFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskExtractedFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskExtractedFileRequestValidationError{}
+
+// Validate checks the field values on GetDownloadTaskExtractedFileResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GetDownloadTaskExtractedFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDownloadTaskExtractedFileResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetDownloadTaskExtractedFileResponseMultiError, or nil if none found.
+func (m *GetDownloadTaskExtractedFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDownloadTaskExtractedFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return GetDownloadTaskExtractedFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDownloadTaskExtractedFileResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetDownloadTaskExtractedFileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDownloadTaskExtractedFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDownloadTaskExtractedFileResponseMultiError) AllErrors() []error { return m }
+
+// GetDownloadTaskExtractedFileResponseValidationError is the validation error
+// returned by GetDownloadTaskExtractedFileResponse.Validate if the designated
+// constraints aren't met.
+type GetDownloadTaskExtractedFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDownloadTaskExtractedFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDownloadTaskExtractedFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDownloadTaskExtractedFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDownloadTaskExtractedFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDownloadTaskExtractedFileResponseValidationError) ErrorName() string {
+	return "GetDownloadTaskExtractedFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDownloadTaskExtractedFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDownloadTaskExtractedFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDownloadTaskExtractedFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDownloadTaskExtractedFileResponseValidationError{}
+
+// Validate checks the field values on DownloadTaskFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskFilterMultiError, or nil if none found.
+func (m *DownloadTaskFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UrlContains
+
+	if len(errors) > 0 {
+		return DownloadTaskFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskFilterMultiError is an error wrapping multiple validation errors
+// returned by DownloadTaskFilter.ValidateAll() if the designated constraints
+// aren't met.
+type DownloadTaskFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskFilterMultiError) AllErrors() []error { return m }
+
+// DownloadTaskFilterValidationError is the validation error returned by
+// DownloadTaskFilter.Validate if the designated constraints aren't met.
+type DownloadTaskFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskFilterValidationError) ErrorName() string {
+	return "DownloadTaskFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskFilterValidationError{}
+
+// Validate checks the field values on DownloadTaskFilesAsArchiveRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DownloadTaskFilesAsArchiveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskFilesAsArchiveRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DownloadTaskFilesAsArchiveRequestMultiError, or nil if none found.
+func (m *DownloadTaskFilesAsArchiveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskFilesAsArchiveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDownloadTaskIdList()) > 1000 {
+		err := DownloadTaskFilesAsArchiveRequestValidationError{
+			field:  "DownloadTaskIdList",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_DownloadTaskFilesAsArchiveRequest_DownloadTaskIdList_Unique := make(map[uint64]struct{}, len(m.GetDownloadTaskIdList()))
+
+	for idx, item := range m.GetDownloadTaskIdList() {
+		_, _ = idx, item
+
+		if _, exists := _DownloadTaskFilesAsArchiveRequest_DownloadTaskIdList_Unique[item]; exists {
+			err := DownloadTaskFilesAsArchiveRequestValidationError{
+				field:  fmt.Sprintf("DownloadTaskIdList[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DownloadTaskFilesAsArchiveRequest_DownloadTaskIdList_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for DownloadTaskIdList[idx]
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskFilesAsArchiveRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskFilesAsArchiveRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskFilesAsArchiveRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := ArchiveFormat_name[int32(m.GetArchiveFormat())]; !ok {
+		err := DownloadTaskFilesAsArchiveRequestValidationError{
+			field:  "ArchiveFormat",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DownloadTaskFilesAsArchiveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskFilesAsArchiveRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DownloadTaskFilesAsArchiveRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskFilesAsArchiveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskFilesAsArchiveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskFilesAsArchiveRequestMultiError) AllErrors() []error { return m }
+
+// DownloadTaskFilesAsArchiveRequestValidationError is the validation error
+// returned by DownloadTaskFilesAsArchiveRequest.Validate if the designated
+// constraints aren't met.
+type DownloadTaskFilesAsArchiveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskFilesAsArchiveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskFilesAsArchiveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskFilesAsArchiveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskFilesAsArchiveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskFilesAsArchiveRequestValidationError) ErrorName() string {
+	return "DownloadTaskFilesAsArchiveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskFilesAsArchiveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskFilesAsArchiveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskFilesAsArchiveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskFilesAsArchiveRequestValidationError{}
+
+// Validate checks the field values on DownloadTaskFilesAsArchiveResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DownloadTaskFilesAsArchiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DownloadTaskFilesAsArchiveResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DownloadTaskFilesAsArchiveResponseMultiError, or nil if none found.
+func (m *DownloadTaskFilesAsArchiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DownloadTaskFilesAsArchiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return DownloadTaskFilesAsArchiveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DownloadTaskFilesAsArchiveResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DownloadTaskFilesAsArchiveResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskFilesAsArchiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DownloadTaskFilesAsArchiveResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DownloadTaskFilesAsArchiveResponseMultiError) AllErrors() []error { return m }
+
+// DownloadTaskFilesAsArchiveResponseValidationError is the validation error
+// returned by DownloadTaskFilesAsArchiveResponse.Validate if the designated
+// constraints aren't met.
+type DownloadTaskFilesAsArchiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DownloadTaskFilesAsArchiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DownloadTaskFilesAsArchiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DownloadTaskFilesAsArchiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DownloadTaskFilesAsArchiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DownloadTaskFilesAsArchiveResponseValidationError) ErrorName() string {
+	return "DownloadTaskFilesAsArchiveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DownloadTaskFilesAsArchiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDownloadTaskFilesAsArchiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DownloadTaskFilesAsArchiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DownloadTaskFilesAsArchiveResponseValidationError{}
+
+// Validate checks the field values on ShareDownloadTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareDownloadTaskRequestMultiError, or nil if none found.
+func (m *ShareDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if l := len(m.GetAccountNameList()); l < 1 || l > 100 {
+		err := ShareDownloadTaskRequestValidationError{
+			field:  "AccountNameList",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ShareDownloadTaskRequest_AccountNameList_Unique := make(map[string]struct{}, len(m.GetAccountNameList()))
+
+	for idx, item := range m.GetAccountNameList() {
+		_, _ = idx, item
+
+		if _, exists := _ShareDownloadTaskRequest_AccountNameList_Unique[item]; exists {
+			err := ShareDownloadTaskRequestValidationError{
+				field:  fmt.Sprintf("AccountNameList[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ShareDownloadTaskRequest_AccountNameList_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 256 {
+			err := ShareDownloadTaskRequestValidationError{
+				field:  fmt.Sprintf("AccountNameList[%v]", idx),
+				reason: "value length must be between 1 and 256 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShareDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareDownloadTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by ShareDownloadTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ShareDownloadTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareDownloadTaskRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareDownloadTaskRequestMultiError) AllErrors() []error { return m }
+
+// ShareDownloadTaskRequestValidationError is the validation error returned by
+// ShareDownloadTaskRequest.Validate if the designated constraints aren't met.
+type ShareDownloadTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareDownloadTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareDownloadTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareDownloadTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareDownloadTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareDownloadTaskRequestValidationError) ErrorName() string {
+	return "ShareDownloadTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareDownloadTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareDownloadTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareDownloadTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareDownloadTaskRequestValidationError{}
+
+// Validate checks the field values on ShareDownloadTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShareDownloadTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShareDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShareDownloadTaskResponseMultiError, or nil if none found.
+func (m *ShareDownloadTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShareDownloadTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSharedWithAccountList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShareDownloadTaskResponseValidationError{
+						field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShareDownloadTaskResponseValidationError{
+						field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShareDownloadTaskResponseValidationError{
+					field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ShareDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShareDownloadTaskResponseMultiError is an error wrapping multiple validation
+// errors returned by ShareDownloadTaskResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ShareDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShareDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShareDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// ShareDownloadTaskResponseValidationError is the validation error returned by
+// ShareDownloadTaskResponse.Validate if the designated constraints aren't met.
+type ShareDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShareDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShareDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShareDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShareDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShareDownloadTaskResponseValidationError) ErrorName() string {
+	return "ShareDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShareDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShareDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShareDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShareDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on UnshareDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnshareDownloadTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnshareDownloadTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnshareDownloadTaskRequestMultiError, or nil if none found.
+func (m *UnshareDownloadTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnshareDownloadTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DownloadTaskId
+
+	if l := len(m.GetAccountNameList()); l < 1 || l > 100 {
+		err := UnshareDownloadTaskRequestValidationError{
+			field:  "AccountNameList",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UnshareDownloadTaskRequest_AccountNameList_Unique := make(map[string]struct{}, len(m.GetAccountNameList()))
+
+	for idx, item := range m.GetAccountNameList() {
+		_, _ = idx, item
+
+		if _, exists := _UnshareDownloadTaskRequest_AccountNameList_Unique[item]; exists {
+			err := UnshareDownloadTaskRequestValidationError{
+				field:  fmt.Sprintf("AccountNameList[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UnshareDownloadTaskRequest_AccountNameList_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 256 {
+			err := UnshareDownloadTaskRequestValidationError{
+				field:  fmt.Sprintf("AccountNameList[%v]", idx),
+				reason: "value length must be between 1 and 256 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UnshareDownloadTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnshareDownloadTaskRequestMultiError is an error wrapping multiple
+// validation errors returned by UnshareDownloadTaskRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UnshareDownloadTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetDownloadTaskFileResponseMultiError) Error() string {
+func (m UnshareDownloadTaskRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1423,12 +15948,11 @@ func (m GetDownloadTaskFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetDownloadTaskFileResponseMultiError) AllErrors() []error { return m }
+func (m UnshareDownloadTaskRequestMultiError) AllErrors() []error { return m }
 
-// GetDownloadTaskFileResponseValidationError is the validation error returned
-// by GetDownloadTaskFileResponse.Validate if the designated constraints
-// aren't met.
-type GetDownloadTaskFileResponseValidationError struct {
+// UnshareDownloadTaskRequestValidationError is the validation error returned
+// by UnshareDownloadTaskRequest.Validate if the designated constraints aren't met.
+type UnshareDownloadTaskRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1436,24 +15960,24 @@ type GetDownloadTaskFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetDownloadTaskFileResponseValidationError) Field() string { return e.field }
+func (e UnshareDownloadTaskRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetDownloadTaskFileResponseValidationError) Reason() string { return e.reason }
+func (e UnshareDownloadTaskRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetDownloadTaskFileResponseValidationError) Cause() error { return e.cause }
+func (e UnshareDownloadTaskRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetDownloadTaskFileResponseValidationError) Key() bool { return e.key }
+func (e UnshareDownloadTaskRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetDownloadTaskFileResponseValidationError) ErrorName() string {
-	return "GetDownloadTaskFileResponseValidationError"
+func (e UnshareDownloadTaskRequestValidationError) ErrorName() string {
+	return "UnshareDownloadTaskRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetDownloadTaskFileResponseValidationError) Error() string {
+func (e UnshareDownloadTaskRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1465,14 +15989,14 @@ func (e GetDownloadTaskFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetDownloadTaskFileResponse.%s: %s%s",
+		"invalid %sUnshareDownloadTaskRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetDownloadTaskFileResponseValidationError{}
+var _ error = UnshareDownloadTaskRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1480,46 +16004,172 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetDownloadTaskFileResponseValidationError{}
+} = UnshareDownloadTaskRequestValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UnshareDownloadTaskResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskRequest) Validate() error {
+func (m *UnshareDownloadTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskRequest with the
+// ValidateAll checks the field values on UnshareDownloadTaskResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskRequestMultiError, or nil if none found.
-func (m *UpdateDownloadTaskRequest) ValidateAll() error {
+// UnshareDownloadTaskResponseMultiError, or nil if none found.
+func (m *UnshareDownloadTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskRequest) validate(all bool) error {
+func (m *UnshareDownloadTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	for idx, item := range m.GetSharedWithAccountList() {
+		_, _ = idx, item
 
-	if uri, err := url.Parse(m.GetUrl()); err != nil {
-		err = UpdateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be a valid URI",
-			cause:  err,
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UnshareDownloadTaskResponseValidationError{
+						field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UnshareDownloadTaskResponseValidationError{
+						field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UnshareDownloadTaskResponseValidationError{
+					field:  fmt.Sprintf("SharedWithAccountList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	} else if !uri.IsAbs() {
-		err := UpdateDownloadTaskRequestValidationError{
-			field:  "Url",
-			reason: "value must be absolute",
+
+	}
+
+	if len(errors) > 0 {
+		return UnshareDownloadTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnshareDownloadTaskResponseMultiError is an error wrapping multiple
+// validation errors returned by UnshareDownloadTaskResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UnshareDownloadTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnshareDownloadTaskResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnshareDownloadTaskResponseMultiError) AllErrors() []error { return m }
+
+// UnshareDownloadTaskResponseValidationError is the validation error returned
+// by UnshareDownloadTaskResponse.Validate if the designated constraints
+// aren't met.
+type UnshareDownloadTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnshareDownloadTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnshareDownloadTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnshareDownloadTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnshareDownloadTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnshareDownloadTaskResponseValidationError) ErrorName() string {
+	return "UnshareDownloadTaskResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnshareDownloadTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnshareDownloadTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnshareDownloadTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnshareDownloadTaskResponseValidationError{}
+
+// Validate checks the field values on GetSharedDownloadTaskListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSharedDownloadTaskListRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSharedDownloadTaskListRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSharedDownloadTaskListRequestMultiError, or nil if none found.
+func (m *GetSharedDownloadTaskListRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSharedDownloadTaskListRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLimit() > 100 {
+		err := GetSharedDownloadTaskListRequestValidationError{
+			field:  "Limit",
+			reason: "value must be less than or equal to 100",
 		}
 		if !all {
 			return err
@@ -1527,20 +16177,23 @@ func (m *UpdateDownloadTaskRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Offset
+
 	if len(errors) > 0 {
-		return UpdateDownloadTaskRequestMultiError(errors)
+		return GetSharedDownloadTaskListRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateDownloadTaskRequestMultiError []error
+// GetSharedDownloadTaskListRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSharedDownloadTaskListRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharedDownloadTaskListRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskRequestMultiError) Error() string {
+func (m GetSharedDownloadTaskListRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1549,11 +16202,12 @@ func (m UpdateDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m GetSharedDownloadTaskListRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskRequestValidationError is the validation error returned by
-// UpdateDownloadTaskRequest.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskRequestValidationError struct {
+// GetSharedDownloadTaskListRequestValidationError is the validation error
+// returned by GetSharedDownloadTaskListRequest.Validate if the designated
+// constraints aren't met.
+type GetSharedDownloadTaskListRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1561,24 +16215,24 @@ type UpdateDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e GetSharedDownloadTaskListRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e GetSharedDownloadTaskListRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e GetSharedDownloadTaskListRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e GetSharedDownloadTaskListRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskRequestValidationError) ErrorName() string {
-	return "UpdateDownloadTaskRequestValidationError"
+func (e GetSharedDownloadTaskListRequestValidationError) ErrorName() string {
+	return "GetSharedDownloadTaskListRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskRequestValidationError) Error() string {
+func (e GetSharedDownloadTaskListRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1590,14 +16244,14 @@ func (e UpdateDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskRequest.%s: %s%s",
+		"invalid %sGetSharedDownloadTaskListRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskRequestValidationError{}
+var _ error = GetSharedDownloadTaskListRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1605,73 +16259,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskRequestValidationError{}
+} = GetSharedDownloadTaskListRequestValidationError{}
 
-// Validate checks the field values on UpdateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDownloadTaskResponse) Validate() error {
+// Validate checks the field values on GetSharedDownloadTaskListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetSharedDownloadTaskListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateDownloadTaskResponseMultiError, or nil if none found.
-func (m *UpdateDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetSharedDownloadTaskListResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetSharedDownloadTaskListResponseMultiError, or nil if none found.
+func (m *GetSharedDownloadTaskListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDownloadTaskResponse) validate(all bool) error {
+func (m *GetSharedDownloadTaskListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDownloadTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateDownloadTaskResponseValidationError{
-					field:  "DownloadTask",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetDownloadTaskList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSharedDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSharedDownloadTaskListResponseValidationError{
+						field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		}
-	} else if v, ok := interface{}(m.GetDownloadTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UpdateDownloadTaskResponseValidationError{
-				field:  "DownloadTask",
-				reason: "embedded message failed validation",
-				cause:  err,
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSharedDownloadTaskListResponseValidationError{
+					field:  fmt.Sprintf("DownloadTaskList[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for TotalCount
+
 	if len(errors) > 0 {
-		return UpdateDownloadTaskResponseMultiError(errors)
+		return GetSharedDownloadTaskListResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by UpdateDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type UpdateDownloadTaskResponseMultiError []error
+// GetSharedDownloadTaskListResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetSharedDownloadTaskListResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetSharedDownloadTaskListResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDownloadTaskResponseMultiError) Error() string {
+func (m GetSharedDownloadTaskListResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1680,11 +16343,12 @@ func (m UpdateDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m GetSharedDownloadTaskListResponseMultiError) AllErrors() []error { return m }
 
-// UpdateDownloadTaskResponseValidationError is the validation error returned
-// by UpdateDownloadTaskResponse.Validate if the designated constraints aren't met.
-type UpdateDownloadTaskResponseValidationError struct {
+// GetSharedDownloadTaskListResponseValidationError is the validation error
+// returned by GetSharedDownloadTaskListResponse.Validate if the designated
+// constraints aren't met.
+type GetSharedDownloadTaskListResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1692,24 +16356,24 @@ type UpdateDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e GetSharedDownloadTaskListResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e GetSharedDownloadTaskListResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e GetSharedDownloadTaskListResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e GetSharedDownloadTaskListResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDownloadTaskResponseValidationError) ErrorName() string {
-	return "UpdateDownloadTaskResponseValidationError"
+func (e GetSharedDownloadTaskListResponseValidationError) ErrorName() string {
+	return "GetSharedDownloadTaskListResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDownloadTaskResponseValidationError) Error() string {
+func (e GetSharedDownloadTaskListResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1721,14 +16385,14 @@ func (e UpdateDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDownloadTaskResponse.%s: %s%s",
+		"invalid %sGetSharedDownloadTaskListResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDownloadTaskResponseValidationError{}
+var _ error = GetSharedDownloadTaskListResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1736,46 +16400,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDownloadTaskResponseValidationError{}
+} = GetSharedDownloadTaskListResponseValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskRequest) Validate() error {
+// Validate checks the field values on StreamRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StreamRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskRequestMultiError, or nil if none found.
-func (m *DeleteDownloadTaskRequest) ValidateAll() error {
+// ValidateAll checks the field values on StreamRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StreamRequestMultiError, or
+// nil if none found.
+func (m *StreamRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskRequest) validate(all bool) error {
+func (m *StreamRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DownloadTaskId
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return DeleteDownloadTaskRequestMultiError(errors)
+		return StreamRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteDownloadTaskRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteDownloadTaskRequestMultiError []error
+// StreamRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskRequestMultiError) Error() string {
+func (m StreamRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1784,11 +16448,11 @@ func (m DeleteDownloadTaskRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskRequestMultiError) AllErrors() []error { return m }
+func (m StreamRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskRequestValidationError is the validation error returned by
-// DeleteDownloadTaskRequest.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskRequestValidationError struct {
+// StreamRequestValidationError is the validation error returned by
+// StreamRequest.Validate if the designated constraints aren't met.
+type StreamRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1796,24 +16460,22 @@ type DeleteDownloadTaskRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskRequestValidationError) Field() string { return e.field }
+func (e StreamRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskRequestValidationError) Reason() string { return e.reason }
+func (e StreamRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskRequestValidationError) Cause() error { return e.cause }
+func (e StreamRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskRequestValidationError) Key() bool { return e.key }
+func (e StreamRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskRequestValidationError) ErrorName() string {
-	return "DeleteDownloadTaskRequestValidationError"
-}
+func (e StreamRequestValidationError) ErrorName() string { return "StreamRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskRequestValidationError) Error() string {
+func (e StreamRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1825,14 +16487,14 @@ func (e DeleteDownloadTaskRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskRequest.%s: %s%s",
+		"invalid %sStreamRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskRequestValidationError{}
+var _ error = StreamRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1840,44 +16502,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskRequestValidationError{}
+} = StreamRequestValidationError{}
 
-// Validate checks the field values on DeleteDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDownloadTaskResponse) Validate() error {
+// Validate checks the field values on StreamResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StreamResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDownloadTaskResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteDownloadTaskResponseMultiError, or nil if none found.
-func (m *DeleteDownloadTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on StreamResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StreamResponseMultiError,
+// or nil if none found.
+func (m *StreamResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDownloadTaskResponse) validate(all bool) error {
+func (m *StreamResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Data
+
 	if len(errors) > 0 {
-		return DeleteDownloadTaskResponseMultiError(errors)
+		return StreamResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDownloadTaskResponseMultiError is an error wrapping multiple
-// validation errors returned by DeleteDownloadTaskResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DeleteDownloadTaskResponseMultiError []error
+// StreamResponseMultiError is an error wrapping multiple validation errors
+// returned by StreamResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StreamResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDownloadTaskResponseMultiError) Error() string {
+func (m StreamResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1886,11 +16550,11 @@ func (m DeleteDownloadTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDownloadTaskResponseMultiError) AllErrors() []error { return m }
+func (m StreamResponseMultiError) AllErrors() []error { return m }
 
-// DeleteDownloadTaskResponseValidationError is the validation error returned
-// by DeleteDownloadTaskResponse.Validate if the designated constraints aren't met.
-type DeleteDownloadTaskResponseValidationError struct {
+// StreamResponseValidationError is the validation error returned by
+// StreamResponse.Validate if the designated constraints aren't met.
+type StreamResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1898,24 +16562,22 @@ type DeleteDownloadTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDownloadTaskResponseValidationError) Field() string { return e.field }
+func (e StreamResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDownloadTaskResponseValidationError) Reason() string { return e.reason }
+func (e StreamResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDownloadTaskResponseValidationError) Cause() error { return e.cause }
+func (e StreamResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDownloadTaskResponseValidationError) Key() bool { return e.key }
+func (e StreamResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDownloadTaskResponseValidationError) ErrorName() string {
-	return "DeleteDownloadTaskResponseValidationError"
-}
+func (e StreamResponseValidationError) ErrorName() string { return "StreamResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteDownloadTaskResponseValidationError) Error() string {
+func (e StreamResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1927,14 +16589,14 @@ func (e DeleteDownloadTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDownloadTaskResponse.%s: %s%s",
+		"invalid %sStreamResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDownloadTaskResponseValidationError{}
+var _ error = StreamResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1942,46 +16604,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDownloadTaskResponseValidationError{}
+} = StreamResponseValidationError{}
 
-// Validate checks the field values on StreamRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StreamRequest) Validate() error {
+// Validate checks the field values on DownloadTaskCreatedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskCreatedEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StreamRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StreamRequestMultiError, or
-// nil if none found.
-func (m *StreamRequest) ValidateAll() error {
+// ValidateAll checks the field values on DownloadTaskCreatedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskCreatedEventMultiError, or nil if none found.
+func (m *DownloadTaskCreatedEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StreamRequest) validate(all bool) error {
+func (m *DownloadTaskCreatedEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return StreamRequestMultiError(errors)
+		return DownloadTaskCreatedEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// StreamRequestMultiError is an error wrapping multiple validation errors
-// returned by StreamRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StreamRequestMultiError []error
+// DownloadTaskCreatedEventMultiError is an error wrapping multiple validation
+// errors returned by DownloadTaskCreatedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type DownloadTaskCreatedEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StreamRequestMultiError) Error() string {
+func (m DownloadTaskCreatedEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1990,11 +16652,11 @@ func (m StreamRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StreamRequestMultiError) AllErrors() []error { return m }
+func (m DownloadTaskCreatedEventMultiError) AllErrors() []error { return m }
 
-// StreamRequestValidationError is the validation error returned by
-// StreamRequest.Validate if the designated constraints aren't met.
-type StreamRequestValidationError struct {
+// DownloadTaskCreatedEventValidationError is the validation error returned by
+// DownloadTaskCreatedEvent.Validate if the designated constraints aren't met.
+type DownloadTaskCreatedEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2002,22 +16664,24 @@ type StreamRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StreamRequestValidationError) Field() string { return e.field }
+func (e DownloadTaskCreatedEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StreamRequestValidationError) Reason() string { return e.reason }
+func (e DownloadTaskCreatedEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StreamRequestValidationError) Cause() error { return e.cause }
+func (e DownloadTaskCreatedEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StreamRequestValidationError) Key() bool { return e.key }
+func (e DownloadTaskCreatedEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StreamRequestValidationError) ErrorName() string { return "StreamRequestValidationError" }
+func (e DownloadTaskCreatedEventValidationError) ErrorName() string {
+	return "DownloadTaskCreatedEventValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StreamRequestValidationError) Error() string {
+func (e DownloadTaskCreatedEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2029,14 +16693,14 @@ func (e StreamRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStreamRequest.%s: %s%s",
+		"invalid %sDownloadTaskCreatedEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StreamRequestValidationError{}
+var _ error = DownloadTaskCreatedEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -2044,46 +16708,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StreamRequestValidationError{}
+} = DownloadTaskCreatedEventValidationError{}
 
-// Validate checks the field values on StreamResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StreamResponse) Validate() error {
+// Validate checks the field values on DownloadTaskLifecycleEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DownloadTaskLifecycleEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StreamResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StreamResponseMultiError,
-// or nil if none found.
-func (m *StreamResponse) ValidateAll() error {
+// ValidateAll checks the field values on DownloadTaskLifecycleEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DownloadTaskLifecycleEventMultiError, or nil if none found.
+func (m *DownloadTaskLifecycleEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StreamResponse) validate(all bool) error {
+func (m *DownloadTaskLifecycleEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Data
+	// no validation rules for Id
+
+	// no validation rules for OfAccountId
+
+	// no validation rules for OfTeamId
+
+	// no validation rules for DownloadStatus
+
+	// no validation rules for ProgressPercent
+
+	// no validation rules for FailureReason
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DownloadTaskLifecycleEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DownloadTaskLifecycleEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return StreamResponseMultiError(errors)
+		return DownloadTaskLifecycleEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// StreamResponseMultiError is an error wrapping multiple validation errors
-// returned by StreamResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StreamResponseMultiError []error
+// DownloadTaskLifecycleEventMultiError is an error wrapping multiple
+// validation errors returned by DownloadTaskLifecycleEvent.ValidateAll() if
+// the designated constraints aren't met.
+type DownloadTaskLifecycleEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StreamResponseMultiError) Error() string {
+func (m DownloadTaskLifecycleEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2092,11 +16795,11 @@ func (m StreamResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StreamResponseMultiError) AllErrors() []error { return m }
+func (m DownloadTaskLifecycleEventMultiError) AllErrors() []error { return m }
 
-// StreamResponseValidationError is the validation error returned by
-// StreamResponse.Validate if the designated constraints aren't met.
-type StreamResponseValidationError struct {
+// DownloadTaskLifecycleEventValidationError is the validation error returned
+// by DownloadTaskLifecycleEvent.Validate if the designated constraints aren't met.
+type DownloadTaskLifecycleEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2104,22 +16807,24 @@ type StreamResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StreamResponseValidationError) Field() string { return e.field }
+func (e DownloadTaskLifecycleEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StreamResponseValidationError) Reason() string { return e.reason }
+func (e DownloadTaskLifecycleEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StreamResponseValidationError) Cause() error { return e.cause }
+func (e DownloadTaskLifecycleEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StreamResponseValidationError) Key() bool { return e.key }
+func (e DownloadTaskLifecycleEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StreamResponseValidationError) ErrorName() string { return "StreamResponseValidationError" }
+func (e DownloadTaskLifecycleEventValidationError) ErrorName() string {
+	return "DownloadTaskLifecycleEventValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StreamResponseValidationError) Error() string {
+func (e DownloadTaskLifecycleEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2131,14 +16836,14 @@ func (e StreamResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStreamResponse.%s: %s%s",
+		"invalid %sDownloadTaskLifecycleEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StreamResponseValidationError{}
+var _ error = DownloadTaskLifecycleEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -2146,4 +16851,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StreamResponseValidationError{}
+} = DownloadTaskLifecycleEventValidationError{}
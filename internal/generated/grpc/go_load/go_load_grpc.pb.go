@@ -24,11 +24,49 @@ const _ = grpc.SupportPackageIsVersion7
 type GoLoadServiceClient interface {
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	CreateSession(ctx context.Context, in *CreateSessionRequest, opts ...grpc.CallOption) (*CreateSessionResponse, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error)
+	DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error)
+	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	GetJSONWebKeySet(ctx context.Context, in *GetJSONWebKeySetRequest, opts ...grpc.CallOption) (*GetJSONWebKeySetResponse, error)
+	UpdateAccountRetentionPolicy(ctx context.Context, in *UpdateAccountRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateAccountRetentionPolicyResponse, error)
+	UpdateAccountPostProcessorList(ctx context.Context, in *UpdateAccountPostProcessorListRequest, opts ...grpc.CallOption) (*UpdateAccountPostProcessorListResponse, error)
+	UpdateAccountEmail(ctx context.Context, in *UpdateAccountEmailRequest, opts ...grpc.CallOption) (*UpdateAccountEmailResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	VerifySessionChallenge(ctx context.Context, in *VerifySessionChallengeRequest, opts ...grpc.CallOption) (*VerifySessionChallengeResponse, error)
+	StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error)
+	FinishOIDCLogin(ctx context.Context, in *FinishOIDCLoginRequest, opts ...grpc.CallOption) (*FinishOIDCLoginResponse, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error)
+	UpdateTeamMemberRole(ctx context.Context, in *UpdateTeamMemberRoleRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRoleResponse, error)
+	RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error)
+	CreateTeamInvitation(ctx context.Context, in *CreateTeamInvitationRequest, opts ...grpc.CallOption) (*CreateTeamInvitationResponse, error)
+	ListTeamInvitations(ctx context.Context, in *ListTeamInvitationsRequest, opts ...grpc.CallOption) (*ListTeamInvitationsResponse, error)
+	AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*AcceptTeamInvitationResponse, error)
+	DeleteTeamInvitation(ctx context.Context, in *DeleteTeamInvitationRequest, opts ...grpc.CallOption) (*DeleteTeamInvitationResponse, error)
 	CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error)
 	GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error)
 	GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error)
 	UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error)
 	DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error)
+	ExtendDownloadTaskExpiry(ctx context.Context, in *ExtendDownloadTaskExpiryRequest, opts ...grpc.CallOption) (*ExtendDownloadTaskExpiryResponse, error)
+	GetDownloadTaskExtractedFileList(ctx context.Context, in *GetDownloadTaskExtractedFileListRequest, opts ...grpc.CallOption) (*GetDownloadTaskExtractedFileListResponse, error)
+	GetDownloadTaskExtractedFile(ctx context.Context, in *GetDownloadTaskExtractedFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskExtractedFileClient, error)
+	DownloadTaskFilesAsArchive(ctx context.Context, in *DownloadTaskFilesAsArchiveRequest, opts ...grpc.CallOption) (GoLoadService_DownloadTaskFilesAsArchiveClient, error)
+	ShareDownloadTask(ctx context.Context, in *ShareDownloadTaskRequest, opts ...grpc.CallOption) (*ShareDownloadTaskResponse, error)
+	UnshareDownloadTask(ctx context.Context, in *UnshareDownloadTaskRequest, opts ...grpc.CallOption) (*UnshareDownloadTaskResponse, error)
+	GetSharedDownloadTaskList(ctx context.Context, in *GetSharedDownloadTaskListRequest, opts ...grpc.CallOption) (*GetSharedDownloadTaskListResponse, error)
 	StreamData(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GoLoadService_StreamDataClient, error)
 }
 
@@ -58,6 +96,285 @@ func (c *goLoadServiceClient) CreateSession(ctx context.Context, in *CreateSessi
 	return out, nil
 }
 
+func (c *goLoadServiceClient) RefreshSession(ctx context.Context, in *RefreshSessionRequest, opts ...grpc.CallOption) (*RefreshSessionResponse, error) {
+	out := new(RefreshSessionResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/RefreshSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...grpc.CallOption) (*DeleteSessionResponse, error) {
+	out := new(DeleteSessionResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error) {
+	out := new(ListSessionsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error) {
+	out := new(RevokeSessionResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ListAPIKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetJSONWebKeySet(ctx context.Context, in *GetJSONWebKeySetRequest, opts ...grpc.CallOption) (*GetJSONWebKeySetResponse, error) {
+	out := new(GetJSONWebKeySetResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/GetJSONWebKeySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UpdateAccountRetentionPolicy(ctx context.Context, in *UpdateAccountRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateAccountRetentionPolicyResponse, error) {
+	out := new(UpdateAccountRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateAccountRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UpdateAccountPostProcessorList(ctx context.Context, in *UpdateAccountPostProcessorListRequest, opts ...grpc.CallOption) (*UpdateAccountPostProcessorListResponse, error) {
+	out := new(UpdateAccountPostProcessorListResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateAccountPostProcessorList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UpdateAccountEmail(ctx context.Context, in *UpdateAccountEmailRequest, opts ...grpc.CallOption) (*UpdateAccountEmailResponse, error) {
+	out := new(UpdateAccountEmailResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateAccountEmail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...grpc.CallOption) (*RequestPasswordResetResponse, error) {
+	out := new(RequestPasswordResetResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/RequestPasswordReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error) {
+	out := new(ResetPasswordResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) VerifySessionChallenge(ctx context.Context, in *VerifySessionChallengeRequest, opts ...grpc.CallOption) (*VerifySessionChallengeResponse, error) {
+	out := new(VerifySessionChallengeResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/VerifySessionChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) StartOIDCLogin(ctx context.Context, in *StartOIDCLoginRequest, opts ...grpc.CallOption) (*StartOIDCLoginResponse, error) {
+	out := new(StartOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/StartOIDCLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) FinishOIDCLogin(ctx context.Context, in *FinishOIDCLoginRequest, opts ...grpc.CallOption) (*FinishOIDCLoginResponse, error) {
+	out := new(FinishOIDCLoginResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/FinishOIDCLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...grpc.CallOption) (*EnrollTOTPResponse, error) {
+	out := new(EnrollTOTPResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...grpc.CallOption) (*ConfirmTOTPResponse, error) {
+	out := new(ConfirmTOTPResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DisableTOTP(ctx context.Context, in *DisableTOTPRequest, opts ...grpc.CallOption) (*DisableTOTPResponse, error) {
+	out := new(DisableTOTPResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/CreateTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ListTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListTeamMembers(ctx context.Context, in *ListTeamMembersRequest, opts ...grpc.CallOption) (*ListTeamMembersResponse, error) {
+	out := new(ListTeamMembersResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ListTeamMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UpdateTeamMemberRole(ctx context.Context, in *UpdateTeamMemberRoleRequest, opts ...grpc.CallOption) (*UpdateTeamMemberRoleResponse, error) {
+	out := new(UpdateTeamMemberRoleResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateTeamMemberRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) RemoveTeamMember(ctx context.Context, in *RemoveTeamMemberRequest, opts ...grpc.CallOption) (*RemoveTeamMemberResponse, error) {
+	out := new(RemoveTeamMemberResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/RemoveTeamMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) CreateTeamInvitation(ctx context.Context, in *CreateTeamInvitationRequest, opts ...grpc.CallOption) (*CreateTeamInvitationResponse, error) {
+	out := new(CreateTeamInvitationResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/CreateTeamInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ListTeamInvitations(ctx context.Context, in *ListTeamInvitationsRequest, opts ...grpc.CallOption) (*ListTeamInvitationsResponse, error) {
+	out := new(ListTeamInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ListTeamInvitations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) AcceptTeamInvitation(ctx context.Context, in *AcceptTeamInvitationRequest, opts ...grpc.CallOption) (*AcceptTeamInvitationResponse, error) {
+	out := new(AcceptTeamInvitationResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/AcceptTeamInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteTeamInvitation(ctx context.Context, in *DeleteTeamInvitationRequest, opts ...grpc.CallOption) (*DeleteTeamInvitationResponse, error) {
+	out := new(DeleteTeamInvitationResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteTeamInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goLoadServiceClient) CreateDownloadTask(ctx context.Context, in *CreateDownloadTaskRequest, opts ...grpc.CallOption) (*CreateDownloadTaskResponse, error) {
 	out := new(CreateDownloadTaskResponse)
 	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/CreateDownloadTask", in, out, opts...)
@@ -67,346 +384,1933 @@ func (c *goLoadServiceClient) CreateDownloadTask(ctx context.Context, in *Create
 	return out, nil
 }
 
-func (c *goLoadServiceClient) GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error) {
-	out := new(GetDownloadTaskListResponse)
-	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/GetDownloadTaskList", in, out, opts...)
+func (c *goLoadServiceClient) GetDownloadTaskList(ctx context.Context, in *GetDownloadTaskListRequest, opts ...grpc.CallOption) (*GetDownloadTaskListResponse, error) {
+	out := new(GetDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/GetDownloadTaskList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[0], "/go_load.GoLoadService/GetDownloadTaskFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goLoadServiceGetDownloadTaskFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoLoadService_GetDownloadTaskFileClient interface {
+	Recv() (*GetDownloadTaskFileResponse, error)
+	grpc.ClientStream
+}
+
+type goLoadServiceGetDownloadTaskFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *goLoadServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
+	m := new(GetDownloadTaskFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goLoadServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
+	out := new(UpdateDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateDownloadTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
+	out := new(DeleteDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteDownloadTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) ExtendDownloadTaskExpiry(ctx context.Context, in *ExtendDownloadTaskExpiryRequest, opts ...grpc.CallOption) (*ExtendDownloadTaskExpiryResponse, error) {
+	out := new(ExtendDownloadTaskExpiryResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ExtendDownloadTaskExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetDownloadTaskExtractedFileList(ctx context.Context, in *GetDownloadTaskExtractedFileListRequest, opts ...grpc.CallOption) (*GetDownloadTaskExtractedFileListResponse, error) {
+	out := new(GetDownloadTaskExtractedFileListResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/GetDownloadTaskExtractedFileList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetDownloadTaskExtractedFile(ctx context.Context, in *GetDownloadTaskExtractedFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskExtractedFileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[1], "/go_load.GoLoadService/GetDownloadTaskExtractedFile", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goLoadServiceGetDownloadTaskExtractedFileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoLoadService_GetDownloadTaskExtractedFileClient interface {
+	Recv() (*GetDownloadTaskExtractedFileResponse, error)
+	grpc.ClientStream
+}
+
+type goLoadServiceGetDownloadTaskExtractedFileClient struct {
+	grpc.ClientStream
+}
+
+func (x *goLoadServiceGetDownloadTaskExtractedFileClient) Recv() (*GetDownloadTaskExtractedFileResponse, error) {
+	m := new(GetDownloadTaskExtractedFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goLoadServiceClient) DownloadTaskFilesAsArchive(ctx context.Context, in *DownloadTaskFilesAsArchiveRequest, opts ...grpc.CallOption) (GoLoadService_DownloadTaskFilesAsArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[2], "/go_load.GoLoadService/DownloadTaskFilesAsArchive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goLoadServiceDownloadTaskFilesAsArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoLoadService_DownloadTaskFilesAsArchiveClient interface {
+	Recv() (*DownloadTaskFilesAsArchiveResponse, error)
+	grpc.ClientStream
+}
+
+type goLoadServiceDownloadTaskFilesAsArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *goLoadServiceDownloadTaskFilesAsArchiveClient) Recv() (*DownloadTaskFilesAsArchiveResponse, error) {
+	m := new(DownloadTaskFilesAsArchiveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goLoadServiceClient) ShareDownloadTask(ctx context.Context, in *ShareDownloadTaskRequest, opts ...grpc.CallOption) (*ShareDownloadTaskResponse, error) {
+	out := new(ShareDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/ShareDownloadTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) UnshareDownloadTask(ctx context.Context, in *UnshareDownloadTaskRequest, opts ...grpc.CallOption) (*UnshareDownloadTaskResponse, error) {
+	out := new(UnshareDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UnshareDownloadTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) GetSharedDownloadTaskList(ctx context.Context, in *GetSharedDownloadTaskListRequest, opts ...grpc.CallOption) (*GetSharedDownloadTaskListResponse, error) {
+	out := new(GetSharedDownloadTaskListResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/GetSharedDownloadTaskList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadServiceClient) StreamData(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GoLoadService_StreamDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[3], "/go_load.GoLoadService/StreamData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goLoadServiceStreamDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoLoadService_StreamDataClient interface {
+	Recv() (*StreamResponse, error)
+	grpc.ClientStream
+}
+
+type goLoadServiceStreamDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *goLoadServiceStreamDataClient) Recv() (*StreamResponse, error) {
+	m := new(StreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// GoLoadServiceServer is the server API for GoLoadService service.
+// All implementations must embed UnimplementedGoLoadServiceServer
+// for forward compatibility
+type GoLoadServiceServer interface {
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
+	RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error)
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
+	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	GetJSONWebKeySet(context.Context, *GetJSONWebKeySetRequest) (*GetJSONWebKeySetResponse, error)
+	UpdateAccountRetentionPolicy(context.Context, *UpdateAccountRetentionPolicyRequest) (*UpdateAccountRetentionPolicyResponse, error)
+	UpdateAccountPostProcessorList(context.Context, *UpdateAccountPostProcessorListRequest) (*UpdateAccountPostProcessorListResponse, error)
+	UpdateAccountEmail(context.Context, *UpdateAccountEmailRequest) (*UpdateAccountEmailResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error)
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	VerifySessionChallenge(context.Context, *VerifySessionChallengeRequest) (*VerifySessionChallengeResponse, error)
+	StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error)
+	FinishOIDCLogin(context.Context, *FinishOIDCLoginRequest) (*FinishOIDCLoginResponse, error)
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error)
+	DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error)
+	UpdateTeamMemberRole(context.Context, *UpdateTeamMemberRoleRequest) (*UpdateTeamMemberRoleResponse, error)
+	RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error)
+	CreateTeamInvitation(context.Context, *CreateTeamInvitationRequest) (*CreateTeamInvitationResponse, error)
+	ListTeamInvitations(context.Context, *ListTeamInvitationsRequest) (*ListTeamInvitationsResponse, error)
+	AcceptTeamInvitation(context.Context, *AcceptTeamInvitationRequest) (*AcceptTeamInvitationResponse, error)
+	DeleteTeamInvitation(context.Context, *DeleteTeamInvitationRequest) (*DeleteTeamInvitationResponse, error)
+	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
+	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
+	GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error
+	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
+	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
+	ExtendDownloadTaskExpiry(context.Context, *ExtendDownloadTaskExpiryRequest) (*ExtendDownloadTaskExpiryResponse, error)
+	GetDownloadTaskExtractedFileList(context.Context, *GetDownloadTaskExtractedFileListRequest) (*GetDownloadTaskExtractedFileListResponse, error)
+	GetDownloadTaskExtractedFile(*GetDownloadTaskExtractedFileRequest, GoLoadService_GetDownloadTaskExtractedFileServer) error
+	DownloadTaskFilesAsArchive(*DownloadTaskFilesAsArchiveRequest, GoLoadService_DownloadTaskFilesAsArchiveServer) error
+	ShareDownloadTask(context.Context, *ShareDownloadTaskRequest) (*ShareDownloadTaskResponse, error)
+	UnshareDownloadTask(context.Context, *UnshareDownloadTaskRequest) (*UnshareDownloadTaskResponse, error)
+	GetSharedDownloadTaskList(context.Context, *GetSharedDownloadTaskListRequest) (*GetSharedDownloadTaskListResponse, error)
+	StreamData(*StreamRequest, GoLoadService_StreamDataServer) error
+	mustEmbedUnimplementedGoLoadServiceServer()
+}
+
+// UnimplementedGoLoadServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoLoadServiceServer struct {
+}
+
+func (UnimplementedGoLoadServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RefreshSession(context.Context, *RefreshSessionRequest) (*RefreshSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetJSONWebKeySet(context.Context, *GetJSONWebKeySetRequest) (*GetJSONWebKeySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJSONWebKeySet not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateAccountRetentionPolicy(context.Context, *UpdateAccountRetentionPolicyRequest) (*UpdateAccountRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRetentionPolicy not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateAccountPostProcessorList(context.Context, *UpdateAccountPostProcessorListRequest) (*UpdateAccountPostProcessorListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountPostProcessorList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateAccountEmail(context.Context, *UpdateAccountEmailRequest) (*UpdateAccountEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountEmail not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*RequestPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedGoLoadServiceServer) VerifySessionChallenge(context.Context, *VerifySessionChallengeRequest) (*VerifySessionChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySessionChallenge not implemented")
+}
+func (UnimplementedGoLoadServiceServer) StartOIDCLogin(context.Context, *StartOIDCLoginRequest) (*StartOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOIDCLogin not implemented")
+}
+func (UnimplementedGoLoadServiceServer) FinishOIDCLogin(context.Context, *FinishOIDCLoginRequest) (*FinishOIDCLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishOIDCLogin not implemented")
+}
+func (UnimplementedGoLoadServiceServer) EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*ConfirmTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DisableTOTP(context.Context, *DisableTOTPRequest) (*DisableTOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*CreateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListTeamMembers(context.Context, *ListTeamMembersRequest) (*ListTeamMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamMembers not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateTeamMemberRole(context.Context, *UpdateTeamMemberRoleRequest) (*UpdateTeamMemberRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTeamMemberRole not implemented")
+}
+func (UnimplementedGoLoadServiceServer) RemoveTeamMember(context.Context, *RemoveTeamMemberRequest) (*RemoveTeamMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateTeamInvitation(context.Context, *CreateTeamInvitationRequest) (*CreateTeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamInvitation not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ListTeamInvitations(context.Context, *ListTeamInvitationsRequest) (*ListTeamInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeamInvitations not implemented")
+}
+func (UnimplementedGoLoadServiceServer) AcceptTeamInvitation(context.Context, *AcceptTeamInvitationRequest) (*AcceptTeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTeamInvitation not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteTeamInvitation(context.Context, *DeleteTeamInvitationRequest) (*DeleteTeamInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeamInvitation not implemented")
+}
+func (UnimplementedGoLoadServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ExtendDownloadTaskExpiry(context.Context, *ExtendDownloadTaskExpiryRequest) (*ExtendDownloadTaskExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendDownloadTaskExpiry not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskExtractedFileList(context.Context, *GetDownloadTaskExtractedFileListRequest) (*GetDownloadTaskExtractedFileListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskExtractedFileList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetDownloadTaskExtractedFile(*GetDownloadTaskExtractedFileRequest, GoLoadService_GetDownloadTaskExtractedFileServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskExtractedFile not implemented")
+}
+func (UnimplementedGoLoadServiceServer) DownloadTaskFilesAsArchive(*DownloadTaskFilesAsArchiveRequest, GoLoadService_DownloadTaskFilesAsArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadTaskFilesAsArchive not implemented")
+}
+func (UnimplementedGoLoadServiceServer) ShareDownloadTask(context.Context, *ShareDownloadTaskRequest) (*ShareDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) UnshareDownloadTask(context.Context, *UnshareDownloadTaskRequest) (*UnshareDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareDownloadTask not implemented")
+}
+func (UnimplementedGoLoadServiceServer) GetSharedDownloadTaskList(context.Context, *GetSharedDownloadTaskListRequest) (*GetSharedDownloadTaskListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedDownloadTaskList not implemented")
+}
+func (UnimplementedGoLoadServiceServer) StreamData(*StreamRequest, GoLoadService_StreamDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamData not implemented")
+}
+func (UnimplementedGoLoadServiceServer) mustEmbedUnimplementedGoLoadServiceServer() {}
+
+// UnsafeGoLoadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoLoadServiceServer will
+// result in compilation errors.
+type UnsafeGoLoadServiceServer interface {
+	mustEmbedUnimplementedGoLoadServiceServer()
+}
+
+func RegisterGoLoadServiceServer(s grpc.ServiceRegistrar, srv GoLoadServiceServer) {
+	s.RegisterService(&GoLoadService_ServiceDesc, srv)
+}
+
+func _GoLoadService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/RefreshSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RefreshSession(ctx, req.(*RefreshSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DeleteSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteSession(ctx, req.(*DeleteSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListSessions(ctx, req.(*ListSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RevokeSession(ctx, req.(*RevokeSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ListAPIKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetJSONWebKeySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJSONWebKeySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetJSONWebKeySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/GetJSONWebKeySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetJSONWebKeySet(ctx, req.(*GetJSONWebKeySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateAccountRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateAccountRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UpdateAccountRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateAccountRetentionPolicy(ctx, req.(*UpdateAccountRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateAccountPostProcessorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountPostProcessorListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateAccountPostProcessorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UpdateAccountPostProcessorList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateAccountPostProcessorList(ctx, req.(*UpdateAccountPostProcessorListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateAccountEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateAccountEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UpdateAccountEmail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateAccountEmail(ctx, req.(*UpdateAccountEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/RequestPasswordReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_VerifySessionChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySessionChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).VerifySessionChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/VerifySessionChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).VerifySessionChallenge(ctx, req.(*VerifySessionChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_StartOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).StartOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/StartOIDCLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).StartOIDCLogin(ctx, req.(*StartOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_FinishOIDCLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishOIDCLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).FinishOIDCLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/FinishOIDCLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).FinishOIDCLogin(ctx, req.(*FinishOIDCLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DisableTOTP(ctx, req.(*DisableTOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ListTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DeleteTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ListTeamMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListTeamMembers(ctx, req.(*ListTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UpdateTeamMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateTeamMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UpdateTeamMemberRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateTeamMemberRole(ctx, req.(*UpdateTeamMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/RemoveTeamMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).RemoveTeamMember(ctx, req.(*RemoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateTeamInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateTeamInvitation(ctx, req.(*CreateTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ListTeamInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ListTeamInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ListTeamInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ListTeamInvitations(ctx, req.(*ListTeamInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_AcceptTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).AcceptTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/AcceptTeamInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).AcceptTeamInvitation(ctx, req.(*AcceptTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteTeamInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteTeamInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DeleteTeamInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteTeamInvitation(ctx, req.(*DeleteTeamInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/CreateDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/GetDownloadTaskList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &goLoadServiceGetDownloadTaskFileServer{stream})
+}
+
+type GoLoadService_GetDownloadTaskFileServer interface {
+	Send(*GetDownloadTaskFileResponse) error
+	grpc.ServerStream
+}
+
+type goLoadServiceGetDownloadTaskFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *goLoadServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UpdateDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/DeleteDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_ExtendDownloadTaskExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendDownloadTaskExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ExtendDownloadTaskExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ExtendDownloadTaskExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ExtendDownloadTaskExpiry(ctx, req.(*ExtendDownloadTaskExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskExtractedFileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadTaskExtractedFileListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetDownloadTaskExtractedFileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/GetDownloadTaskExtractedFileList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetDownloadTaskExtractedFileList(ctx, req.(*GetDownloadTaskExtractedFileListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetDownloadTaskExtractedFile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDownloadTaskExtractedFileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).GetDownloadTaskExtractedFile(m, &goLoadServiceGetDownloadTaskExtractedFileServer{stream})
+}
+
+type GoLoadService_GetDownloadTaskExtractedFileServer interface {
+	Send(*GetDownloadTaskExtractedFileResponse) error
+	grpc.ServerStream
+}
+
+type goLoadServiceGetDownloadTaskExtractedFileServer struct {
+	grpc.ServerStream
+}
+
+func (x *goLoadServiceGetDownloadTaskExtractedFileServer) Send(m *GetDownloadTaskExtractedFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoLoadService_DownloadTaskFilesAsArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadTaskFilesAsArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).DownloadTaskFilesAsArchive(m, &goLoadServiceDownloadTaskFilesAsArchiveServer{stream})
+}
+
+type GoLoadService_DownloadTaskFilesAsArchiveServer interface {
+	Send(*DownloadTaskFilesAsArchiveResponse) error
+	grpc.ServerStream
+}
+
+type goLoadServiceDownloadTaskFilesAsArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *goLoadServiceDownloadTaskFilesAsArchiveServer) Send(m *DownloadTaskFilesAsArchiveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoLoadService_ShareDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).ShareDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/ShareDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).ShareDownloadTask(ctx, req.(*ShareDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_UnshareDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).UnshareDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/UnshareDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).UnshareDownloadTask(ctx, req.(*UnshareDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_GetSharedDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedDownloadTaskListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadServiceServer).GetSharedDownloadTaskList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadService/GetSharedDownloadTaskList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadServiceServer).GetSharedDownloadTaskList(ctx, req.(*GetSharedDownloadTaskListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoLoadService_StreamData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoLoadServiceServer).StreamData(m, &goLoadServiceStreamDataServer{stream})
+}
+
+type GoLoadService_StreamDataServer interface {
+	Send(*StreamResponse) error
+	grpc.ServerStream
+}
+
+type goLoadServiceStreamDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *goLoadServiceStreamDataServer) Send(m *StreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// GoLoadService_ServiceDesc is the grpc.ServiceDesc for GoLoadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GoLoadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_load.GoLoadService",
+	HandlerType: (*GoLoadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAccount",
+			Handler:    _GoLoadService_CreateAccount_Handler,
+		},
+		{
+			MethodName: "CreateSession",
+			Handler:    _GoLoadService_CreateSession_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _GoLoadService_RefreshSession_Handler,
+		},
+		{
+			MethodName: "DeleteSession",
+			Handler:    _GoLoadService_DeleteSession_Handler,
+		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _GoLoadService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _GoLoadService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _GoLoadService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _GoLoadService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _GoLoadService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "GetJSONWebKeySet",
+			Handler:    _GoLoadService_GetJSONWebKeySet_Handler,
+		},
+		{
+			MethodName: "UpdateAccountRetentionPolicy",
+			Handler:    _GoLoadService_UpdateAccountRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAccountPostProcessorList",
+			Handler:    _GoLoadService_UpdateAccountPostProcessorList_Handler,
+		},
+		{
+			MethodName: "UpdateAccountEmail",
+			Handler:    _GoLoadService_UpdateAccountEmail_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _GoLoadService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _GoLoadService_RequestPasswordReset_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _GoLoadService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _GoLoadService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "VerifySessionChallenge",
+			Handler:    _GoLoadService_VerifySessionChallenge_Handler,
+		},
+		{
+			MethodName: "StartOIDCLogin",
+			Handler:    _GoLoadService_StartOIDCLogin_Handler,
+		},
+		{
+			MethodName: "FinishOIDCLogin",
+			Handler:    _GoLoadService_FinishOIDCLogin_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _GoLoadService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _GoLoadService_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _GoLoadService_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _GoLoadService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _GoLoadService_ListTeams_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _GoLoadService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "ListTeamMembers",
+			Handler:    _GoLoadService_ListTeamMembers_Handler,
+		},
+		{
+			MethodName: "UpdateTeamMemberRole",
+			Handler:    _GoLoadService_UpdateTeamMemberRole_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _GoLoadService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "CreateTeamInvitation",
+			Handler:    _GoLoadService_CreateTeamInvitation_Handler,
+		},
+		{
+			MethodName: "ListTeamInvitations",
+			Handler:    _GoLoadService_ListTeamInvitations_Handler,
+		},
+		{
+			MethodName: "AcceptTeamInvitation",
+			Handler:    _GoLoadService_AcceptTeamInvitation_Handler,
+		},
+		{
+			MethodName: "DeleteTeamInvitation",
+			Handler:    _GoLoadService_DeleteTeamInvitation_Handler,
+		},
+		{
+			MethodName: "CreateDownloadTask",
+			Handler:    _GoLoadService_CreateDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskList",
+			Handler:    _GoLoadService_GetDownloadTaskList_Handler,
+		},
+		{
+			MethodName: "UpdateDownloadTask",
+			Handler:    _GoLoadService_UpdateDownloadTask_Handler,
+		},
+		{
+			MethodName: "DeleteDownloadTask",
+			Handler:    _GoLoadService_DeleteDownloadTask_Handler,
+		},
+		{
+			MethodName: "ExtendDownloadTaskExpiry",
+			Handler:    _GoLoadService_ExtendDownloadTaskExpiry_Handler,
+		},
+		{
+			MethodName: "GetDownloadTaskExtractedFileList",
+			Handler:    _GoLoadService_GetDownloadTaskExtractedFileList_Handler,
+		},
+		{
+			MethodName: "ShareDownloadTask",
+			Handler:    _GoLoadService_ShareDownloadTask_Handler,
+		},
+		{
+			MethodName: "UnshareDownloadTask",
+			Handler:    _GoLoadService_UnshareDownloadTask_Handler,
+		},
+		{
+			MethodName: "GetSharedDownloadTaskList",
+			Handler:    _GoLoadService_GetSharedDownloadTaskList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetDownloadTaskFile",
+			Handler:       _GoLoadService_GetDownloadTaskFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDownloadTaskExtractedFile",
+			Handler:       _GoLoadService_GetDownloadTaskExtractedFile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadTaskFilesAsArchive",
+			Handler:       _GoLoadService_DownloadTaskFilesAsArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamData",
+			Handler:       _GoLoadService_StreamData_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/go_load.proto",
+}
+
+// GoLoadAdminServiceClient is the client API for GoLoadAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GoLoadAdminServiceClient interface {
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error)
+	DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error)
+	EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error)
+	ListAllDownloadTasks(ctx context.Context, in *ListAllDownloadTasksRequest, opts ...grpc.CallOption) (*ListAllDownloadTasksResponse, error)
+	RetryDownloadTask(ctx context.Context, in *RetryDownloadTaskRequest, opts ...grpc.CallOption) (*RetryDownloadTaskResponse, error)
+	CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error)
+	GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error)
+	ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessage(ctx context.Context, in *ReplayDeadLetterMessageRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessageResponse, error)
+	DeleteDeadLetterMessage(ctx context.Context, in *DeleteDeadLetterMessageRequest, opts ...grpc.CallOption) (*DeleteDeadLetterMessageResponse, error)
+}
+
+type goLoadAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGoLoadAdminServiceClient(cc grpc.ClientConnInterface) GoLoadAdminServiceClient {
+	return &goLoadAdminServiceClient{cc}
+}
+
+func (c *goLoadAdminServiceClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error) {
+	out := new(UpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/UpdateAccountRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) DisableAccount(ctx context.Context, in *DisableAccountRequest, opts ...grpc.CallOption) (*DisableAccountResponse, error) {
+	out := new(DisableAccountResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/DisableAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) EnableAccount(ctx context.Context, in *EnableAccountRequest, opts ...grpc.CallOption) (*EnableAccountResponse, error) {
+	out := new(EnableAccountResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/EnableAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) ListAllDownloadTasks(ctx context.Context, in *ListAllDownloadTasksRequest, opts ...grpc.CallOption) (*ListAllDownloadTasksResponse, error) {
+	out := new(ListAllDownloadTasksResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/ListAllDownloadTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goLoadAdminServiceClient) RetryDownloadTask(ctx context.Context, in *RetryDownloadTaskRequest, opts ...grpc.CallOption) (*RetryDownloadTaskResponse, error) {
+	out := new(RetryDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/RetryDownloadTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goLoadServiceClient) GetDownloadTaskFile(ctx context.Context, in *GetDownloadTaskFileRequest, opts ...grpc.CallOption) (GoLoadService_GetDownloadTaskFileClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[0], "/go_load.GoLoadService/GetDownloadTaskFile", opts...)
+func (c *goLoadAdminServiceClient) CancelDownloadTask(ctx context.Context, in *CancelDownloadTaskRequest, opts ...grpc.CallOption) (*CancelDownloadTaskResponse, error) {
+	out := new(CancelDownloadTaskResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/CancelDownloadTask", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &goLoadServiceGetDownloadTaskFileClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type GoLoadService_GetDownloadTaskFileClient interface {
-	Recv() (*GetDownloadTaskFileResponse, error)
-	grpc.ClientStream
-}
-
-type goLoadServiceGetDownloadTaskFileClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *goLoadServiceGetDownloadTaskFileClient) Recv() (*GetDownloadTaskFileResponse, error) {
-	m := new(GetDownloadTaskFileResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *goLoadAdminServiceClient) GetSystemStats(ctx context.Context, in *GetSystemStatsRequest, opts ...grpc.CallOption) (*GetSystemStatsResponse, error) {
+	out := new(GetSystemStatsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/GetSystemStats", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *goLoadServiceClient) UpdateDownloadTask(ctx context.Context, in *UpdateDownloadTaskRequest, opts ...grpc.CallOption) (*UpdateDownloadTaskResponse, error) {
-	out := new(UpdateDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/UpdateDownloadTask", in, out, opts...)
+func (c *goLoadAdminServiceClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsResponse, error) {
+	out := new(ListAuditLogsResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/ListAuditLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goLoadServiceClient) DeleteDownloadTask(ctx context.Context, in *DeleteDownloadTaskRequest, opts ...grpc.CallOption) (*DeleteDownloadTaskResponse, error) {
-	out := new(DeleteDownloadTaskResponse)
-	err := c.cc.Invoke(ctx, "/go_load.GoLoadService/DeleteDownloadTask", in, out, opts...)
+func (c *goLoadAdminServiceClient) ListDeadLetterMessages(ctx context.Context, in *ListDeadLetterMessagesRequest, opts ...grpc.CallOption) (*ListDeadLetterMessagesResponse, error) {
+	out := new(ListDeadLetterMessagesResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/ListDeadLetterMessages", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *goLoadServiceClient) StreamData(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (GoLoadService_StreamDataClient, error) {
-	stream, err := c.cc.NewStream(ctx, &GoLoadService_ServiceDesc.Streams[1], "/go_load.GoLoadService/StreamData", opts...)
+func (c *goLoadAdminServiceClient) ReplayDeadLetterMessage(ctx context.Context, in *ReplayDeadLetterMessageRequest, opts ...grpc.CallOption) (*ReplayDeadLetterMessageResponse, error) {
+	out := new(ReplayDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/ReplayDeadLetterMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &goLoadServiceStreamDataClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type GoLoadService_StreamDataClient interface {
-	Recv() (*StreamResponse, error)
-	grpc.ClientStream
-}
-
-type goLoadServiceStreamDataClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *goLoadServiceStreamDataClient) Recv() (*StreamResponse, error) {
-	m := new(StreamResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *goLoadAdminServiceClient) DeleteDeadLetterMessage(ctx context.Context, in *DeleteDeadLetterMessageRequest, opts ...grpc.CallOption) (*DeleteDeadLetterMessageResponse, error) {
+	out := new(DeleteDeadLetterMessageResponse)
+	err := c.cc.Invoke(ctx, "/go_load.GoLoadAdminService/DeleteDeadLetterMessage", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-// GoLoadServiceServer is the server API for GoLoadService service.
-// All implementations must embed UnimplementedGoLoadServiceServer
+// GoLoadAdminServiceServer is the server API for GoLoadAdminService service.
+// All implementations must embed UnimplementedGoLoadAdminServiceServer
 // for forward compatibility
-type GoLoadServiceServer interface {
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error)
-	CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error)
-	GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error)
-	GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error
-	UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error)
-	DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error)
-	StreamData(*StreamRequest, GoLoadService_StreamDataServer) error
-	mustEmbedUnimplementedGoLoadServiceServer()
+type GoLoadAdminServiceServer interface {
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error)
+	DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error)
+	EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error)
+	ListAllDownloadTasks(context.Context, *ListAllDownloadTasksRequest) (*ListAllDownloadTasksResponse, error)
+	RetryDownloadTask(context.Context, *RetryDownloadTaskRequest) (*RetryDownloadTaskResponse, error)
+	CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error)
+	GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error)
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error)
+	ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error)
+	ReplayDeadLetterMessage(context.Context, *ReplayDeadLetterMessageRequest) (*ReplayDeadLetterMessageResponse, error)
+	DeleteDeadLetterMessage(context.Context, *DeleteDeadLetterMessageRequest) (*DeleteDeadLetterMessageResponse, error)
+	mustEmbedUnimplementedGoLoadAdminServiceServer()
 }
 
-// UnimplementedGoLoadServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedGoLoadServiceServer struct {
+// UnimplementedGoLoadAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGoLoadAdminServiceServer struct {
 }
 
-func (UnimplementedGoLoadServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+func (UnimplementedGoLoadAdminServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
-func (UnimplementedGoLoadServiceServer) CreateSession(context.Context, *CreateSessionRequest) (*CreateSessionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateSession not implemented")
+func (UnimplementedGoLoadAdminServiceServer) UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRole not implemented")
 }
-func (UnimplementedGoLoadServiceServer) CreateDownloadTask(context.Context, *CreateDownloadTaskRequest) (*CreateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadTask not implemented")
+func (UnimplementedGoLoadAdminServiceServer) DisableAccount(context.Context, *DisableAccountRequest) (*DisableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableAccount not implemented")
 }
-func (UnimplementedGoLoadServiceServer) GetDownloadTaskList(context.Context, *GetDownloadTaskListRequest) (*GetDownloadTaskListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDownloadTaskList not implemented")
+func (UnimplementedGoLoadAdminServiceServer) EnableAccount(context.Context, *EnableAccountRequest) (*EnableAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableAccount not implemented")
 }
-func (UnimplementedGoLoadServiceServer) GetDownloadTaskFile(*GetDownloadTaskFileRequest, GoLoadService_GetDownloadTaskFileServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetDownloadTaskFile not implemented")
+func (UnimplementedGoLoadAdminServiceServer) ListAllDownloadTasks(context.Context, *ListAllDownloadTasksRequest) (*ListAllDownloadTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllDownloadTasks not implemented")
 }
-func (UnimplementedGoLoadServiceServer) UpdateDownloadTask(context.Context, *UpdateDownloadTaskRequest) (*UpdateDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDownloadTask not implemented")
+func (UnimplementedGoLoadAdminServiceServer) RetryDownloadTask(context.Context, *RetryDownloadTaskRequest) (*RetryDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryDownloadTask not implemented")
 }
-func (UnimplementedGoLoadServiceServer) DeleteDownloadTask(context.Context, *DeleteDownloadTaskRequest) (*DeleteDownloadTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDownloadTask not implemented")
+func (UnimplementedGoLoadAdminServiceServer) CancelDownloadTask(context.Context, *CancelDownloadTaskRequest) (*CancelDownloadTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDownloadTask not implemented")
 }
-func (UnimplementedGoLoadServiceServer) StreamData(*StreamRequest, GoLoadService_StreamDataServer) error {
-	return status.Errorf(codes.Unimplemented, "method StreamData not implemented")
+func (UnimplementedGoLoadAdminServiceServer) GetSystemStats(context.Context, *GetSystemStatsRequest) (*GetSystemStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemStats not implemented")
 }
-func (UnimplementedGoLoadServiceServer) mustEmbedUnimplementedGoLoadServiceServer() {}
+func (UnimplementedGoLoadAdminServiceServer) ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) ListDeadLetterMessages(context.Context, *ListDeadLetterMessagesRequest) (*ListDeadLetterMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetterMessages not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) ReplayDeadLetterMessage(context.Context, *ReplayDeadLetterMessageRequest) (*ReplayDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetterMessage not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) DeleteDeadLetterMessage(context.Context, *DeleteDeadLetterMessageRequest) (*DeleteDeadLetterMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadLetterMessage not implemented")
+}
+func (UnimplementedGoLoadAdminServiceServer) mustEmbedUnimplementedGoLoadAdminServiceServer() {}
 
-// UnsafeGoLoadServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoLoadServiceServer will
+// UnsafeGoLoadAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoLoadAdminServiceServer will
 // result in compilation errors.
-type UnsafeGoLoadServiceServer interface {
-	mustEmbedUnimplementedGoLoadServiceServer()
+type UnsafeGoLoadAdminServiceServer interface {
+	mustEmbedUnimplementedGoLoadAdminServiceServer()
 }
 
-func RegisterGoLoadServiceServer(s grpc.ServiceRegistrar, srv GoLoadServiceServer) {
-	s.RegisterService(&GoLoadService_ServiceDesc, srv)
+func RegisterGoLoadAdminServiceServer(s grpc.ServiceRegistrar, srv GoLoadAdminServiceServer) {
+	s.RegisterService(&GoLoadAdminService_ServiceDesc, srv)
 }
 
-func _GoLoadService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _GoLoadAdminService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateAccount(ctx, in)
+		return srv.(GoLoadAdminServiceServer).ListAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/CreateAccount",
+		FullMethod: "/go_load.GoLoadAdminService/ListAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(GoLoadAdminServiceServer).ListAccounts(ctx, req.(*ListAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateSessionRequest)
+func _GoLoadAdminService_UpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateSession(ctx, in)
+		return srv.(GoLoadAdminServiceServer).UpdateAccountRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/CreateSession",
+		FullMethod: "/go_load.GoLoadAdminService/UpdateAccountRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateSession(ctx, req.(*CreateSessionRequest))
+		return srv.(GoLoadAdminServiceServer).UpdateAccountRole(ctx, req.(*UpdateAccountRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_CreateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDownloadTaskRequest)
+func _GoLoadAdminService_DisableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, in)
+		return srv.(GoLoadAdminServiceServer).DisableAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/CreateDownloadTask",
+		FullMethod: "/go_load.GoLoadAdminService/DisableAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).CreateDownloadTask(ctx, req.(*CreateDownloadTaskRequest))
+		return srv.(GoLoadAdminServiceServer).DisableAccount(ctx, req.(*DisableAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDownloadTaskListRequest)
+func _GoLoadAdminService_EnableAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, in)
+		return srv.(GoLoadAdminServiceServer).EnableAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/GetDownloadTaskList",
+		FullMethod: "/go_load.GoLoadAdminService/EnableAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).GetDownloadTaskList(ctx, req.(*GetDownloadTaskListRequest))
+		return srv.(GoLoadAdminServiceServer).EnableAccount(ctx, req.(*EnableAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_GetDownloadTaskFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetDownloadTaskFileRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoLoadAdminService_ListAllDownloadTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllDownloadTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoLoadServiceServer).GetDownloadTaskFile(m, &goLoadServiceGetDownloadTaskFileServer{stream})
-}
-
-type GoLoadService_GetDownloadTaskFileServer interface {
-	Send(*GetDownloadTaskFileResponse) error
-	grpc.ServerStream
-}
-
-type goLoadServiceGetDownloadTaskFileServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).ListAllDownloadTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/ListAllDownloadTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).ListAllDownloadTasks(ctx, req.(*ListAllDownloadTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goLoadServiceGetDownloadTaskFileServer) Send(m *GetDownloadTaskFileResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoLoadAdminService_RetryDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryDownloadTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).RetryDownloadTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/RetryDownloadTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).RetryDownloadTask(ctx, req.(*RetryDownloadTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_UpdateDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDownloadTaskRequest)
+func _GoLoadAdminService_CancelDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDownloadTaskRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, in)
+		return srv.(GoLoadAdminServiceServer).CancelDownloadTask(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/UpdateDownloadTask",
+		FullMethod: "/go_load.GoLoadAdminService/CancelDownloadTask",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).UpdateDownloadTask(ctx, req.(*UpdateDownloadTaskRequest))
+		return srv.(GoLoadAdminServiceServer).CancelDownloadTask(ctx, req.(*CancelDownloadTaskRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_DeleteDownloadTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteDownloadTaskRequest)
+func _GoLoadAdminService_GetSystemStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, in)
+		return srv.(GoLoadAdminServiceServer).GetSystemStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/go_load.GoLoadService/DeleteDownloadTask",
+		FullMethod: "/go_load.GoLoadAdminService/GetSystemStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoLoadServiceServer).DeleteDownloadTask(ctx, req.(*DeleteDownloadTaskRequest))
+		return srv.(GoLoadAdminServiceServer).GetSystemStats(ctx, req.(*GetSystemStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoLoadService_StreamData_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoLoadAdminService_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoLoadServiceServer).StreamData(m, &goLoadServiceStreamDataServer{stream})
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/ListAuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type GoLoadService_StreamDataServer interface {
-	Send(*StreamResponse) error
-	grpc.ServerStream
+func _GoLoadAdminService_ListDeadLetterMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLetterMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).ListDeadLetterMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/ListDeadLetterMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).ListDeadLetterMessages(ctx, req.(*ListDeadLetterMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goLoadServiceStreamDataServer struct {
-	grpc.ServerStream
+func _GoLoadAdminService_ReplayDeadLetterMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).ReplayDeadLetterMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/ReplayDeadLetterMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).ReplayDeadLetterMessage(ctx, req.(*ReplayDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *goLoadServiceStreamDataServer) Send(m *StreamResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _GoLoadAdminService_DeleteDeadLetterMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadLetterMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoLoadAdminServiceServer).DeleteDeadLetterMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_load.GoLoadAdminService/DeleteDeadLetterMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoLoadAdminServiceServer).DeleteDeadLetterMessage(ctx, req.(*DeleteDeadLetterMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GoLoadService_ServiceDesc is the grpc.ServiceDesc for GoLoadService service.
+// GoLoadAdminService_ServiceDesc is the grpc.ServiceDesc for GoLoadAdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var GoLoadService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "go_load.GoLoadService",
-	HandlerType: (*GoLoadServiceServer)(nil),
+var GoLoadAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_load.GoLoadAdminService",
+	HandlerType: (*GoLoadAdminServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateAccount",
-			Handler:    _GoLoadService_CreateAccount_Handler,
+			MethodName: "ListAccounts",
+			Handler:    _GoLoadAdminService_ListAccounts_Handler,
 		},
 		{
-			MethodName: "CreateSession",
-			Handler:    _GoLoadService_CreateSession_Handler,
+			MethodName: "UpdateAccountRole",
+			Handler:    _GoLoadAdminService_UpdateAccountRole_Handler,
 		},
 		{
-			MethodName: "CreateDownloadTask",
-			Handler:    _GoLoadService_CreateDownloadTask_Handler,
+			MethodName: "DisableAccount",
+			Handler:    _GoLoadAdminService_DisableAccount_Handler,
 		},
 		{
-			MethodName: "GetDownloadTaskList",
-			Handler:    _GoLoadService_GetDownloadTaskList_Handler,
+			MethodName: "EnableAccount",
+			Handler:    _GoLoadAdminService_EnableAccount_Handler,
 		},
 		{
-			MethodName: "UpdateDownloadTask",
-			Handler:    _GoLoadService_UpdateDownloadTask_Handler,
+			MethodName: "ListAllDownloadTasks",
+			Handler:    _GoLoadAdminService_ListAllDownloadTasks_Handler,
 		},
 		{
-			MethodName: "DeleteDownloadTask",
-			Handler:    _GoLoadService_DeleteDownloadTask_Handler,
+			MethodName: "RetryDownloadTask",
+			Handler:    _GoLoadAdminService_RetryDownloadTask_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "GetDownloadTaskFile",
-			Handler:       _GoLoadService_GetDownloadTaskFile_Handler,
-			ServerStreams: true,
+			MethodName: "CancelDownloadTask",
+			Handler:    _GoLoadAdminService_CancelDownloadTask_Handler,
 		},
 		{
-			StreamName:    "StreamData",
-			Handler:       _GoLoadService_StreamData_Handler,
-			ServerStreams: true,
+			MethodName: "GetSystemStats",
+			Handler:    _GoLoadAdminService_GetSystemStats_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _GoLoadAdminService_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "ListDeadLetterMessages",
+			Handler:    _GoLoadAdminService_ListDeadLetterMessages_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetterMessage",
+			Handler:    _GoLoadAdminService_ReplayDeadLetterMessage_Handler,
+		},
+		{
+			MethodName: "DeleteDeadLetterMessage",
+			Handler:    _GoLoadAdminService_DeleteDeadLetterMessage_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/go_load.proto",
 }
@@ -2,9 +2,9 @@ package consumers
 
 import (
 	"context"
-	"encoding/json"
 
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/consumer"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/envelope"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
 	"go.uber.org/zap"
 )
@@ -15,6 +15,7 @@ type Root interface {
 
 type root struct {
 	mqConsumer                 consumer.Consumer
+	downloadTaskCreatedDecoder *envelope.Decoder[producer.DownloadTaskCreated]
 	downloadTaskCreatedHandler DownloadTaskCreated
 	logger                     *zap.Logger
 }
@@ -26,6 +27,7 @@ func NewRoot(
 ) Root {
 	return &root{
 		mqConsumer:                 mqConsumer,
+		downloadTaskCreatedDecoder: producer.NewDownloadTaskCreatedDecoder(),
 		downloadTaskCreatedHandler: downloadTaskCreatedHandler,
 		logger:                     logger,
 	}
@@ -34,9 +36,9 @@ func NewRoot(
 func (r root) Start(ctx context.Context) error {
 	r.mqConsumer.RegisterHandler(
 		producer.MessageQueueDownloadTaskCreated,
-		func(ctx context.Context, _ string, payload []byte) error {
-			var event producer.DownloadTaskCreated
-			if err := json.Unmarshal(payload, &event); err != nil {
+		func(ctx context.Context, _ string, headers map[string]string, payload []byte) error {
+			ctx, _, event, err := r.downloadTaskCreatedDecoder.Decode(ctx, headers, payload)
+			if err != nil {
 				return err
 			}
 
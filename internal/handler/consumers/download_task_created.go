@@ -14,17 +14,17 @@ type DownloadTaskCreated interface {
 }
 
 type downloadTaskCreated struct {
-	downloadTaskLogic logic.DownloadTask
-	logger            *zap.Logger
+	downloadTaskExecutorLogic logic.DownloadTaskExecutor
+	logger                    *zap.Logger
 }
 
 func NewDownloadTaskCreated(
-	downloadTaskLogic logic.DownloadTask,
+	downloadTaskExecutorLogic logic.DownloadTaskExecutor,
 	logger *zap.Logger,
 ) DownloadTaskCreated {
 	return &downloadTaskCreated{
-		downloadTaskLogic: downloadTaskLogic,
-		logger:            logger,
+		downloadTaskExecutorLogic: downloadTaskExecutorLogic,
+		logger:                    logger,
 	}
 }
 
@@ -33,7 +33,7 @@ func (d downloadTaskCreated) Handle(ctx context.Context, event producer.Download
 		With(zap.Any("event", event))
 	logger.Info("download task created event received")
 
-	if err := d.downloadTaskLogic.ExecuteDownloadTask(ctx, event.ID); err != nil {
+	if err := d.downloadTaskExecutorLogic.ExecuteDownloadTask(ctx, event.ID); err != nil {
 		logger.With(zap.Error(err)).Error("failed to handle download task created event")
 		return err
 	}
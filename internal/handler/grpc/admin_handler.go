@@ -0,0 +1,242 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/logic"
+)
+
+type AdminHandler struct {
+	go_load.UnimplementedGoLoadAdminServiceServer
+	adminLogic             logic.Admin
+	downloadTaskLogic      logic.DownloadTask
+	deadLetterMessageLogic logic.DeadLetterMessage
+}
+
+func NewAdminHandler(
+	adminLogic logic.Admin,
+	downloadTaskLogic logic.DownloadTask,
+	deadLetterMessageLogic logic.DeadLetterMessage,
+) go_load.GoLoadAdminServiceServer {
+	return AdminHandler{
+		adminLogic:             adminLogic,
+		downloadTaskLogic:      downloadTaskLogic,
+		deadLetterMessageLogic: deadLetterMessageLogic,
+	}
+}
+
+func (h AdminHandler) ListAccounts(
+	ctx context.Context,
+	request *go_load.ListAccountsRequest,
+) (*go_load.ListAccountsResponse, error) {
+	output, err := h.adminLogic.ListAccounts(ctx, logic.ListAccountsParams{
+		AccountNameContains: request.GetAccountNameContains(),
+		Role:                request.GetRole(),
+		Limit:               request.GetLimit(),
+		Offset:              request.GetOffset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListAccountsResponse{
+		AccountList: output.AccountList,
+		TotalCount:  output.TotalCount,
+	}, nil
+}
+
+func (h AdminHandler) UpdateAccountRole(
+	ctx context.Context,
+	request *go_load.UpdateAccountRoleRequest,
+) (*go_load.UpdateAccountRoleResponse, error) {
+	output, err := h.adminLogic.UpdateAccountRole(ctx, logic.UpdateAccountRoleParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		AccountID: request.GetAccountId(),
+		Role:      request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountRoleResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h AdminHandler) DisableAccount(
+	ctx context.Context,
+	request *go_load.DisableAccountRequest,
+) (*go_load.DisableAccountResponse, error) {
+	output, err := h.adminLogic.SetAccountDisabled(ctx, logic.SetAccountDisabledParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		AccountID: request.GetAccountId(),
+		Disabled:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DisableAccountResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h AdminHandler) EnableAccount(
+	ctx context.Context,
+	request *go_load.EnableAccountRequest,
+) (*go_load.EnableAccountResponse, error) {
+	output, err := h.adminLogic.SetAccountDisabled(ctx, logic.SetAccountDisabledParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		AccountID: request.GetAccountId(),
+		Disabled:  false,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.EnableAccountResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h AdminHandler) ListAllDownloadTasks(
+	ctx context.Context,
+	request *go_load.ListAllDownloadTasksRequest,
+) (*go_load.ListAllDownloadTasksResponse, error) {
+	output, err := h.downloadTaskLogic.GetAllDownloadTaskList(ctx, logic.GetAllDownloadTaskListParams{
+		OfAccountID:        request.GetOfAccountId(),
+		DownloadStatusList: request.GetDownloadStatusList(),
+		URLContains:        request.GetUrlContains(),
+		Limit:              request.GetLimit(),
+		Offset:             request.GetOffset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListAllDownloadTasksResponse{
+		DownloadTaskList: output.DownloadTaskList,
+		TotalCount:       output.TotalCount,
+	}, nil
+}
+
+func (h AdminHandler) RetryDownloadTask(
+	ctx context.Context,
+	request *go_load.RetryDownloadTaskRequest,
+) (*go_load.RetryDownloadTaskResponse, error) {
+	output, err := h.downloadTaskLogic.RetryDownloadTask(ctx, logic.RetryDownloadTaskParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RetryDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
+func (h AdminHandler) CancelDownloadTask(
+	ctx context.Context,
+	request *go_load.CancelDownloadTaskRequest,
+) (*go_load.CancelDownloadTaskResponse, error) {
+	output, err := h.downloadTaskLogic.CancelDownloadTask(ctx, logic.CancelDownloadTaskParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CancelDownloadTaskResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
+func (h AdminHandler) GetSystemStats(
+	ctx context.Context,
+	_ *go_load.GetSystemStatsRequest,
+) (*go_load.GetSystemStatsResponse, error) {
+	output, err := h.adminLogic.GetSystemStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetSystemStatsResponse{
+		AccountCount:            output.AccountCount,
+		DisabledAccountCount:    output.DisabledAccountCount,
+		AdminAccountCount:       output.AdminAccountCount,
+		DownloadTaskCount:       output.DownloadTaskCount,
+		DownloadStatusCountList: output.DownloadStatusCountList,
+	}, nil
+}
+
+func (h AdminHandler) ListAuditLogs(
+	ctx context.Context,
+	request *go_load.ListAuditLogsRequest,
+) (*go_load.ListAuditLogsResponse, error) {
+	output, err := h.adminLogic.ListAuditLogs(ctx, logic.ListAuditLogsParams{
+		OfAccountID: request.GetOfAccountId(),
+		TargetType:  request.GetTargetType(),
+		TargetID:    request.GetTargetId(),
+		Limit:       request.GetLimit(),
+		Offset:      request.GetOffset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListAuditLogsResponse{
+		AuditLogList: output.AuditLogList,
+		TotalCount:   output.TotalCount,
+	}, nil
+}
+
+func (h AdminHandler) ListDeadLetterMessages(
+	ctx context.Context,
+	request *go_load.ListDeadLetterMessagesRequest,
+) (*go_load.ListDeadLetterMessagesResponse, error) {
+	output, err := h.deadLetterMessageLogic.ListDeadLetterMessages(ctx, logic.ListDeadLetterMessagesParams{
+		QueueName: request.GetQueueName(),
+		Limit:     request.GetLimit(),
+		Offset:    request.GetOffset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListDeadLetterMessagesResponse{
+		DeadLetterMessageList: output.DeadLetterMessageList,
+		TotalCount:            output.TotalCount,
+	}, nil
+}
+
+func (h AdminHandler) ReplayDeadLetterMessage(
+	ctx context.Context,
+	request *go_load.ReplayDeadLetterMessageRequest,
+) (*go_load.ReplayDeadLetterMessageResponse, error) {
+	if err := h.deadLetterMessageLogic.ReplayDeadLetterMessage(ctx, logic.ReplayDeadLetterMessageParams{
+		Principal:           logic.PrincipalFromContext(ctx),
+		DeadLetterMessageID: request.GetDeadLetterMessageId(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &go_load.ReplayDeadLetterMessageResponse{}, nil
+}
+
+func (h AdminHandler) DeleteDeadLetterMessage(
+	ctx context.Context,
+	request *go_load.DeleteDeadLetterMessageRequest,
+) (*go_load.DeleteDeadLetterMessageResponse, error) {
+	if err := h.deadLetterMessageLogic.DeleteDeadLetterMessage(ctx, logic.DeleteDeadLetterMessageParams{
+		Principal:           logic.PrincipalFromContext(ctx),
+		DeadLetterMessageID: request.GetDeadLetterMessageId(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteDeadLetterMessageResponse{}, nil
+}
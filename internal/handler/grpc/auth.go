@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/logic"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// API keys are sent as a bearer token, grpc-gateway forwards the http header under this name
+	authorizationMetadataName = "authorization"
+	bearerTokenPrefix         = "Bearer "
+	// grpc-gateway appends the address of the http client to this metadata
+	forwardedForMetadataName = "x-forwarded-for"
+)
+
+type authLevel int
+
+const (
+	// authLevelPublic does not require a principal, the request is served even if its token is invalid
+	authLevelPublic authLevel = iota
+	// authLevelAuthenticated accepts session tokens and api keys having the required scope
+	authLevelAuthenticated
+	// authLevelSession only accepts session tokens, so a leaked api key cannot take over the account
+	authLevelSession
+	// authLevelAdmin only accepts session tokens of admin accounts
+	authLevelAdmin
+)
+
+type authPolicy struct {
+	level authLevel
+	// scope is only checked for api keys on authLevelAuthenticated, session tokens are allowed every scope
+	scope logic.APIKeyScope
+}
+
+var (
+	errAuthenticationRequired = status.Error(codes.Unauthenticated, "authentication required")
+	errSessionRequired        = status.Error(codes.PermissionDenied, "a session token is required, api keys are not accepted")
+	errAdminRequired          = status.Error(codes.PermissionDenied, "admin permission required")
+	errAPIKeyMissingScope     = status.Error(codes.PermissionDenied, "api key does not have the required scope")
+)
+
+// methodAuthPolicyMap is keyed by method name of GoLoadService, methods missing from it and every method of
+// GoLoadAdminService are only allowed to admins
+var methodAuthPolicyMap = map[string]authPolicy{
+	"CreateAccount":                    {level: authLevelPublic},
+	"CreateSession":                    {level: authLevelPublic},
+	"RefreshSession":                   {level: authLevelPublic},
+	"RequestPasswordReset":             {level: authLevelPublic},
+	"ResetPassword":                    {level: authLevelPublic},
+	"VerifySessionChallenge":           {level: authLevelPublic},
+	"StartOIDCLogin":                   {level: authLevelPublic},
+	"FinishOIDCLogin":                  {level: authLevelPublic},
+	"GetJSONWebKeySet":                 {level: authLevelPublic},
+	"StreamData":                       {level: authLevelPublic},
+	"DeleteSession":                    {level: authLevelSession},
+	"ListSessions":                     {level: authLevelSession},
+	"RevokeSession":                    {level: authLevelSession},
+	"CreateAPIKey":                     {level: authLevelSession},
+	"ListAPIKeys":                      {level: authLevelSession},
+	"RevokeAPIKey":                     {level: authLevelSession},
+	"UpdateAccountEmail":               {level: authLevelSession},
+	"ChangePassword":                   {level: authLevelSession},
+	"DeleteAccount":                    {level: authLevelSession},
+	"EnrollTOTP":                       {level: authLevelSession},
+	"ConfirmTOTP":                      {level: authLevelSession},
+	"DisableTOTP":                      {level: authLevelSession},
+	"CreateTeam":                       {level: authLevelSession},
+	"ListTeams":                        {level: authLevelSession},
+	"DeleteTeam":                       {level: authLevelSession},
+	"ListTeamMembers":                  {level: authLevelSession},
+	"UpdateTeamMemberRole":             {level: authLevelSession},
+	"RemoveTeamMember":                 {level: authLevelSession},
+	"CreateTeamInvitation":             {level: authLevelSession},
+	"ListTeamInvitations":              {level: authLevelSession},
+	"AcceptTeamInvitation":             {level: authLevelSession},
+	"DeleteTeamInvitation":             {level: authLevelSession},
+	"UpdateAccountRetentionPolicy":     {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageAccount},
+	"UpdateAccountPostProcessorList":   {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageAccount},
+	"CreateDownloadTask":               {level: authLevelAuthenticated, scope: logic.APIKeyScopeCreateTasks},
+	"GetDownloadTaskList":              {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"GetDownloadTaskFile":              {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"GetDownloadTaskExtractedFileList": {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"GetDownloadTaskExtractedFile":     {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"DownloadTaskFilesAsArchive":       {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"GetSharedDownloadTaskList":        {level: authLevelAuthenticated, scope: logic.APIKeyScopeRead},
+	"UpdateDownloadTask":               {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageTasks},
+	"DeleteDownloadTask":               {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageTasks},
+	"ExtendDownloadTaskExpiry":         {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageTasks},
+	"ShareDownloadTask":                {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageTasks},
+	"UnshareDownloadTask":              {level: authLevelAuthenticated, scope: logic.APIKeyScopeManageTasks},
+}
+
+func getAuthPolicy(fullMethod string) authPolicy {
+	goLoadServicePrefix := "/" + go_load.GoLoadService_ServiceDesc.ServiceName + "/"
+	if !strings.HasPrefix(fullMethod, goLoadServicePrefix) {
+		return authPolicy{level: authLevelAdmin}
+	}
+
+	policy, ok := methodAuthPolicyMap[strings.TrimPrefix(fullMethod, goLoadServicePrefix)]
+	if !ok {
+		return authPolicy{level: authLevelAdmin}
+	}
+
+	return policy
+}
+
+// getAuthToken reads the session token set by the http gateway from cookies, or a bearer token
+func getAuthToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if tokenValues := md.Get(AuthTokenMetadataName); len(tokenValues) > 0 && tokenValues[0] != "" {
+		return tokenValues[0]
+	}
+
+	authorizationValues := md.Get(authorizationMetadataName)
+	if len(authorizationValues) == 0 {
+		return ""
+	}
+
+	authorization := authorizationValues[0]
+	if len(authorization) > len(bearerTokenPrefix) && strings.EqualFold(authorization[:len(bearerTokenPrefix)], bearerTokenPrefix) {
+		return authorization[len(bearerTokenPrefix):]
+	}
+
+	return ""
+}
+
+// getClientIP returns the ip of the caller, requests coming from loopback are forwarded by the http gateway of
+// this process so the last x-forwarded-for entry, which the gateway appends, is used for them. Earlier entries
+// are sent by the http client and are not trusted
+func getClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return host
+	}
+
+	forwardedForValues := md.Get(forwardedForMetadataName)
+	if len(forwardedForValues) == 0 {
+		return host
+	}
+
+	forwardedForList := strings.Split(forwardedForValues[len(forwardedForValues)-1], ",")
+	forwardedFor := strings.TrimSpace(forwardedForList[len(forwardedForList)-1])
+	if net.ParseIP(forwardedFor) == nil {
+		return host
+	}
+
+	return forwardedFor
+}
+
+type Auth interface {
+	// Authenticate resolves the principal of the request once and puts it into the context, it is used by
+	// the unary and stream auth interceptors
+	Authenticate(ctx context.Context) (context.Context, error)
+}
+
+type auth struct {
+	tokenLogic logic.Token
+	logger     *zap.Logger
+}
+
+func NewAuth(
+	tokenLogic logic.Token,
+	logger *zap.Logger,
+) Auth {
+	return &auth{
+		tokenLogic: tokenLogic,
+		logger:     logger,
+	}
+}
+
+func (a auth) checkPolicy(principal logic.Principal, policy authPolicy) error {
+	if principal.IsAnonymous() {
+		return errAuthenticationRequired
+	}
+
+	switch policy.level {
+	case authLevelSession:
+		if !principal.IsSession() {
+			return errSessionRequired
+		}
+
+	case authLevelAdmin:
+		if !principal.IsSession() {
+			return errSessionRequired
+		}
+
+		if !principal.Admin {
+			return errAdminRequired
+		}
+
+	default:
+		if policy.scope != "" && !principal.HasScope(policy.scope) {
+			return errAPIKeyMissingScope
+		}
+	}
+
+	return nil
+}
+
+func (a auth) Authenticate(ctx context.Context) (context.Context, error) {
+	fullMethod, _ := grpc.Method(ctx)
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.String("method", fullMethod))
+
+	policy := getAuthPolicy(fullMethod)
+	if policy.level == authLevelPublic {
+		return ctx, nil
+	}
+
+	token := getAuthToken(ctx)
+	if token == "" {
+		return nil, errAuthenticationRequired
+	}
+
+	principal, err := a.tokenLogic.GetPrincipal(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.checkPolicy(principal, policy); err != nil {
+		logger.With(zap.Error(err)).Warn("request is not allowed by the auth policy")
+		return nil, err
+	}
+
+	return logic.WithPrincipal(ctx, principal), nil
+}
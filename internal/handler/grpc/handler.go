@@ -17,17 +17,37 @@ import (
 const (
 	//nolint:gosec // This is just to specify the metadata name
 	AuthTokenMetadataName = "GOLOAD_AUTH"
+	//nolint:gosec // This is just to specify the metadata name
+	RefreshTokenMetadataName = "GOLOAD_REFRESH"
+
+	userAgentMetadataName = "user-agent"
+	// grpc-gateway forwards the user agent of the http request under this name
+	gatewayUserAgentMetadataName = "grpcgateway-user-agent"
 )
 
 type Handler struct {
 	go_load.UnimplementedGoLoadServiceServer
 	accountLogic                                 logic.Account
+	sessionLogic                                 logic.Session
+	apiKeyLogic                                  logic.APIKey
+	passwordResetLogic                           logic.PasswordReset
+	totpLogic                                    logic.TOTP
+	oidcLogic                                    logic.OIDC
+	teamLogic                                    logic.Team
+	tokenSigningKeyLogic                         logic.TokenSigningKey
 	downloadTaskLogic                            logic.DownloadTask
 	getDownloadTaskFileResponseBufferSizeInBytes uint64
 }
 
 func NewHandler(
 	accountLogic logic.Account,
+	sessionLogic logic.Session,
+	apiKeyLogic logic.APIKey,
+	passwordResetLogic logic.PasswordReset,
+	totpLogic logic.TOTP,
+	oidcLogic logic.OIDC,
+	teamLogic logic.Team,
+	tokenSigningKeyLogic logic.TokenSigningKey,
 	downloadTaskLogic logic.DownloadTask,
 	grpcConfig configs.GRPC,
 ) (go_load.GoLoadServiceServer, error) {
@@ -37,19 +57,26 @@ func NewHandler(
 	}
 
 	return Handler{
-		accountLogic:      accountLogic,
-		downloadTaskLogic: downloadTaskLogic,
+		accountLogic:         accountLogic,
+		sessionLogic:         sessionLogic,
+		apiKeyLogic:          apiKeyLogic,
+		passwordResetLogic:   passwordResetLogic,
+		totpLogic:            totpLogic,
+		oidcLogic:            oidcLogic,
+		teamLogic:            teamLogic,
+		tokenSigningKeyLogic: tokenSigningKeyLogic,
+		downloadTaskLogic:    downloadTaskLogic,
 		getDownloadTaskFileResponseBufferSizeInBytes: getDownloadTaskFileResponseBufferSizeInBytes,
 	}, nil
 }
 
-func (h Handler) getAuthTokenMetadata(ctx context.Context) string {
+func (h Handler) getMetadata(ctx context.Context, metadataName string) string {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return ""
 	}
 
-	metadataValues := md.Get(AuthTokenMetadataName)
+	metadataValues := md.Get(metadataName)
 	if len(metadataValues) == 0 {
 		return ""
 	}
@@ -57,6 +84,22 @@ func (h Handler) getAuthTokenMetadata(ctx context.Context) string {
 	return metadataValues[0]
 }
 
+func (h Handler) getUserAgentMetadata(ctx context.Context) string {
+	if userAgent := h.getMetadata(ctx, gatewayUserAgentMetadataName); userAgent != "" {
+		return userAgent
+	}
+
+	return h.getMetadata(ctx, userAgentMetadataName)
+}
+
+// setSessionTokenHeader sends the tokens of the session back, the http gateway turns them into cookies
+func (h Handler) setSessionTokenHeader(ctx context.Context, token, refreshToken string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(
+		AuthTokenMetadataName, token,
+		RefreshTokenMetadataName, refreshToken,
+	))
+}
+
 func (h Handler) CreateAccount(
 	ctx context.Context,
 	request *go_load.CreateAccountRequest,
@@ -64,6 +107,7 @@ func (h Handler) CreateAccount(
 	output, err := h.accountLogic.CreateAccount(ctx, logic.CreateAccountParams{
 		AccountName:     request.GetAccountName(),
 		AccountPassword: request.GetPassword(),
+		Email:           request.GetEmail(),
 	})
 	if err != nil {
 		return nil, err
@@ -81,12 +125,20 @@ func (h Handler) CreateSession(
 	output, err := h.accountLogic.CreateSession(ctx, logic.CreateSessionParams{
 		AccountName:     request.GetAccountName(),
 		AccountPassword: request.GetPassword(),
+		UserAgent:       h.getUserAgentMetadata(ctx),
+		ClientIP:        getClientIP(ctx),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = grpc.SetHeader(ctx, metadata.Pairs(AuthTokenMetadataName, output.Token))
+	if output.ChallengeToken != "" {
+		return &go_load.CreateSessionResponse{
+			ChallengeToken: output.ChallengeToken,
+		}, nil
+	}
+
+	err = h.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
 	if err != nil {
 		return nil, err
 	}
@@ -96,15 +148,588 @@ func (h Handler) CreateSession(
 	}, nil
 }
 
+func (h Handler) RefreshSession(
+	ctx context.Context,
+	request *go_load.RefreshSessionRequest,
+) (*go_load.RefreshSessionResponse, error) {
+	refreshToken := request.GetRefreshToken()
+	if refreshToken == "" {
+		refreshToken = h.getMetadata(ctx, RefreshTokenMetadataName)
+	}
+
+	output, err := h.sessionLogic.RefreshSession(ctx, logic.RefreshSessionParams{
+		RefreshToken: refreshToken,
+		UserAgent:    h.getUserAgentMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty refresh token would make the http gateway remove the refresh token cookie, which already holds the
+	// refresh token rotated by a concurrent request
+	if output.RefreshToken == "" {
+		err = grpc.SetHeader(ctx, metadata.Pairs(AuthTokenMetadataName, output.Token))
+	} else {
+		err = h.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RefreshSessionResponse{
+		Session: output.Session,
+	}, nil
+}
+
+func (h Handler) DeleteSession(
+	ctx context.Context,
+	_ *go_load.DeleteSessionRequest,
+) (*go_load.DeleteSessionResponse, error) {
+	err := h.sessionLogic.DeleteSession(ctx, logic.DeleteSessionParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty tokens make the http gateway remove the cookies
+	err = h.setSessionTokenHeader(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteSessionResponse{}, nil
+}
+
+func (h Handler) ListSessions(
+	ctx context.Context,
+	_ *go_load.ListSessionsRequest,
+) (*go_load.ListSessionsResponse, error) {
+	output, err := h.sessionLogic.ListSessions(ctx, logic.ListSessionsParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListSessionsResponse{
+		SessionList: output.SessionList,
+	}, nil
+}
+
+func (h Handler) RevokeSession(
+	ctx context.Context,
+	request *go_load.RevokeSessionRequest,
+) (*go_load.RevokeSessionResponse, error) {
+	err := h.sessionLogic.RevokeSession(ctx, logic.RevokeSessionParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		SessionID: request.GetSessionId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RevokeSessionResponse{}, nil
+}
+
+func (h Handler) CreateAPIKey(
+	ctx context.Context,
+	request *go_load.CreateAPIKeyRequest,
+) (*go_load.CreateAPIKeyResponse, error) {
+	params := logic.CreateAPIKeyParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		Name:      request.GetName(),
+		ScopeList: request.GetScopeList(),
+	}
+	if request.GetExpiresAt() != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	output, err := h.apiKeyLogic.CreateAPIKey(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateAPIKeyResponse{
+		ApiKey: output.APIKey,
+		Key:    output.Key,
+	}, nil
+}
+
+func (h Handler) ListAPIKeys(
+	ctx context.Context,
+	_ *go_load.ListAPIKeysRequest,
+) (*go_load.ListAPIKeysResponse, error) {
+	output, err := h.apiKeyLogic.ListAPIKeys(ctx, logic.ListAPIKeysParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListAPIKeysResponse{
+		ApiKeyList: output.APIKeyList,
+	}, nil
+}
+
+func (h Handler) RevokeAPIKey(
+	ctx context.Context,
+	request *go_load.RevokeAPIKeyRequest,
+) (*go_load.RevokeAPIKeyResponse, error) {
+	err := h.apiKeyLogic.RevokeAPIKey(ctx, logic.RevokeAPIKeyParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		APIKeyID:  request.GetApiKeyId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RevokeAPIKeyResponse{}, nil
+}
+
+func (h Handler) UpdateAccountRetentionPolicy(
+	ctx context.Context,
+	request *go_load.UpdateAccountRetentionPolicyRequest,
+) (*go_load.UpdateAccountRetentionPolicyResponse, error) {
+	params := logic.UpdateAccountRetentionPolicyParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	}
+	if request.DownloadTaskRetention != nil {
+		downloadTaskRetention := request.GetDownloadTaskRetention().AsDuration()
+		params.DownloadTaskRetention = &downloadTaskRetention
+	}
+
+	output, err := h.accountLogic.UpdateAccountRetentionPolicy(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountRetentionPolicyResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h Handler) UpdateAccountPostProcessorList(
+	ctx context.Context,
+	request *go_load.UpdateAccountPostProcessorListRequest,
+) (*go_load.UpdateAccountPostProcessorListResponse, error) {
+	params := logic.UpdateAccountPostProcessorListParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	}
+	if request.PostProcessorList != nil {
+		params.PostProcessorNameList = append([]string{}, request.GetPostProcessorList().GetPostProcessorNameList()...)
+	}
+
+	output, err := h.accountLogic.UpdateAccountPostProcessorList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountPostProcessorListResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h Handler) UpdateAccountEmail(
+	ctx context.Context,
+	request *go_load.UpdateAccountEmailRequest,
+) (*go_load.UpdateAccountEmailResponse, error) {
+	output, err := h.accountLogic.UpdateAccountEmail(ctx, logic.UpdateAccountEmailParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		Email:     request.GetEmail(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateAccountEmailResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h Handler) ChangePassword(
+	ctx context.Context,
+	request *go_load.ChangePasswordRequest,
+) (*go_load.ChangePasswordResponse, error) {
+	err := h.accountLogic.ChangePassword(ctx, logic.ChangePasswordParams{
+		Principal:   logic.PrincipalFromContext(ctx),
+		OldPassword: request.GetOldPassword(),
+		NewPassword: request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ChangePasswordResponse{}, nil
+}
+
+func (h Handler) RequestPasswordReset(
+	ctx context.Context,
+	request *go_load.RequestPasswordResetRequest,
+) (*go_load.RequestPasswordResetResponse, error) {
+	err := h.passwordResetLogic.RequestPasswordReset(ctx, logic.RequestPasswordResetParams{
+		AccountName: request.GetAccountName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RequestPasswordResetResponse{}, nil
+}
+
+func (h Handler) ResetPassword(
+	ctx context.Context,
+	request *go_load.ResetPasswordRequest,
+) (*go_load.ResetPasswordResponse, error) {
+	err := h.passwordResetLogic.ResetPassword(ctx, logic.ResetPasswordParams{
+		ResetToken:  request.GetResetToken(),
+		NewPassword: request.GetNewPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ResetPasswordResponse{}, nil
+}
+
+func (h Handler) DeleteAccount(
+	ctx context.Context,
+	request *go_load.DeleteAccountRequest,
+) (*go_load.DeleteAccountResponse, error) {
+	err := h.accountLogic.DeleteAccount(ctx, logic.DeleteAccountParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		Password:  request.GetPassword(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty tokens make the http gateway remove the cookies
+	err = h.setSessionTokenHeader(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteAccountResponse{}, nil
+}
+
+func (h Handler) VerifySessionChallenge(
+	ctx context.Context,
+	request *go_load.VerifySessionChallengeRequest,
+) (*go_load.VerifySessionChallengeResponse, error) {
+	output, err := h.totpLogic.VerifySessionChallenge(ctx, logic.VerifySessionChallengeParams{
+		ChallengeToken: request.GetChallengeToken(),
+		TOTPCode:       request.GetTotpCode(),
+		RecoveryCode:   request.GetRecoveryCode(),
+		UserAgent:      h.getUserAgentMetadata(ctx),
+		ClientIP:       getClientIP(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.VerifySessionChallengeResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h Handler) StartOIDCLogin(
+	ctx context.Context,
+	_ *go_load.StartOIDCLoginRequest,
+) (*go_load.StartOIDCLoginResponse, error) {
+	output, err := h.oidcLogic.StartOIDCLogin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.StartOIDCLoginResponse{
+		AuthorizationUrl: output.AuthorizationURL,
+		State:            output.State,
+	}, nil
+}
+
+func (h Handler) FinishOIDCLogin(
+	ctx context.Context,
+	request *go_load.FinishOIDCLoginRequest,
+) (*go_load.FinishOIDCLoginResponse, error) {
+	output, err := h.oidcLogic.FinishOIDCLogin(ctx, logic.FinishOIDCLoginParams{
+		State:     request.GetState(),
+		Code:      request.GetCode(),
+		UserAgent: h.getUserAgentMetadata(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.setSessionTokenHeader(ctx, output.Token, output.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.FinishOIDCLoginResponse{
+		Account: output.Account,
+	}, nil
+}
+
+func (h Handler) EnrollTOTP(
+	ctx context.Context,
+	_ *go_load.EnrollTOTPRequest,
+) (*go_load.EnrollTOTPResponse, error) {
+	output, err := h.totpLogic.EnrollTOTP(ctx, logic.EnrollTOTPParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.EnrollTOTPResponse{
+		Secret:     output.Secret,
+		OtpauthUri: output.OTPAuthURI,
+	}, nil
+}
+
+func (h Handler) ConfirmTOTP(
+	ctx context.Context,
+	request *go_load.ConfirmTOTPRequest,
+) (*go_load.ConfirmTOTPResponse, error) {
+	output, err := h.totpLogic.ConfirmTOTP(ctx, logic.ConfirmTOTPParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TOTPCode:  request.GetTotpCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ConfirmTOTPResponse{
+		RecoveryCodeList: output.RecoveryCodeList,
+	}, nil
+}
+
+func (h Handler) DisableTOTP(
+	ctx context.Context,
+	request *go_load.DisableTOTPRequest,
+) (*go_load.DisableTOTPResponse, error) {
+	err := h.totpLogic.DisableTOTP(ctx, logic.DisableTOTPParams{
+		Principal:    logic.PrincipalFromContext(ctx),
+		Password:     request.GetPassword(),
+		TOTPCode:     request.GetTotpCode(),
+		RecoveryCode: request.GetRecoveryCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DisableTOTPResponse{}, nil
+}
+
+func (h Handler) CreateTeam(
+	ctx context.Context,
+	request *go_load.CreateTeamRequest,
+) (*go_load.CreateTeamResponse, error) {
+	output, err := h.teamLogic.CreateTeam(ctx, logic.CreateTeamParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		Name:      request.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateTeamResponse{
+		Team: output.Team,
+	}, nil
+}
+
+func (h Handler) ListTeams(
+	ctx context.Context,
+	_ *go_load.ListTeamsRequest,
+) (*go_load.ListTeamsResponse, error) {
+	output, err := h.teamLogic.ListTeams(ctx, logic.ListTeamsParams{
+		Principal: logic.PrincipalFromContext(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListTeamsResponse{
+		TeamList: output.TeamList,
+	}, nil
+}
+
+func (h Handler) DeleteTeam(
+	ctx context.Context,
+	request *go_load.DeleteTeamRequest,
+) (*go_load.DeleteTeamResponse, error) {
+	err := h.teamLogic.DeleteTeam(ctx, logic.DeleteTeamParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteTeamResponse{}, nil
+}
+
+func (h Handler) ListTeamMembers(
+	ctx context.Context,
+	request *go_load.ListTeamMembersRequest,
+) (*go_load.ListTeamMembersResponse, error) {
+	output, err := h.teamLogic.ListTeamMembers(ctx, logic.ListTeamMembersParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListTeamMembersResponse{
+		TeamMemberList: output.TeamMemberList,
+	}, nil
+}
+
+func (h Handler) UpdateTeamMemberRole(
+	ctx context.Context,
+	request *go_load.UpdateTeamMemberRoleRequest,
+) (*go_load.UpdateTeamMemberRoleResponse, error) {
+	output, err := h.teamLogic.UpdateTeamMemberRole(ctx, logic.UpdateTeamMemberRoleParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+		AccountID: request.GetAccountId(),
+		Role:      request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UpdateTeamMemberRoleResponse{
+		TeamMember: output.TeamMember,
+	}, nil
+}
+
+func (h Handler) RemoveTeamMember(
+	ctx context.Context,
+	request *go_load.RemoveTeamMemberRequest,
+) (*go_load.RemoveTeamMemberResponse, error) {
+	err := h.teamLogic.RemoveTeamMember(ctx, logic.RemoveTeamMemberParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+		AccountID: request.GetAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.RemoveTeamMemberResponse{}, nil
+}
+
+func (h Handler) CreateTeamInvitation(
+	ctx context.Context,
+	request *go_load.CreateTeamInvitationRequest,
+) (*go_load.CreateTeamInvitationResponse, error) {
+	output, err := h.teamLogic.CreateTeamInvitation(ctx, logic.CreateTeamInvitationParams{
+		Principal:          logic.PrincipalFromContext(ctx),
+		TeamID:             request.GetTeamId(),
+		InviteeAccountName: request.GetInviteeAccountName(),
+		Role:               request.GetRole(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.CreateTeamInvitationResponse{
+		TeamInvitation: output.TeamInvitation,
+	}, nil
+}
+
+func (h Handler) ListTeamInvitations(
+	ctx context.Context,
+	request *go_load.ListTeamInvitationsRequest,
+) (*go_load.ListTeamInvitationsResponse, error) {
+	output, err := h.teamLogic.ListTeamInvitations(ctx, logic.ListTeamInvitationsParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ListTeamInvitationsResponse{
+		TeamInvitationList: output.TeamInvitationList,
+	}, nil
+}
+
+func (h Handler) AcceptTeamInvitation(
+	ctx context.Context,
+	request *go_load.AcceptTeamInvitationRequest,
+) (*go_load.AcceptTeamInvitationResponse, error) {
+	output, err := h.teamLogic.AcceptTeamInvitation(ctx, logic.AcceptTeamInvitationParams{
+		Principal:        logic.PrincipalFromContext(ctx),
+		TeamInvitationID: request.GetTeamInvitationId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.AcceptTeamInvitationResponse{
+		Team: output.Team,
+	}, nil
+}
+
+func (h Handler) DeleteTeamInvitation(
+	ctx context.Context,
+	request *go_load.DeleteTeamInvitationRequest,
+) (*go_load.DeleteTeamInvitationResponse, error) {
+	err := h.teamLogic.DeleteTeamInvitation(ctx, logic.DeleteTeamInvitationParams{
+		Principal:        logic.PrincipalFromContext(ctx),
+		TeamInvitationID: request.GetTeamInvitationId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.DeleteTeamInvitationResponse{}, nil
+}
+
+func (h Handler) GetJSONWebKeySet(
+	ctx context.Context,
+	_ *go_load.GetJSONWebKeySetRequest,
+) (*go_load.GetJSONWebKeySetResponse, error) {
+	jsonWebKeyList, err := h.tokenSigningKeyLogic.GetJSONWebKeySet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetJSONWebKeySetResponse{
+		Keys: jsonWebKeyList,
+	}, nil
+}
+
 func (h Handler) CreateDownloadTask(
 	ctx context.Context,
 	request *go_load.CreateDownloadTaskRequest,
 ) (*go_load.CreateDownloadTaskResponse, error) {
-	output, err := h.downloadTaskLogic.CreateDownloadTask(ctx, logic.CreateDownloadTaskParams{
-		Token:        h.getAuthTokenMetadata(ctx),
-		URL:          request.GetUrl(),
-		DownloadType: request.GetDownloadType(),
-	})
+	params := logic.CreateDownloadTaskParams{
+		Principal:      logic.PrincipalFromContext(ctx),
+		URL:            request.GetUrl(),
+		DownloadType:   request.GetDownloadType(),
+		ExtractArchive: request.GetExtractArchive(),
+		TeamID:         request.GetTeamId(),
+	}
+	if request.PostProcessorList != nil {
+		params.PostProcessorNameList = append([]string{}, request.GetPostProcessorList().GetPostProcessorNameList()...)
+	}
+	if request.ExpiresAt != nil {
+		expiresAt := request.GetExpiresAt().AsTime()
+		params.ExpiresAt = &expiresAt
+	}
+
+	output, err := h.downloadTaskLogic.CreateDownloadTask(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -119,9 +744,10 @@ func (h Handler) GetDownloadTaskList(
 	request *go_load.GetDownloadTaskListRequest,
 ) (*go_load.GetDownloadTaskListResponse, error) {
 	output, err := h.downloadTaskLogic.GetDownloadTaskList(ctx, logic.GetDownloadTaskListParams{
-		Token:  h.getAuthTokenMetadata(ctx),
-		Limit:  request.Limit,
-		Offset: request.Offset,
+		Principal: logic.PrincipalFromContext(ctx),
+		TeamID:    request.GetTeamId(),
+		Limit:     request.Limit,
+		Offset:    request.Offset,
 	})
 	if err != nil {
 		return nil, err
@@ -138,22 +764,28 @@ func (h Handler) GetDownloadTaskFile(
 	server go_load.GoLoadService_GetDownloadTaskFileServer,
 ) error {
 	outputReader, err := h.downloadTaskLogic.GetDownloadTaskFile(server.Context(), logic.GetDownloadTaskFileParams{
-		Token: h.getAuthTokenMetadata(server.Context()),
-		ID:    request.GetDownloadTaskId(),
+		Principal: logic.PrincipalFromContext(server.Context()),
+		ID:        request.GetDownloadTaskId(),
 	})
 	if err != nil {
 		return err
 	}
 	defer outputReader.Close()
 
+	return h.sendFileData(outputReader, func(data []byte) error {
+		return server.Send(&go_load.GetDownloadTaskFileResponse{
+			Data: data,
+		})
+	})
+}
+
+// sendFileData reads the file by chunks of the configured buffer size and sends each chunk with sendFunc
+func (h Handler) sendFileData(reader io.Reader, sendFunc func(data []byte) error) error {
 	for {
 		dataBuffer := make([]byte, h.getDownloadTaskFileResponseBufferSizeInBytes)
-		readByteCount, readErr := outputReader.Read(dataBuffer)
+		readByteCount, readErr := reader.Read(dataBuffer)
 		if readByteCount > 0 {
-			sendErr := server.Send(&go_load.GetDownloadTaskFileResponse{
-				Data: dataBuffer[:readByteCount],
-			})
-			if sendErr != nil {
+			if sendErr := sendFunc(dataBuffer[:readByteCount]); sendErr != nil {
 				return sendErr
 			}
 
@@ -161,7 +793,7 @@ func (h Handler) GetDownloadTaskFile(
 		}
 
 		if readErr != nil {
-			if errors.Is(err, io.EOF) {
+			if errors.Is(readErr, io.EOF) {
 				break
 			}
 
@@ -171,14 +803,129 @@ func (h Handler) GetDownloadTaskFile(
 	return nil
 }
 
+func (h Handler) GetDownloadTaskExtractedFileList(
+	ctx context.Context,
+	request *go_load.GetDownloadTaskExtractedFileListRequest,
+) (*go_load.GetDownloadTaskExtractedFileListResponse, error) {
+	output, err := h.downloadTaskLogic.GetDownloadTaskExtractedFileList(ctx, logic.GetDownloadTaskExtractedFileListParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetDownloadTaskExtractedFileListResponse{
+		ExtractedFileList: output.ExtractedFileList,
+	}, nil
+}
+
+func (h Handler) GetDownloadTaskExtractedFile(
+	request *go_load.GetDownloadTaskExtractedFileRequest,
+	server go_load.GoLoadService_GetDownloadTaskExtractedFileServer,
+) error {
+	outputReader, err := h.downloadTaskLogic.GetDownloadTaskExtractedFile(server.Context(), logic.GetDownloadTaskExtractedFileParams{
+		Principal: logic.PrincipalFromContext(server.Context()),
+		ID:        request.GetDownloadTaskId(),
+		Path:      request.GetPath(),
+	})
+	if err != nil {
+		return err
+	}
+	defer outputReader.Close()
+
+	return h.sendFileData(outputReader, func(data []byte) error {
+		return server.Send(&go_load.GetDownloadTaskExtractedFileResponse{
+			Data: data,
+		})
+	})
+}
+
+func (h Handler) DownloadTaskFilesAsArchive(
+	request *go_load.DownloadTaskFilesAsArchiveRequest,
+	server go_load.GoLoadService_DownloadTaskFilesAsArchiveServer,
+) error {
+	outputReader, err := h.downloadTaskLogic.GetDownloadTaskFilesAsArchive(server.Context(), logic.GetDownloadTaskFilesAsArchiveParams{
+		Principal:     logic.PrincipalFromContext(server.Context()),
+		IDList:        request.GetDownloadTaskIdList(),
+		URLContains:   request.GetFilter().GetUrlContains(),
+		ArchiveFormat: request.GetArchiveFormat(),
+	})
+	if err != nil {
+		return err
+	}
+	defer outputReader.Close()
+
+	return h.sendFileData(outputReader, func(data []byte) error {
+		return server.Send(&go_load.DownloadTaskFilesAsArchiveResponse{
+			Data: data,
+		})
+	})
+}
+
+func (h Handler) ShareDownloadTask(
+	ctx context.Context,
+	request *go_load.ShareDownloadTaskRequest,
+) (*go_load.ShareDownloadTaskResponse, error) {
+	output, err := h.downloadTaskLogic.ShareDownloadTask(ctx, logic.ShareDownloadTaskParams{
+		Principal:       logic.PrincipalFromContext(ctx),
+		ID:              request.GetDownloadTaskId(),
+		AccountNameList: request.GetAccountNameList(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ShareDownloadTaskResponse{
+		SharedWithAccountList: output.SharedWithAccountList,
+	}, nil
+}
+
+func (h Handler) UnshareDownloadTask(
+	ctx context.Context,
+	request *go_load.UnshareDownloadTaskRequest,
+) (*go_load.UnshareDownloadTaskResponse, error) {
+	output, err := h.downloadTaskLogic.UnshareDownloadTask(ctx, logic.UnshareDownloadTaskParams{
+		Principal:       logic.PrincipalFromContext(ctx),
+		ID:              request.GetDownloadTaskId(),
+		AccountNameList: request.GetAccountNameList(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.UnshareDownloadTaskResponse{
+		SharedWithAccountList: output.SharedWithAccountList,
+	}, nil
+}
+
+func (h Handler) GetSharedDownloadTaskList(
+	ctx context.Context,
+	request *go_load.GetSharedDownloadTaskListRequest,
+) (*go_load.GetSharedDownloadTaskListResponse, error) {
+	output, err := h.downloadTaskLogic.GetSharedDownloadTaskList(ctx, logic.GetSharedDownloadTaskListParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		Limit:     request.GetLimit(),
+		Offset:    request.GetOffset(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.GetSharedDownloadTaskListResponse{
+		DownloadTaskList: output.DownloadTaskList,
+		TotalCount:       output.TotalCount,
+	}, nil
+}
+
 func (h Handler) UpdateDownloadTask(
 	ctx context.Context,
 	request *go_load.UpdateDownloadTaskRequest,
 ) (*go_load.UpdateDownloadTaskResponse, error) {
 	output, err := h.downloadTaskLogic.UpdateDownloadTask(ctx, logic.UpdateDownloadTaskParams{
-		Token: h.getAuthTokenMetadata(ctx),
-		ID:    request.GetDownloadTaskId(),
-		URL:   request.GetUrl(),
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
+		URL:       request.GetUrl(),
 	})
 	if err != nil {
 		return nil, err
@@ -194,8 +941,8 @@ func (h Handler) DeleteDownloadTask(
 	request *go_load.DeleteDownloadTaskRequest,
 ) (*go_load.DeleteDownloadTaskResponse, error) {
 	err := h.downloadTaskLogic.DeleteDownloadTask(ctx, logic.DeleteDownloadTaskParams{
-		Token: h.getAuthTokenMetadata(ctx),
-		ID:    request.GetDownloadTaskId(),
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
 	})
 	if err != nil {
 		return nil, err
@@ -204,6 +951,24 @@ func (h Handler) DeleteDownloadTask(
 	return &go_load.DeleteDownloadTaskResponse{}, nil
 }
 
+func (h Handler) ExtendDownloadTaskExpiry(
+	ctx context.Context,
+	request *go_load.ExtendDownloadTaskExpiryRequest,
+) (*go_load.ExtendDownloadTaskExpiryResponse, error) {
+	output, err := h.downloadTaskLogic.ExtendDownloadTaskExpiry(ctx, logic.ExtendDownloadTaskExpiryParams{
+		Principal: logic.PrincipalFromContext(ctx),
+		ID:        request.GetDownloadTaskId(),
+		ExpiresAt: request.GetExpiresAt().AsTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &go_load.ExtendDownloadTaskExpiryResponse{
+		DownloadTask: output.DownloadTask,
+	}, nil
+}
+
 func (h Handler) StreamData(req *go_load.StreamRequest, stream go_load.GoLoadService_StreamDataServer) error {
 	for i := 0; i < 10; i++ {
 		resp := &go_load.StreamResponse{
@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/logic"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+const (
+	rateLimitBucketCleanupInterval = time.Minute
+)
+
+type tokenBucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is when the bucket refills to its burst, it can be dropped after that since a new bucket starts full
+	fullAt time.Time
+}
+
+type RateLimiter interface {
+	// Limit takes a token from the bucket of the principal of the request, or of its client ip when the request is
+	// anonymous, for the method. It returns RESOURCE_EXHAUSTED with the delay until the next token when the bucket
+	// is empty
+	Limit(ctx context.Context, fullMethod string) error
+}
+
+type rateLimiter struct {
+	enabled       bool
+	defaultRule   configs.RateLimitRule
+	methodRuleMap map[string]configs.RateLimitRule
+	bucketMap     map[string]*tokenBucket
+	mutex         *sync.Mutex
+	lastCleanupAt time.Time
+	logger        *zap.Logger
+}
+
+func validateRateLimitRule(rule configs.RateLimitRule) error {
+	if rule.RequestsPerSecond <= 0 {
+		return errors.New("requests_per_second must be positive")
+	}
+
+	if rule.Burst == 0 {
+		return errors.New("burst must be positive")
+	}
+
+	return nil
+}
+
+func getMethodNameList() []string {
+	methodNameList := make([]string, 0)
+	for _, serviceDesc := range []grpc.ServiceDesc{go_load.GoLoadService_ServiceDesc, go_load.GoLoadAdminService_ServiceDesc} {
+		for _, method := range serviceDesc.Methods {
+			methodNameList = append(methodNameList, method.MethodName)
+		}
+
+		for _, stream := range serviceDesc.Streams {
+			methodNameList = append(methodNameList, stream.StreamName)
+		}
+	}
+
+	return methodNameList
+}
+
+func NewRateLimiter(
+	grpcConfig configs.GRPC,
+	logger *zap.Logger,
+) (RateLimiter, error) {
+	rateLimitConfig := grpcConfig.RateLimit
+	if rateLimitConfig.Enabled {
+		if err := validateRateLimitRule(rateLimitConfig.Default); err != nil {
+			return nil, fmt.Errorf("invalid rate_limit.default: %w", err)
+		}
+
+		methodNameList := getMethodNameList()
+		for methodName, rule := range rateLimitConfig.MethodList {
+			if !lo.Contains(methodNameList, methodName) {
+				return nil, fmt.Errorf("invalid rate_limit.method_list: unknown method %s", methodName)
+			}
+
+			if err := validateRateLimitRule(rule); err != nil {
+				return nil, fmt.Errorf("invalid rate_limit.method_list.%s: %w", methodName, err)
+			}
+		}
+	}
+
+	return &rateLimiter{
+		enabled:       rateLimitConfig.Enabled,
+		defaultRule:   rateLimitConfig.Default,
+		methodRuleMap: rateLimitConfig.MethodList,
+		bucketMap:     make(map[string]*tokenBucket),
+		mutex:         new(sync.Mutex),
+		lastCleanupAt: time.Now(),
+		logger:        logger,
+	}, nil
+}
+
+func (r *rateLimiter) getRule(methodName string) configs.RateLimitRule {
+	if rule, ok := r.methodRuleMap[methodName]; ok {
+		return rule
+	}
+
+	return r.defaultRule
+}
+
+func (r *rateLimiter) getSubject(ctx context.Context) string {
+	principal := logic.PrincipalFromContext(ctx)
+	if !principal.IsAnonymous() {
+		return fmt.Sprintf("account:%d", principal.AccountID)
+	}
+
+	return "ip:" + getClientIP(ctx)
+}
+
+// cleanupBuckets must be called with the mutex held
+func (r *rateLimiter) cleanupBuckets(now time.Time) {
+	if now.Sub(r.lastCleanupAt) < rateLimitBucketCleanupInterval {
+		return
+	}
+
+	for key, bucket := range r.bucketMap {
+		if !bucket.fullAt.After(now) {
+			delete(r.bucketMap, key)
+		}
+	}
+
+	r.lastCleanupAt = now
+}
+
+// take returns 0 if a token was taken, or how long until the bucket has a token otherwise
+func (r *rateLimiter) take(key string, rule configs.RateLimitRule, now time.Time) time.Duration {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.cleanupBuckets(now)
+
+	burst := float64(rule.Burst)
+	bucket, ok := r.bucketMap[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: burst, updatedAt: now}
+		r.bucketMap[key] = bucket
+	}
+
+	bucket.tokens = min(burst, bucket.tokens+now.Sub(bucket.updatedAt).Seconds()*rule.RequestsPerSecond)
+	bucket.updatedAt = now
+
+	var retryDelay time.Duration
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+	} else {
+		retryDelay = time.Duration((1 - bucket.tokens) / rule.RequestsPerSecond * float64(time.Second))
+	}
+
+	bucket.fullAt = now.Add(time.Duration((burst - bucket.tokens) / rule.RequestsPerSecond * float64(time.Second)))
+	return retryDelay
+}
+
+func (r *rateLimiter) Limit(ctx context.Context, fullMethod string) error {
+	if !r.enabled {
+		return nil
+	}
+
+	methodName := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	subject := r.getSubject(ctx)
+
+	retryDelay := r.take(methodName+"|"+subject, r.getRule(methodName), time.Now())
+	if retryDelay == 0 {
+		return nil
+	}
+
+	utils.LoggerWithContext(ctx, r.logger).
+		With(zap.String("method", fullMethod)).
+		With(zap.String("subject", subject)).
+		Warn("request is rate limited")
+	return logic.NewResourceExhaustedError("too many requests, try again later", retryDelay)
+}
+
+// rateLimitUnaryServerInterceptor runs after the auth interceptor, so authenticated requests are limited per account
+func rateLimitUnaryServerInterceptor(rateLimiter RateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := rateLimiter.Limit(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitStreamServerInterceptor(rateLimiter RateLimiter) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := rateLimiter.Limit(stream.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, stream)
+	}
+}
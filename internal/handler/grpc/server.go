@@ -3,7 +3,9 @@ package grpc
 import (
 	"context"
 	"net"
+	"time"
 
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/validator"
 	"github.com/nhtuan0700/GoLoad/internal/configs"
 	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
@@ -13,30 +15,47 @@ import (
 )
 
 type Server interface {
+	// Start serves until ctx is done, then stops accepting calls and waits for in-flight calls up to
+	// grpc.shutdown_timeout
 	Start(ctx context.Context) error
 }
 
 type server struct {
-	handler    go_load.GoLoadServiceServer
-	grpcConfig configs.GRPC
-	logger     *zap.Logger
+	handler      go_load.GoLoadServiceServer
+	adminHandler go_load.GoLoadAdminServiceServer
+	auth         Auth
+	rateLimiter  RateLimiter
+	grpcConfig   configs.GRPC
+	logger       *zap.Logger
 }
 
 func NewServer(
 	handler go_load.GoLoadServiceServer,
+	adminHandler go_load.GoLoadAdminServiceServer,
+	auth Auth,
+	rateLimiter RateLimiter,
 	grpcConfig configs.Config,
 	logger *zap.Logger,
 ) Server {
 	return &server{
-		handler:    handler,
-		grpcConfig: grpcConfig.GRPC,
-		logger:     logger,
+		handler:      handler,
+		adminHandler: adminHandler,
+		auth:         auth,
+		rateLimiter:  rateLimiter,
+		grpcConfig:   grpcConfig.GRPC,
+		logger:       logger,
 	}
 }
 
 func (s server) Start(ctx context.Context) error {
 	logger := utils.LoggerWithContext(ctx, s.logger)
 
+	shutdownTimeout, err := s.grpcConfig.GetShutdownTimeoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse grpc shutdown_timeout")
+		return err
+	}
+
 	listener, err := net.Listen("tcp", s.grpcConfig.Address)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to open tcp listener")
@@ -47,14 +66,46 @@ func (s server) Start(ctx context.Context) error {
 
 	server := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			grpcauth.UnaryServerInterceptor(s.auth.Authenticate),
+			rateLimitUnaryServerInterceptor(s.rateLimiter),
 			validator.UnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
+			grpcauth.StreamServerInterceptor(s.auth.Authenticate),
+			rateLimitStreamServerInterceptor(s.rateLimiter),
 			validator.StreamServerInterceptor(),
 		),
 	)
 	go_load.RegisterGoLoadServiceServer(server, s.handler)
+	go_load.RegisterGoLoadAdminServiceServer(server, s.adminHandler)
+
+	stoppedChannel := make(chan struct{})
+	go func() {
+		defer close(stoppedChannel)
+		<-ctx.Done()
+		logger.Info("stopping grpc server")
+
+		gracefullyStoppedChannel := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(gracefullyStoppedChannel)
+		}()
+
+		select {
+		case <-gracefullyStoppedChannel:
+		case <-time.After(shutdownTimeout):
+			// Long running streams such as file downloads would block GracefulStop forever
+			logger.Warn("grpc server did not stop within shutdown timeout, closing remaining calls")
+			server.Stop()
+		}
+	}()
 
 	logger.With(zap.String("address", s.grpcConfig.Address)).Info("starting grpc server")
-	return server.Serve(listener)
+	if err = server.Serve(listener); err != nil {
+		return err
+	}
+
+	// Serve returns as soon as GracefulStop is called, before in-flight calls are done
+	<-stoppedChannel
+	return nil
 }
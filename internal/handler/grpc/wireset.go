@@ -4,5 +4,8 @@ import "github.com/google/wire"
 
 var WireSet = wire.NewSet(
 	NewHandler,
+	NewAdminHandler,
 	NewServer,
+	NewAuth,
+	NewRateLimiter,
 )
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	handlerGRPC "github.com/nhtuan0700/GoLoad/internal/handler/grpc"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DownloadTaskFilesAsArchivePath = "/v1/download-task-files-archive"
+
+	downloadTaskFilesAsArchiveQueryDownloadTaskID = "download_task_id"
+	downloadTaskFilesAsArchiveQueryURLContains    = "url_contains"
+	downloadTaskFilesAsArchiveQueryArchiveFormat  = "archive_format"
+)
+
+// downloadTaskFilesAsArchiveHandler serves the archive as a plain file download instead of the json stream
+// the gateway would produce, so it can be opened directly by a browser
+type downloadTaskFilesAsArchiveHandler struct {
+	goLoadServiceClient go_load.GoLoadServiceClient
+	logger              *zap.Logger
+}
+
+func newDownloadTaskFilesAsArchiveHandler(
+	goLoadServiceClient go_load.GoLoadServiceClient,
+	logger *zap.Logger,
+) *downloadTaskFilesAsArchiveHandler {
+	return &downloadTaskFilesAsArchiveHandler{
+		goLoadServiceClient: goLoadServiceClient,
+		logger:              logger,
+	}
+}
+
+func (h downloadTaskFilesAsArchiveHandler) parseRequest(r *http.Request) (*go_load.DownloadTaskFilesAsArchiveRequest, error) {
+	query := r.URL.Query()
+
+	downloadTaskIDList := make([]uint64, 0)
+	for _, downloadTaskIDValue := range query[downloadTaskFilesAsArchiveQueryDownloadTaskID] {
+		downloadTaskID, err := strconv.ParseUint(downloadTaskIDValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %s", downloadTaskFilesAsArchiveQueryDownloadTaskID, downloadTaskIDValue)
+		}
+
+		downloadTaskIDList = append(downloadTaskIDList, downloadTaskID)
+	}
+
+	archiveFormat := go_load.ArchiveFormat_ARCHIVE_FORMAT_ZIP
+	switch query.Get(downloadTaskFilesAsArchiveQueryArchiveFormat) {
+	case "", "zip":
+	case "tar":
+		archiveFormat = go_load.ArchiveFormat_ARCHIVE_FORMAT_TAR
+	default:
+		return nil, fmt.Errorf("invalid %s: %s", downloadTaskFilesAsArchiveQueryArchiveFormat, query.Get(downloadTaskFilesAsArchiveQueryArchiveFormat))
+	}
+
+	return &go_load.DownloadTaskFilesAsArchiveRequest{
+		DownloadTaskIdList: downloadTaskIDList,
+		Filter: &go_load.DownloadTaskFilter{
+			UrlContains: query.Get(downloadTaskFilesAsArchiveQueryURLContains),
+		},
+		ArchiveFormat: archiveFormat,
+	}, nil
+}
+
+func (h downloadTaskFilesAsArchiveHandler) writeError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
+
+func (h downloadTaskFilesAsArchiveHandler) Handle(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	logger := utils.LoggerWithContext(r.Context(), h.logger)
+
+	request, err := h.parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if cookie, cookieErr := r.Cookie(AuthTokenCookieName); cookieErr == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, handlerGRPC.AuthTokenMetadataName, cookie.Value)
+	}
+
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+	}
+
+	stream, err := h.goLoadServiceClient.DownloadTaskFilesAsArchive(ctx, request)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	// Errors found before the archive starts are returned by the first message, they can still change the status code
+	response, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.writeError(w, err)
+		return
+	}
+
+	contentType, fileExtension := "application/zip", "zip"
+	if request.GetArchiveFormat() == go_load.ArchiveFormat_ARCHIVE_FORMAT_TAR {
+		contentType, fileExtension = "application/x-tar", "tar"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="goload.%s"`, fileExtension))
+	w.WriteHeader(http.StatusOK)
+
+	for err == nil {
+		if _, writeErr := w.Write(response.GetData()); writeErr != nil {
+			logger.With(zap.Error(writeErr)).Warn("failed to write download task files archive response")
+			return
+		}
+
+		response, err = stream.Recv()
+	}
+
+	if !errors.Is(err, io.EOF) {
+		// The status code is already sent, abort the connection so the client does not keep a truncated archive
+		logger.With(zap.Error(err)).Error("failed to receive download task files archive")
+		panic(http.ErrAbortHandler)
+	}
+}
@@ -0,0 +1,142 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	gatewayUserAgentMetadataName = "grpcgateway-user-agent"
+)
+
+type SessionRenewalConfig struct {
+	AuthCookieName         string
+	RefreshCookieName      string
+	AuthMetadataName       string
+	RefreshMetadataName    string
+	AuthCookieExpiresIn    time.Duration
+	RefreshCookieExpiresIn time.Duration
+	// RenewBeforeExpiry is how long before the auth token expires the session is refreshed
+	RenewBeforeExpiry time.Duration
+}
+
+type sessionRenewal struct {
+	goLoadServiceClient go_load.GoLoadServiceClient
+	config              SessionRenewalConfig
+	logger              *zap.Logger
+}
+
+// NewSessionRenewalMiddleware refreshes the session of a request whose auth token is missing or about to expire,
+// the new tokens are used for the request itself and sent back as cookies, so browsers stay logged in transparently
+func NewSessionRenewalMiddleware(
+	goLoadServiceClient go_load.GoLoadServiceClient,
+	config SessionRenewalConfig,
+	logger *zap.Logger,
+) func(next http.Handler) http.Handler {
+	s := &sessionRenewal{
+		goLoadServiceClient: goLoadServiceClient,
+		config:              config,
+		logger:              logger,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, s.renewSession(w, r))
+		})
+	}
+}
+
+func (s sessionRenewal) shouldRenewSession(r *http.Request) bool {
+	authCookie, err := r.Cookie(s.config.AuthCookieName)
+	if err != nil {
+		return true
+	}
+
+	// The signature is verified by the grpc server, the expiry is only read here to decide whether to refresh
+	claims := jwt.MapClaims{}
+	if _, _, err = jwt.NewParser().ParseUnverified(authCookie.Value, claims); err != nil {
+		return true
+	}
+
+	expirationTime, err := claims.GetExpirationTime()
+	if err != nil || expirationTime == nil {
+		return true
+	}
+
+	return time.Until(expirationTime.Time) <= s.config.RenewBeforeExpiry
+}
+
+func (s sessionRenewal) newCookie(name, value string, expiresIn time.Duration) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Expires:  time.Now().Add(expiresIn),
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+		HttpOnly: true,
+	}
+}
+
+// renewSession returns the request to serve, with the renewed auth token if the session was refreshed
+func (s sessionRenewal) renewSession(w http.ResponseWriter, r *http.Request) *http.Request {
+	logger := utils.LoggerWithContext(r.Context(), s.logger)
+
+	refreshCookie, err := r.Cookie(s.config.RefreshCookieName)
+	if err != nil || refreshCookie.Value == "" || !s.shouldRenewSession(r) {
+		return r
+	}
+
+	ctx := metadata.AppendToOutgoingContext(r.Context(), gatewayUserAgentMetadataName, r.UserAgent())
+	var headerMD metadata.MD
+	_, err = s.goLoadServiceClient.RefreshSession(
+		ctx,
+		&go_load.RefreshSessionRequest{RefreshToken: refreshCookie.Value},
+		grpc.Header(&headerMD),
+	)
+	if err != nil {
+		// The request goes on with its current cookies, it is rejected later if its auth token is not valid anymore
+		logger.With(zap.Error(err)).Warn("failed to refresh session")
+		return r
+	}
+
+	authTokenValues := headerMD.Get(s.config.AuthMetadataName)
+	if len(authTokenValues) == 0 {
+		logger.Error("refreshed session does not have an auth token")
+		return r
+	}
+
+	http.SetCookie(w, s.newCookie(s.config.AuthCookieName, authTokenValues[0], s.config.AuthCookieExpiresIn))
+
+	// The refresh token is not rotated when a concurrent request already rotated it, the refresh cookie is then left
+	// alone so it keeps the refresh token sent back to that request
+	refreshTokenValues := headerMD.Get(s.config.RefreshMetadataName)
+	isRefreshTokenRotated := len(refreshTokenValues) > 0 && refreshTokenValues[0] != ""
+	if isRefreshTokenRotated {
+		http.SetCookie(w, s.newCookie(s.config.RefreshCookieName, refreshTokenValues[0], s.config.RefreshCookieExpiresIn))
+	}
+
+	renewedRequest := r.Clone(r.Context())
+	renewedRequest.Header.Del("Cookie")
+	for _, cookie := range r.Cookies() {
+		switch {
+		case cookie.Name == s.config.AuthCookieName:
+			cookie.Value = authTokenValues[0]
+		case cookie.Name == s.config.RefreshCookieName && isRefreshTokenRotated:
+			cookie.Value = refreshTokenValues[0]
+		}
+		renewedRequest.AddCookie(cookie)
+	}
+
+	if _, err = renewedRequest.Cookie(s.config.AuthCookieName); err != nil {
+		renewedRequest.AddCookie(&http.Cookie{Name: s.config.AuthCookieName, Value: authTokenValues[0]})
+	}
+
+	return renewedRequest
+}
@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	handlerGRPC "github.com/nhtuan0700/GoLoad/internal/handler/grpc"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	OIDCLoginPath    = "/auth/oidc/login"
+	OIDCCallbackPath = "/auth/oidc/callback"
+
+	// oidcStateCookieName ties the callback to the browser that started the login, so an attacker cannot sign a
+	// victim in to the attacker's account with a callback url of their own
+	oidcStateCookieName = "GOLOAD_OIDC_STATE"
+
+	oidcCallbackQueryState            = "state"
+	oidcCallbackQueryCode             = "code"
+	oidcCallbackQueryError            = "error"
+	oidcCallbackQueryErrorDescription = "error_description"
+
+	gatewayUserAgentMetadataName = "grpcgateway-user-agent"
+	forwardedForMetadataName     = "x-forwarded-for"
+)
+
+// oidcLoginHandler redirects browsers to the identity provider and back, the login itself is done by the grpc server
+type oidcLoginHandler struct {
+	goLoadServiceClient  go_load.GoLoadServiceClient
+	postLoginRedirectURL string
+	tokenExpiresIn       time.Duration
+	refreshExpiresIn     time.Duration
+	logger               *zap.Logger
+}
+
+func newOIDCLoginHandler(
+	goLoadServiceClient go_load.GoLoadServiceClient,
+	postLoginRedirectURL string,
+	tokenExpiresIn time.Duration,
+	refreshExpiresIn time.Duration,
+	logger *zap.Logger,
+) *oidcLoginHandler {
+	return &oidcLoginHandler{
+		goLoadServiceClient:  goLoadServiceClient,
+		postLoginRedirectURL: postLoginRedirectURL,
+		tokenExpiresIn:       tokenExpiresIn,
+		refreshExpiresIn:     refreshExpiresIn,
+		logger:               logger,
+	}
+}
+
+func (h oidcLoginHandler) writeError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+}
+
+// newStateCookie is lax unlike the session cookies, since the callback is a navigation from the identity provider
+func (h oidcLoginHandler) newStateCookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    value,
+		Path:     OIDCCallbackPath,
+		MaxAge:   maxAge,
+		SameSite: http.SameSiteLaxMode,
+		HttpOnly: true,
+	}
+}
+
+func (h oidcLoginHandler) newSessionCookie(name, value string, expiresIn time.Duration) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Expires:  time.Now().Add(expiresIn),
+		SameSite: http.SameSiteStrictMode,
+		Path:     "/",
+		HttpOnly: true,
+	}
+}
+
+// getOutgoingContext forwards the client like the gateway does, so the grpc server rate limits the client and not
+// this process
+func (h oidcLoginHandler) getOutgoingContext(r *http.Request) context.Context {
+	ctx := metadata.AppendToOutgoingContext(r.Context(), gatewayUserAgentMetadataName, r.UserAgent())
+	if remoteIP, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, forwardedForMetadataName, remoteIP)
+	}
+
+	return ctx
+}
+
+func (h oidcLoginHandler) HandleLogin(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	response, err := h.goLoadServiceClient.StartOIDCLogin(h.getOutgoingContext(r), &go_load.StartOIDCLoginRequest{})
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	http.SetCookie(w, h.newStateCookie(response.GetState(), 0))
+	http.Redirect(w, r, response.GetAuthorizationUrl(), http.StatusFound)
+}
+
+func (h oidcLoginHandler) HandleCallback(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	logger := utils.LoggerWithContext(r.Context(), h.logger)
+	query := r.URL.Query()
+
+	// The state is only meant for one callback, whatever its outcome
+	http.SetCookie(w, h.newStateCookie("", -1))
+
+	if query.Get(oidcCallbackQueryError) != "" {
+		logger.With(zap.String("error", query.Get(oidcCallbackQueryError))).
+			With(zap.String("error_description", query.Get(oidcCallbackQueryErrorDescription))).
+			Warn("oidc identity provider returned an error")
+		http.Error(w, "oidc login failed", http.StatusUnauthorized)
+		return
+	}
+
+	state := query.Get(oidcCallbackQueryState)
+	stateCookie, err := r.Cookie(oidcStateCookieName)
+	if err != nil || state == "" || subtle.ConstantTimeCompare([]byte(stateCookie.Value), []byte(state)) != 1 {
+		http.Error(w, "oidc login state does not match this browser", http.StatusBadRequest)
+		return
+	}
+
+	var headerMD metadata.MD
+	_, err = h.goLoadServiceClient.FinishOIDCLogin(h.getOutgoingContext(r), &go_load.FinishOIDCLoginRequest{
+		State: state,
+		Code:  query.Get(oidcCallbackQueryCode),
+	}, grpc.Header(&headerMD))
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	authTokenValues := headerMD.Get(handlerGRPC.AuthTokenMetadataName)
+	refreshTokenValues := headerMD.Get(handlerGRPC.RefreshTokenMetadataName)
+	if len(authTokenValues) == 0 || len(refreshTokenValues) == 0 {
+		logger.Error("oidc session does not have tokens")
+		http.Error(w, "failed to start session", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, h.newSessionCookie(AuthTokenCookieName, authTokenValues[0], h.tokenExpiresIn))
+	http.SetCookie(w, h.newSessionCookie(RefreshTokenCookieName, refreshTokenValues[0], h.refreshExpiresIn))
+	http.Redirect(w, r, h.postLoginRedirectURL, http.StatusFound)
+}
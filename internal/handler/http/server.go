@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
@@ -20,6 +21,8 @@ import (
 const (
 	//nolint:gosec // This is just to specify the metadata name
 	AuthTokenCookieName = "GOLOAD_AUTH"
+	//nolint:gosec // This is just to specify the cookie name
+	RefreshTokenCookieName = "GOLOAD_REFRESH"
 )
 
 type Server interface {
@@ -47,45 +50,117 @@ func NewServer(
 	}
 }
 
-func (s server) getGRPCGatewayHandler(ctx context.Context) (http.Handler, error) {
-	tokenExpiresIn, err := s.authConfig.Token.GetExpiresInDuration()
-	if err != nil {
-		return nil, err
-	}
+func (s server) getGRPCClientConn() (*grpc.ClientConn, error) {
+	return grpc.NewClient(
+		s.grpcConfig.Address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+}
 
+func (s server) getGRPCGatewayHandler(
+	ctx context.Context,
+	goLoadServiceClient go_load.GoLoadServiceClient,
+	goLoadAdminServiceClient go_load.GoLoadAdminServiceClient,
+	tokenExpiresIn time.Duration,
+	refreshTokenExpiresIn time.Duration,
+) (http.Handler, error) {
 	grpcMux := runtime.NewServeMux(
 		servermuxoptions.WithAuthCookieToAuthMetadata(AuthTokenCookieName, handlerGRPC.AuthTokenMetadataName),
+		servermuxoptions.WithAuthCookieToAuthMetadata(RefreshTokenCookieName, handlerGRPC.RefreshTokenMetadataName),
 		servermuxoptions.WithAuthMetadataToAuthCookie(handlerGRPC.AuthTokenMetadataName, AuthTokenCookieName, tokenExpiresIn),
-		servermuxoptions.WithRemoveGoAuthMetadata(handlerGRPC.AuthTokenMetadataName),
-		servermuxoptions.WithErrorHandler(handlerGRPC.AuthTokenMetadataName),
+		servermuxoptions.WithAuthMetadataToAuthCookie(handlerGRPC.RefreshTokenMetadataName, RefreshTokenCookieName, refreshTokenExpiresIn),
+		servermuxoptions.WithRemoveGoAuthMetadata(handlerGRPC.AuthTokenMetadataName, handlerGRPC.RefreshTokenMetadataName),
+		servermuxoptions.WithErrorHandler(AuthTokenCookieName, RefreshTokenCookieName),
 		servermuxoptions.WithSSE(),
 	)
 
-	err = go_load.RegisterGoLoadServiceHandlerFromEndpoint(
-		ctx,
-		grpcMux,
-		s.grpcConfig.Address,
-		[]grpc.DialOption{
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		},
-	)
+	err := go_load.RegisterGoLoadServiceHandlerClient(ctx, grpcMux, goLoadServiceClient)
+	if err != nil {
+		return nil, err
+	}
+
+	err = go_load.RegisterGoLoadAdminServiceHandlerClient(ctx, grpcMux, goLoadAdminServiceClient)
+	if err != nil {
+		return nil, err
+	}
 
+	err = grpcMux.HandlePath(
+		http.MethodGet,
+		DownloadTaskFilesAsArchivePath,
+		newDownloadTaskFilesAsArchiveHandler(goLoadServiceClient, s.logger).Handle,
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	postLoginRedirectURL := s.authConfig.OIDC.PostLoginRedirectURL
+	if postLoginRedirectURL == "" {
+		postLoginRedirectURL = "/"
+	}
+
+	oidcLoginHandler := newOIDCLoginHandler(
+		goLoadServiceClient, postLoginRedirectURL, tokenExpiresIn, refreshTokenExpiresIn, s.logger)
+	if err = grpcMux.HandlePath(http.MethodGet, OIDCLoginPath, oidcLoginHandler.HandleLogin); err != nil {
+		return nil, err
+	}
+
+	if err = grpcMux.HandlePath(http.MethodGet, OIDCCallbackPath, oidcLoginHandler.HandleCallback); err != nil {
+		return nil, err
+	}
+
 	return grpcMux, nil
 }
 
 func (s server) Start(ctx context.Context) error {
 	logger := utils.LoggerWithContext(ctx, s.logger)
 
-	grpcGatewayHandler, err := s.getGRPCGatewayHandler(ctx)
+	tokenExpiresIn, err := s.authConfig.Token.GetExpiresInDuration()
 	if err != nil {
 		return err
 	}
 
-	handler := middleware.CorsMiddleware(grpcGatewayHandler)
+	regenerateTokenBeforeExpiry, err := s.authConfig.Token.GetRegenerateTokenBeforeExpiry()
+	if err != nil {
+		return err
+	}
+
+	refreshTokenExpiresIn, err := s.authConfig.RefreshToken.GetExpiresInDuration()
+	if err != nil {
+		return err
+	}
+
+	shutdownTimeout, err := s.httpConfig.GetShutdownTimeoutDuration()
+	if err != nil {
+		return err
+	}
+
+	grpcClientConn, err := s.getGRPCClientConn()
+	if err != nil {
+		return err
+	}
+
+	// Closed only once the http server is shut down, in-flight requests still need it to reach the grpc server
+	defer grpcClientConn.Close()
+
+	goLoadServiceClient := go_load.NewGoLoadServiceClient(grpcClientConn)
+	goLoadAdminServiceClient := go_load.NewGoLoadAdminServiceClient(grpcClientConn)
+	grpcGatewayHandler, err := s.getGRPCGatewayHandler(
+		ctx, goLoadServiceClient, goLoadAdminServiceClient, tokenExpiresIn, refreshTokenExpiresIn)
+	if err != nil {
+		return err
+	}
+
+	sessionRenewalMiddleware := middleware.NewSessionRenewalMiddleware(goLoadServiceClient, middleware.SessionRenewalConfig{
+		AuthCookieName:         AuthTokenCookieName,
+		RefreshCookieName:      RefreshTokenCookieName,
+		AuthMetadataName:       handlerGRPC.AuthTokenMetadataName,
+		RefreshMetadataName:    handlerGRPC.RefreshTokenMetadataName,
+		AuthCookieExpiresIn:    tokenExpiresIn,
+		RefreshCookieExpiresIn: refreshTokenExpiresIn,
+		RenewBeforeExpiry:      regenerateTokenBeforeExpiry,
+	}, s.logger)
+
+	handler := middleware.CorsMiddleware(sessionRenewalMiddleware(grpcGatewayHandler))
 
 	httpServer := http.Server{
 		Addr:              s.httpConfig.Address,
@@ -93,6 +168,29 @@ func (s server) Start(ctx context.Context) error {
 		ReadHeaderTimeout: time.Minute,
 	}
 
+	shutdownErrChannel := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		logger.Info("stopping http server")
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+
+		if shutdownErr := httpServer.Shutdown(shutdownCtx); shutdownErr != nil {
+			// Streaming responses such as file downloads would keep Shutdown waiting forever
+			logger.With(zap.Error(shutdownErr)).Warn("http server did not shut down within shutdown timeout, closing remaining connections")
+			shutdownErrChannel <- httpServer.Close()
+			return
+		}
+
+		shutdownErrChannel <- nil
+	}()
+
 	logger.With(zap.String("address", s.httpConfig.Address)).Info("Starting http server")
-	return httpServer.ListenAndServe()
+	if err = httpServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	// ListenAndServe returns as soon as Shutdown is called, before in-flight requests are done
+	return <-shutdownErrChannel
 }
@@ -2,10 +2,14 @@ package servermuxoptions
 
 import (
 	"context"
+	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -41,6 +45,12 @@ func WithAuthMetadataToAuthCookie(
 			return nil
 		}
 
+		// An empty value is sent when the session ends
+		if authMetadataValues[0] == "" {
+			http.SetCookie(w, newExpiredCookie(authCookieName))
+			return nil
+		}
+
 		http.SetCookie(w, &http.Cookie{
 			Name:     authCookieName,
 			Value:    authMetadataValues[0],
@@ -54,16 +64,32 @@ func WithAuthMetadataToAuthCookie(
 	})
 }
 
-func WithRemoveGoAuthMetadata(authMetadataName string) runtime.ServeMuxOption {
+func newExpiredCookie(cookieName string) *http.Cookie {
+	return &http.Cookie{
+		Name:     cookieName,
+		Value:    "",
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Expires:  time.Unix(0, 0),
+		Path:     "/",
+		MaxAge:   -1,
+	}
+}
+
+// WithRemoveGoAuthMetadata keeps tokens out of the response headers, they are only sent as http only cookies
+func WithRemoveGoAuthMetadata(authMetadataNameList ...string) runtime.ServeMuxOption {
 	return runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) {
-		if s == authMetadataName {
-			return "", false
+		for _, authMetadataName := range authMetadataNameList {
+			// Header metadata keys are lower cased by grpc
+			if strings.EqualFold(s, authMetadataName) {
+				return "", false
+			}
 		}
 		return runtime.DefaultHeaderMatcher(s)
 	})
 }
 
-func WithErrorHandler(authMetadataName string) runtime.ServeMuxOption {
+func WithErrorHandler(authCookieNameList ...string) runtime.ServeMuxOption {
 	return runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 		s, ok := status.FromError(err)
 		if !ok {
@@ -71,19 +97,31 @@ func WithErrorHandler(authMetadataName string) runtime.ServeMuxOption {
 			return
 		}
 
-		// Remove cookie
+		// Remove cookies
 		if s.Code() == codes.Unauthenticated {
-			http.SetCookie(w, &http.Cookie{
-				Name:     authMetadataName,
-				Value:    "",
-				HttpOnly: true,
-				SameSite: http.SameSiteStrictMode,
-				Expires:  time.Unix(0, 0),
-				Path:     "/",
-				MaxAge:   -1,
-			})
+			for _, authCookieName := range authCookieNameList {
+				http.SetCookie(w, newExpiredCookie(authCookieName))
+			}
+		}
+
+		if s.Code() == codes.ResourceExhausted {
+			setRetryAfterHeader(w, s)
 		}
 
 		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
 	})
 }
+
+// setRetryAfterHeader turns the RetryInfo detail of rate limited requests into the http Retry-After header
+func setRetryAfterHeader(w http.ResponseWriter, s *status.Status) {
+	for _, detail := range s.Details() {
+		retryInfo, ok := detail.(*errdetails.RetryInfo)
+		if !ok || retryInfo.GetRetryDelay() == nil {
+			continue
+		}
+
+		retryDelayInSeconds := int64(math.Ceil(retryInfo.GetRetryDelay().AsDuration().Seconds()))
+		w.Header().Set("Retry-After", strconv.FormatInt(retryDelayInSeconds, 10))
+		return
+	}
+}
@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/nhtuan0700/GoLoad/internal/logic"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type ExpireDownloadTasks interface {
+	Run(ctx context.Context) error
+}
+
+type expireDownloadTasks struct {
+	downloadTaskLogic logic.DownloadTask
+	logger            *zap.Logger
+}
+
+func NewExpireDownloadTasks(
+	downloadTaskLogic logic.DownloadTask,
+	logger *zap.Logger,
+) ExpireDownloadTasks {
+	return &expireDownloadTasks{
+		downloadTaskLogic: downloadTaskLogic,
+		logger:            logger,
+	}
+}
+
+func (e expireDownloadTasks) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, e.logger)
+
+	if err := e.downloadTaskLogic.ExpireDownloadTasks(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to expire download tasks")
+		return err
+	}
+
+	return nil
+}
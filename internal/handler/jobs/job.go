@@ -0,0 +1,88 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type Root interface {
+	Start(ctx context.Context) error
+}
+
+type root struct {
+	expireDownloadTasksJob   ExpireDownloadTasks
+	rotateTokenSigningKeyJob RotateTokenSigningKey
+	cronConfig               configs.Cron
+	logger                   *zap.Logger
+}
+
+func NewRoot(
+	expireDownloadTasksJob ExpireDownloadTasks,
+	rotateTokenSigningKeyJob RotateTokenSigningKey,
+	cronConfig configs.Cron,
+	logger *zap.Logger,
+) Root {
+	return &root{
+		expireDownloadTasksJob:   expireDownloadTasksJob,
+		rotateTokenSigningKeyJob: rotateTokenSigningKeyJob,
+		cronConfig:               cronConfig,
+		logger:                   logger,
+	}
+}
+
+// runPeriodically runs jobFunc every interval until ctx is done, a failed run is logged and retried on the next tick
+func (r root) runPeriodically(ctx context.Context, jobName string, interval time.Duration, jobFunc func(context.Context) error) {
+	logger := utils.LoggerWithContext(ctx, r.logger).With(zap.String("job_name", jobName))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := jobFunc(ctx); err != nil {
+				logger.With(zap.Error(err)).Error("job failed")
+			}
+
+		case <-ctx.Done():
+			logger.Info("job stopped")
+			return
+		}
+	}
+}
+
+func (r root) Start(ctx context.Context) error {
+	expireDownloadTasksInterval, err := r.cronConfig.ExpireDownloadTasks.GetIntervalDuration()
+	if err != nil {
+		r.logger.With(zap.Error(err)).Error("failed to parse expire_download_tasks interval")
+		return err
+	}
+
+	rotateTokenSigningKeyInterval, err := r.cronConfig.RotateTokenSigningKey.GetIntervalDuration()
+	if err != nil {
+		r.logger.With(zap.Error(err)).Error("failed to parse rotate_token_signing_key interval")
+		return err
+	}
+
+	r.logger.Info("Starting root job scheduler")
+
+	var waitGroup sync.WaitGroup
+	waitGroup.Add(2)
+	go func() {
+		defer waitGroup.Done()
+		r.runPeriodically(ctx, "expire_download_tasks", expireDownloadTasksInterval, r.expireDownloadTasksJob.Run)
+	}()
+
+	go func() {
+		defer waitGroup.Done()
+		r.runPeriodically(ctx, "rotate_token_signing_key", rotateTokenSigningKeyInterval, r.rotateTokenSigningKeyJob.Run)
+	}()
+
+	waitGroup.Wait()
+	return nil
+}
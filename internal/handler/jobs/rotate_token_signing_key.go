@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/nhtuan0700/GoLoad/internal/logic"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+type RotateTokenSigningKey interface {
+	Run(ctx context.Context) error
+}
+
+type rotateTokenSigningKey struct {
+	tokenSigningKeyLogic logic.TokenSigningKey
+	logger               *zap.Logger
+}
+
+func NewRotateTokenSigningKey(
+	tokenSigningKeyLogic logic.TokenSigningKey,
+	logger *zap.Logger,
+) RotateTokenSigningKey {
+	return &rotateTokenSigningKey{
+		tokenSigningKeyLogic: tokenSigningKeyLogic,
+		logger:               logger,
+	}
+}
+
+func (r rotateTokenSigningKey) Run(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, r.logger)
+
+	if err := r.tokenSigningKeyLogic.RotateSigningKey(ctx); err != nil {
+		logger.With(zap.Error(err)).Error("failed to rotate token signing key")
+		return err
+	}
+
+	return nil
+}
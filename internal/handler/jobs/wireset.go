@@ -0,0 +1,9 @@
+package jobs
+
+import "github.com/google/wire"
+
+var WireSet = wire.NewSet(
+	NewRoot,
+	NewExpireDownloadTasks,
+	NewRotateTokenSigningKey,
+)
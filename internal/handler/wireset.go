@@ -5,10 +5,12 @@ import (
 	"github.com/nhtuan0700/GoLoad/internal/handler/consumers"
 	"github.com/nhtuan0700/GoLoad/internal/handler/grpc"
 	"github.com/nhtuan0700/GoLoad/internal/handler/http"
+	"github.com/nhtuan0700/GoLoad/internal/handler/jobs"
 )
 
 var WireSet = wire.NewSet(
 	grpc.WireSet,
 	http.WireSet,
 	consumers.WireSet,
+	jobs.WireSet,
 )
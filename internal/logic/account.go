@@ -3,20 +3,48 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
 	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	AccountRoleUser  = "user"
+	AccountRoleAdmin = "admin"
+)
+
+var (
+	errAccountDisabled = status.Error(codes.PermissionDenied, "account is disabled")
+	// errIncorrectPassword is returned when a signed in account confirms its password, it is not Unauthenticated
+	// since the session of the request is still valid
+	errIncorrectPassword = status.Error(codes.PermissionDenied, "incorrect password")
+
+	accountRoleToProtoAccountRole = map[string]go_load.AccountRole{
+		AccountRoleUser:  go_load.AccountRole_ACCOUNT_ROLE_USER,
+		AccountRoleAdmin: go_load.AccountRole_ACCOUNT_ROLE_ADMIN,
+	}
+	protoAccountRoleToAccountRole = lo.Invert(accountRoleToProtoAccountRole)
 )
 
 type CreateAccountParams struct {
 	AccountName     string
 	AccountPassword string
+	// Email is empty if the account does not set one
+	Email string
 }
 
 type CreateAccountOutput struct {
@@ -27,45 +55,157 @@ type CreateAccountOutput struct {
 type CreateSessionParams struct {
 	AccountName     string
 	AccountPassword string
+	UserAgent       string
+	// ClientIP is empty if it is not known, failed logins are then only counted per account name
+	ClientIP string
 }
 
 type CreateSessionOutput struct {
-	Token   string
+	Token        string
+	RefreshToken string
+	Account      *go_load.Account
+	// ChallengeToken is set instead of the other fields when the account has totp enabled
+	ChallengeToken string
+}
+
+type UpdateAccountRetentionPolicyParams struct {
+	Principal Principal
+	// DownloadTaskRetention is nil to fall back to the configured default retention
+	DownloadTaskRetention *time.Duration
+}
+
+type UpdateAccountRetentionPolicyOutput struct {
+	Account *go_load.Account
+}
+
+type UpdateAccountPostProcessorListParams struct {
+	Principal Principal
+	// PostProcessorNameList is nil to fall back to the configured default post processor list
+	PostProcessorNameList []string
+}
+
+type UpdateAccountPostProcessorListOutput struct {
 	Account *go_load.Account
 }
 
+type UpdateAccountEmailParams struct {
+	Principal Principal
+	// Email is empty to remove the email of the account
+	Email string
+}
+
+type UpdateAccountEmailOutput struct {
+	Account *go_load.Account
+}
+
+type ChangePasswordParams struct {
+	Principal   Principal
+	OldPassword string
+	NewPassword string
+}
+
+type DeleteAccountParams struct {
+	Principal Principal
+	Password  string
+}
+
 type Account interface {
 	CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error)
 	CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error)
+	UpdateAccountRetentionPolicy(ctx context.Context, params UpdateAccountRetentionPolicyParams) (UpdateAccountRetentionPolicyOutput, error)
+	UpdateAccountPostProcessorList(ctx context.Context, params UpdateAccountPostProcessorListParams) (UpdateAccountPostProcessorListOutput, error)
+	UpdateAccountEmail(ctx context.Context, params UpdateAccountEmailParams) (UpdateAccountEmailOutput, error)
+	// ChangePassword revokes every other session of the account, the session of the request stays signed in
+	ChangePassword(ctx context.Context, params ChangePasswordParams) error
+	// DeleteAccount removes the account with its sessions, api keys, totp, download tasks and their stored files,
+	// download tasks it created in teams are given to another owner of the team instead
+	DeleteAccount(ctx context.Context, params DeleteAccountParams) error
 }
 
 type account struct {
-	goquDatabase                *goqu.Database
-	accountDataAccessor         database.AccountDataAccessor
-	accountPasswordDataAccessor database.AccountPasswordDataAccessor
-	takenAccountNameCache       cache.TakeAccountName
-	hashLogic                   Hash
-	tokenLogic                  Token
-	logger                      *zap.Logger
+	goquDatabase                        *goqu.Database
+	accountDataAccessor                 database.AccountDataAccessor
+	accountPasswordDataAccessor         database.AccountPasswordDataAccessor
+	sessionDataAccessor                 database.SessionDataAccessor
+	apiKeyDataAccessor                  database.APIKeyDataAccessor
+	passwordResetTokenDataAccessor      database.PasswordResetTokenDataAccessor
+	accountTOTPDataAccessor             database.AccountTOTPDataAccessor
+	accountRecoveryCodeDataAccessor     database.AccountRecoveryCodeDataAccessor
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor
+	teamDataAccessor                    database.TeamDataAccessor
+	teamMemberDataAccessor              database.TeamMemberDataAccessor
+	teamInvitationDataAccessor          database.TeamInvitationDataAccessor
+	downloadTaskShareDataAccessor       database.DownloadTaskShareDataAccessor
+	downloadTaskDataAccessor            database.DownloadTaskDataAccessor
+	auditLogDataAccessor                database.AuditLogDataAccessor
+	lifecycleEventProducer              producer.DownloadTaskLifecycleEventProducer
+	takenAccountNameCache               cache.TakeAccountName
+	fileClient                          file.Client
+	hashLogic                           Hash
+	passwordPolicyLogic                 PasswordPolicy
+	sessionLogic                        Session
+	loginLockoutLogic                   LoginLockout
+	totpLogic                           TOTP
+	postProcessorCatalog                PostProcessorCatalog
+	authConfig                          configs.Auth
+	logger                              *zap.Logger
 }
 
 func NewAccount(
 	goquDatabase *goqu.Database,
 	accountDataAccessor database.AccountDataAccessor,
 	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor,
+	accountTOTPDataAccessor database.AccountTOTPDataAccessor,
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor,
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor,
+	teamDataAccessor database.TeamDataAccessor,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	teamInvitationDataAccessor database.TeamInvitationDataAccessor,
+	downloadTaskShareDataAccessor database.DownloadTaskShareDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	lifecycleEventProducer producer.DownloadTaskLifecycleEventProducer,
 	takenAccountNameCache cache.TakeAccountName,
+	fileClient file.Client,
 	hashLogic Hash,
-	tokenLogic Token,
+	passwordPolicyLogic PasswordPolicy,
+	sessionLogic Session,
+	loginLockoutLogic LoginLockout,
+	totpLogic TOTP,
+	postProcessorCatalog PostProcessorCatalog,
+	authConfig configs.Auth,
 	logger *zap.Logger,
 ) Account {
 	return &account{
-		goquDatabase:                goquDatabase,
-		accountDataAccessor:         accountDataAccessor,
-		accountPasswordDataAccessor: accountPasswordDataAccessor,
-		takenAccountNameCache:       takenAccountNameCache,
-		hashLogic:                   hashLogic,
-		tokenLogic:                  tokenLogic,
-		logger:                      logger,
+		goquDatabase:                        goquDatabase,
+		accountDataAccessor:                 accountDataAccessor,
+		accountPasswordDataAccessor:         accountPasswordDataAccessor,
+		sessionDataAccessor:                 sessionDataAccessor,
+		apiKeyDataAccessor:                  apiKeyDataAccessor,
+		passwordResetTokenDataAccessor:      passwordResetTokenDataAccessor,
+		accountTOTPDataAccessor:             accountTOTPDataAccessor,
+		accountRecoveryCodeDataAccessor:     accountRecoveryCodeDataAccessor,
+		accountExternalIdentityDataAccessor: accountExternalIdentityDataAccessor,
+		teamDataAccessor:                    teamDataAccessor,
+		teamMemberDataAccessor:              teamMemberDataAccessor,
+		teamInvitationDataAccessor:          teamInvitationDataAccessor,
+		downloadTaskShareDataAccessor:       downloadTaskShareDataAccessor,
+		downloadTaskDataAccessor:            downloadTaskDataAccessor,
+		auditLogDataAccessor:                auditLogDataAccessor,
+		lifecycleEventProducer:              lifecycleEventProducer,
+		takenAccountNameCache:               takenAccountNameCache,
+		fileClient:                          fileClient,
+		hashLogic:                           hashLogic,
+		passwordPolicyLogic:                 passwordPolicyLogic,
+		sessionLogic:                        sessionLogic,
+		loginLockoutLogic:                   loginLockoutLogic,
+		totpLogic:                           totpLogic,
+		postProcessorCatalog:                postProcessorCatalog,
+		authConfig:                          authConfig,
+		logger:                              logger,
 	}
 }
 
@@ -96,6 +236,10 @@ func (a *account) isAccountNameTaken(ctx context.Context, accountName string) (b
 }
 
 func (a *account) CreateAccount(ctx context.Context, params CreateAccountParams) (CreateAccountOutput, error) {
+	if err := a.passwordPolicyLogic.Validate(ctx, params.AccountPassword); err != nil {
+		return CreateAccountOutput{}, err
+	}
+
 	isAccountNameTaken, err := a.isAccountNameTaken(ctx, params.AccountName)
 	if err != nil {
 		return CreateAccountOutput{}, status.Error(codes.Internal, "failed to check if account name is taken")
@@ -108,7 +252,9 @@ func (a *account) CreateAccount(ctx context.Context, params CreateAccountParams)
 	var accountID uint64
 	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		accountID, err = a.accountDataAccessor.WithDatabase(td).CreateAccount(ctx, database.Account{
-			Name: params.AccountName,
+			Name:  params.AccountName,
+			Role:  AccountRoleUser,
+			Email: emailToDatabaseEmail(params.Email),
 		})
 		if err != nil {
 			return err
@@ -140,16 +286,56 @@ func (a *account) CreateAccount(ctx context.Context, params CreateAccountParams)
 	}, nil
 }
 
-func (a *account) databaseAccountToProtoAccount(account database.Account) *go_load.Account {
-	return &go_load.Account{
+// emailToDatabaseEmail stores accounts without an email as NULL
+func emailToDatabaseEmail(email string) *string {
+	if email == "" {
+		return nil
+	}
+
+	return &email
+}
+
+func databaseAccountToProtoAccount(account database.Account) *go_load.Account {
+	protoAccount := &go_load.Account{
 		Id:          account.ID,
 		AccountName: account.Name,
+		Role:        accountRoleToProtoAccountRole[account.Role],
+		Email:       lo.FromPtr(account.Email),
+	}
+
+	if account.DisabledAt != nil {
+		protoAccount.DisabledAt = timestamppb.New(*account.DisabledAt)
+	}
+
+	if account.DownloadTaskRetention != nil {
+		protoAccount.DownloadTaskRetention = durationpb.New(time.Duration(*account.DownloadTaskRetention) * time.Second)
+	}
+
+	if account.PostProcessorList.Data != nil {
+		if postProcessorNameList, err := convertJSONValue[[]string](account.PostProcessorList.Data); err == nil {
+			protoAccount.PostProcessorList = &go_load.PostProcessorList{
+				PostProcessorNameList: postProcessorNameList,
+			}
+		}
 	}
+
+	return protoAccount
 }
 
 func (a *account) CreateSession(ctx context.Context, params CreateSessionParams) (CreateSessionOutput, error) {
+	loginLockoutParams := LoginLockoutParams{
+		AccountName: params.AccountName,
+		ClientIP:    params.ClientIP,
+	}
+	if err := a.loginLockoutLogic.Check(ctx, loginLockoutParams); err != nil {
+		return CreateSessionOutput{}, err
+	}
+
 	existingAccount, err := a.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
 	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			a.loginLockoutLogic.RecordFailure(ctx, loginLockoutParams)
+		}
 		return CreateSessionOutput{}, err
 	}
 
@@ -164,16 +350,409 @@ func (a *account) CreateSession(ctx context.Context, params CreateSessionParams)
 	}
 
 	if !isHashEqual {
+		a.loginLockoutLogic.RecordFailure(ctx, loginLockoutParams)
 		return CreateSessionOutput{}, status.Error(codes.Unauthenticated, "incorrect password")
 	}
 
-	token, _, err := a.tokenLogic.GetToken(ctx, existingAccount.ID)
+	a.rehashPasswordIfNeeded(ctx, existingAccountPassword, params.AccountPassword)
+
+	// Checked after the password so the response does not tell whether a disabled account exists
+	if existingAccount.DisabledAt != nil {
+		return CreateSessionOutput{}, errAccountDisabled
+	}
+
+	// The failed count is only reset once the second factor is verified, otherwise entering the password again
+	// would let an attacker guess codes without being locked out
+	challengeToken, err := a.totpLogic.CreateLoginChallenge(ctx, existingAccount.ID)
+	if err != nil {
+		return CreateSessionOutput{}, err
+	}
+
+	if challengeToken != "" {
+		return CreateSessionOutput{ChallengeToken: challengeToken}, nil
+	}
+
+	a.loginLockoutLogic.RecordSuccess(ctx, loginLockoutParams)
+
+	startSessionOutput, err := a.sessionLogic.StartSession(ctx, StartSessionParams{
+		AccountID: existingAccount.ID,
+		UserAgent: params.UserAgent,
+	})
 	if err != nil {
 		return CreateSessionOutput{}, err
 	}
 
 	return CreateSessionOutput{
-		Token:   token,
-		Account: a.databaseAccountToProtoAccount(existingAccount),
+		Token:        startSessionOutput.Token,
+		RefreshToken: startSessionOutput.RefreshToken,
+		Account:      databaseAccountToProtoAccount(existingAccount),
 	}, nil
 }
+
+// rehashPasswordIfNeeded upgrades the hash of a password that was just verified to the configured algorithm and
+// parameters, a failure is only logged since the login itself succeeded
+func (a *account) rehashPasswordIfNeeded(ctx context.Context, accountPassword database.AccountPassword, password string) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountPassword.OfAccountID))
+
+	if !a.hashLogic.NeedsRehash(accountPassword.Hash) {
+		return
+	}
+
+	hashedPassword, err := a.hashLogic.Hash(ctx, password)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to rehash password")
+		return
+	}
+
+	accountPassword.Hash = hashedPassword
+	if err = a.accountPasswordDataAccessor.UpdateAccountPassword(ctx, accountPassword); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to update rehashed password")
+		return
+	}
+
+	logger.Info("rehashed password with the configured hash algorithm")
+}
+
+func (a *account) UpdateAccountRetentionPolicy(
+	ctx context.Context,
+	params UpdateAccountRetentionPolicyParams,
+) (UpdateAccountRetentionPolicyOutput, error) {
+	accountID := params.Principal.AccountID
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return UpdateAccountRetentionPolicyOutput{}, err
+	}
+
+	existingAccount.DownloadTaskRetention = nil
+	if params.DownloadTaskRetention != nil {
+		retentionInSeconds := uint64(params.DownloadTaskRetention.Seconds())
+		existingAccount.DownloadTaskRetention = &retentionInSeconds
+	}
+
+	if err = a.accountDataAccessor.UpdateAccount(ctx, existingAccount); err != nil {
+		return UpdateAccountRetentionPolicyOutput{}, err
+	}
+
+	return UpdateAccountRetentionPolicyOutput{
+		Account: databaseAccountToProtoAccount(existingAccount),
+	}, nil
+}
+
+func (a *account) UpdateAccountPostProcessorList(
+	ctx context.Context,
+	params UpdateAccountPostProcessorListParams,
+) (UpdateAccountPostProcessorListOutput, error) {
+	accountID := params.Principal.AccountID
+
+	if err := a.postProcessorCatalog.ValidatePostProcessorNameList(params.PostProcessorNameList); err != nil {
+		return UpdateAccountPostProcessorListOutput{}, err
+	}
+
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return UpdateAccountPostProcessorListOutput{}, err
+	}
+
+	existingAccount.PostProcessorList = database.JSON{}
+	if params.PostProcessorNameList != nil {
+		existingAccount.PostProcessorList.Data = params.PostProcessorNameList
+	}
+
+	if err = a.accountDataAccessor.UpdateAccount(ctx, existingAccount); err != nil {
+		return UpdateAccountPostProcessorListOutput{}, err
+	}
+
+	return UpdateAccountPostProcessorListOutput{
+		Account: databaseAccountToProtoAccount(existingAccount),
+	}, nil
+}
+
+func (a *account) UpdateAccountEmail(ctx context.Context, params UpdateAccountEmailParams) (UpdateAccountEmailOutput, error) {
+	existingAccount, err := a.accountDataAccessor.GetAccountByID(ctx, params.Principal.AccountID)
+	if err != nil {
+		return UpdateAccountEmailOutput{}, err
+	}
+
+	existingAccount.Email = emailToDatabaseEmail(params.Email)
+	if err = a.accountDataAccessor.UpdateAccount(ctx, existingAccount); err != nil {
+		return UpdateAccountEmailOutput{}, err
+	}
+
+	return UpdateAccountEmailOutput{
+		Account: databaseAccountToProtoAccount(existingAccount),
+	}, nil
+}
+
+// checkAccountPassword confirms the password of a signed in account before a sensitive change
+func checkAccountPassword(
+	ctx context.Context,
+	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	hashLogic Hash,
+	accountID uint64,
+	password string,
+) error {
+	existingAccountPassword, err := accountPasswordDataAccessor.GetAccountPassword(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	isHashEqual, err := hashLogic.IsHashEqual(ctx, password, existingAccountPassword.Hash)
+	if err != nil {
+		return err
+	}
+
+	if !isHashEqual {
+		return errIncorrectPassword
+	}
+
+	return nil
+}
+
+func (a *account) ChangePassword(ctx context.Context, params ChangePasswordParams) error {
+	accountID := params.Principal.AccountID
+
+	if err := checkAccountPassword(ctx, a.accountPasswordDataAccessor, a.hashLogic, accountID, params.OldPassword); err != nil {
+		return err
+	}
+
+	if err := a.passwordPolicyLogic.Validate(ctx, params.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := a.hashLogic.Hash(ctx, params.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		err = a.accountPasswordDataAccessor.WithDatabase(td).UpdateAccountPassword(ctx, database.AccountPassword{
+			OfAccountID: accountID,
+			Hash:        hashedPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, a.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionChangePassword,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   accountID,
+		})
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	return a.sessionLogic.RevokeAccountSessions(ctx, RevokeAccountSessionsParams{
+		AccountID:       accountID,
+		ExceptSessionID: params.Principal.SessionID,
+	})
+}
+
+// deleteTeamMembershipList fails if the account is the last owner of a team, the team would be left without anyone
+// to manage it
+func (a *account) deleteTeamMembershipList(ctx context.Context, td *goqu.TxDatabase, accountID uint64) error {
+	teamMemberDataAccessor := a.teamMemberDataAccessor.WithDatabase(td)
+	teamMemberList, err := teamMemberDataAccessor.GetTeamMemberListByAccount(ctx, accountID)
+	if err != nil {
+		return err
+	}
+
+	for _, teamMember := range teamMemberList {
+		if TeamRole(teamMember.Role) != TeamRoleOwner {
+			continue
+		}
+
+		if _, err = a.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, teamMember.OfTeamID); err != nil {
+			return err
+		}
+
+		memberListOfTeam, err := teamMemberDataAccessor.GetTeamMemberListByTeam(ctx, teamMember.OfTeamID)
+		if err != nil {
+			return err
+		}
+
+		if !hasOtherTeamOwner(memberListOfTeam, accountID) {
+			return status.Error(codes.FailedPrecondition,
+				fmt.Sprintf("the account is the last owner of team %d, delete the team or add another owner first", teamMember.OfTeamID))
+		}
+	}
+
+	if err = a.teamInvitationDataAccessor.WithDatabase(td).DeleteTeamInvitationListByInvitee(ctx, accountID); err != nil {
+		return err
+	}
+
+	return teamMemberDataAccessor.DeleteTeamMemberListByAccount(ctx, accountID)
+}
+
+// transferTeamDownloadTaskList gives the download tasks the account created in teams to another owner of each team,
+// the rest of the team still depends on them. It fails if a team has no other owner
+func (a *account) transferTeamDownloadTaskList(
+	ctx context.Context,
+	td *goqu.TxDatabase,
+	accountID uint64,
+	downloadTaskList []database.DownloadTask,
+) error {
+	teamIDList := lo.Uniq(lo.FilterMap(downloadTaskList, func(item database.DownloadTask, _ int) (uint64, bool) {
+		return lo.FromPtr(item.OfTeamID), item.OfTeamID != nil
+	}))
+
+	for _, teamID := range teamIDList {
+		if _, err := a.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, teamID); err != nil {
+			return err
+		}
+
+		memberListOfTeam, err := a.teamMemberDataAccessor.WithDatabase(td).GetTeamMemberListByTeam(ctx, teamID)
+		if err != nil {
+			return err
+		}
+
+		newOwner, ok := lo.Find(memberListOfTeam, func(item database.TeamMember) bool {
+			return item.OfAccountID != accountID && TeamRole(item.Role) == TeamRoleOwner
+		})
+		if !ok {
+			return status.Error(codes.FailedPrecondition,
+				fmt.Sprintf("team %d has no other owner to take over the download tasks of the account", teamID))
+		}
+
+		err = a.downloadTaskDataAccessor.WithDatabase(td).TransferTeamDownloadTaskList(
+			ctx, teamID, accountID, newOwner.OfAccountID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *account) DeleteAccount(ctx context.Context, params DeleteAccountParams) error {
+	accountID := params.Principal.AccountID
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", accountID))
+
+	if err := checkAccountPassword(ctx, a.accountPasswordDataAccessor, a.hashLogic, accountID, params.Password); err != nil {
+		return err
+	}
+
+	var (
+		existingAccount  database.Account
+		downloadTaskList []database.DownloadTask
+	)
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		existingAccount, err = a.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		downloadTaskList, _, err = a.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskListByFilter(
+			ctx, database.DownloadTaskFilter{OfAccountID: &accountID}, 0, 0)
+		if err != nil {
+			return err
+		}
+
+		// Team download tasks are kept, only the download tasks of the account outside of teams are deleted
+		if err = a.transferTeamDownloadTaskList(ctx, td, accountID, downloadTaskList); err != nil {
+			return err
+		}
+
+		downloadTaskList = lo.Filter(downloadTaskList, func(item database.DownloadTask, _ int) bool {
+			return item.OfTeamID == nil
+		})
+
+		if err = a.downloadTaskShareDataAccessor.WithDatabase(td).DeleteDownloadTaskShareListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.downloadTaskDataAccessor.WithDatabase(td).DeleteDownloadTaskListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.sessionDataAccessor.WithDatabase(td).DeleteSessionListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.apiKeyDataAccessor.WithDatabase(td).DeleteAPIKeyListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.passwordResetTokenDataAccessor.WithDatabase(td).DeletePasswordResetTokenListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.accountRecoveryCodeDataAccessor.WithDatabase(td).DeleteAccountRecoveryCodeListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.accountTOTPDataAccessor.WithDatabase(td).DeleteAccountTOTP(ctx, accountID); err != nil {
+			return err
+		}
+
+		err = a.accountExternalIdentityDataAccessor.WithDatabase(td).DeleteAccountExternalIdentityListByAccount(ctx, accountID)
+		if err != nil {
+			return err
+		}
+
+		if err = a.deleteTeamMembershipList(ctx, td, accountID); err != nil {
+			return err
+		}
+
+		if err = a.accountPasswordDataAccessor.WithDatabase(td).DeleteAccountPassword(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err = a.accountDataAccessor.WithDatabase(td).DeleteAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		err = createAuditLog(ctx, a.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionDeleteAccount,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   accountID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountName: existingAccount.Name,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		// Events are produced last, so subscribers are not told about deleted tasks while the deletion can still
+		// fail and be rolled back
+		for _, downloadTask := range downloadTaskList {
+			err = a.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+				producer.MessageQueueDownloadTaskDeleted, downloadTask))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// Tokens of the deleted sessions are rejected since their account cannot be found anymore, files are deleted
+	// after the commit so a failed transaction does not lose them, a file failing to be deleted is only logged
+	for _, downloadTask := range downloadTaskList {
+		metadata, ok := downloadTask.Metadata.Data.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		for _, filePath := range getStoredFilePathList(metadata) {
+			if err := a.fileClient.Delete(ctx, filePath); err != nil {
+				logger.With(zap.Error(err)).With(zap.String("file_path", filePath)).
+					Error("failed to delete file of deleted account")
+			}
+		}
+	}
+
+	if err := a.takenAccountNameCache.Remove(ctx, existingAccount.Name); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to remove account name from taken set in cache")
+	}
+
+	logger.Info("account deleted")
+	return nil
+}
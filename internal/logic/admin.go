@@ -0,0 +1,334 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListAccountsParams struct {
+	AccountNameContains string
+	// Role is unspecified to list accounts of every role
+	Role   go_load.AccountRole
+	Limit  uint64
+	Offset uint64
+}
+
+type ListAccountsOutput struct {
+	AccountList []*go_load.Account
+	TotalCount  uint64
+}
+
+type UpdateAccountRoleParams struct {
+	Principal Principal
+	AccountID uint64
+	Role      go_load.AccountRole
+}
+
+type UpdateAccountRoleOutput struct {
+	Account *go_load.Account
+}
+
+type SetAccountDisabledParams struct {
+	Principal Principal
+	AccountID uint64
+	Disabled  bool
+}
+
+type SetAccountDisabledOutput struct {
+	Account *go_load.Account
+}
+
+type GetSystemStatsOutput struct {
+	AccountCount            uint64
+	DisabledAccountCount    uint64
+	AdminAccountCount       uint64
+	DownloadTaskCount       uint64
+	DownloadStatusCountList []*go_load.DownloadStatusCount
+}
+
+type ListAuditLogsParams struct {
+	// OfAccountID is 0 to list audit logs of every account
+	OfAccountID uint64
+	// TargetType is empty to list audit logs of every target type, TargetID is only used if it is set
+	TargetType string
+	TargetID   uint64
+	Limit      uint64
+	Offset     uint64
+}
+
+type ListAuditLogsOutput struct {
+	AuditLogList []*go_load.AuditLog
+	TotalCount   uint64
+}
+
+// Admin is only called by the admin service, the admin role of the principal is checked by the auth interceptors
+type BootstrapAdminParams struct {
+	AccountName string
+}
+
+type BootstrapAdminOutput struct {
+	Account *go_load.Account
+}
+
+type Admin interface {
+	// BootstrapAdmin gives the admin role to an existing account, it is only run from the command line by operators
+	// since no admin exists yet to grant it through the api
+	BootstrapAdmin(ctx context.Context, params BootstrapAdminParams) (BootstrapAdminOutput, error)
+	ListAccounts(ctx context.Context, params ListAccountsParams) (ListAccountsOutput, error)
+	UpdateAccountRole(ctx context.Context, params UpdateAccountRoleParams) (UpdateAccountRoleOutput, error)
+	SetAccountDisabled(ctx context.Context, params SetAccountDisabledParams) (SetAccountDisabledOutput, error)
+	GetSystemStats(ctx context.Context) (GetSystemStatsOutput, error)
+	ListAuditLogs(ctx context.Context, params ListAuditLogsParams) (ListAuditLogsOutput, error)
+}
+
+type admin struct {
+	goquDatabase             *goqu.Database
+	accountDataAccessor      database.AccountDataAccessor
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor
+	auditLogDataAccessor     database.AuditLogDataAccessor
+	logger                   *zap.Logger
+}
+
+func NewAdmin(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	logger *zap.Logger,
+) Admin {
+	return &admin{
+		goquDatabase:             goquDatabase,
+		accountDataAccessor:      accountDataAccessor,
+		downloadTaskDataAccessor: downloadTaskDataAccessor,
+		auditLogDataAccessor:     auditLogDataAccessor,
+		logger:                   logger,
+	}
+}
+
+func (a admin) ListAccounts(ctx context.Context, params ListAccountsParams) (ListAccountsOutput, error) {
+	filter := database.AccountFilter{
+		AccountNameContains: params.AccountNameContains,
+	}
+	if params.Role != go_load.AccountRole_ACCOUNT_ROLE_UNSPECIFIED {
+		filter.Role = protoAccountRoleToAccountRole[params.Role]
+	}
+
+	accountList, totalCount, err := a.accountDataAccessor.GetAccountListByFilter(ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		return ListAccountsOutput{}, err
+	}
+
+	return ListAccountsOutput{
+		AccountList: lo.Map(accountList, func(item database.Account, _ int) *go_load.Account {
+			return databaseAccountToProtoAccount(item)
+		}),
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (a admin) BootstrapAdmin(ctx context.Context, params BootstrapAdminParams) (BootstrapAdminOutput, error) {
+	account, err := a.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+	if err != nil {
+		return BootstrapAdminOutput{}, err
+	}
+
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		account, err = a.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		if account.Role == AccountRoleAdmin {
+			return nil
+		}
+
+		previousRole := account.Role
+		account.Role = AccountRoleAdmin
+		if err = a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		// There is no principal on the command line, the promoted account is recorded as the actor
+		return createAuditLog(ctx, a.auditLogDataAccessor.WithDatabase(td), Principal{AccountID: account.ID}, auditLogEntry{
+			Action:     auditLogActionBootstrapAdmin,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   account.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNamePreviousRole: previousRole,
+				auditLogMetadataFieldNameRole:         AccountRoleAdmin,
+			},
+		})
+	})
+	if txErr != nil {
+		return BootstrapAdminOutput{}, txErr
+	}
+
+	return BootstrapAdminOutput{
+		Account: databaseAccountToProtoAccount(account),
+	}, nil
+}
+
+func (a admin) UpdateAccountRole(ctx context.Context, params UpdateAccountRoleParams) (UpdateAccountRoleOutput, error) {
+	role, ok := protoAccountRoleToAccountRole[params.Role]
+	if !ok {
+		return UpdateAccountRoleOutput{}, status.Error(codes.InvalidArgument, "unsupported account role")
+	}
+
+	// Admins cannot demote themselves, so there is always an admin left to grant the role back
+	if params.AccountID == params.Principal.AccountID {
+		return UpdateAccountRoleOutput{}, status.Error(codes.FailedPrecondition, "cannot update the role of your own account")
+	}
+
+	var account database.Account
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		account, err = a.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if account.Role == role {
+			return nil
+		}
+
+		previousRole := account.Role
+		account.Role = role
+		if err = a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, a.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionUpdateAccountRole,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   account.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNamePreviousRole: previousRole,
+				auditLogMetadataFieldNameRole:         role,
+			},
+		})
+	})
+	if txErr != nil {
+		return UpdateAccountRoleOutput{}, txErr
+	}
+
+	return UpdateAccountRoleOutput{
+		Account: databaseAccountToProtoAccount(account),
+	}, nil
+}
+
+func (a admin) SetAccountDisabled(ctx context.Context, params SetAccountDisabledParams) (SetAccountDisabledOutput, error) {
+	if params.Disabled && params.AccountID == params.Principal.AccountID {
+		return SetAccountDisabledOutput{}, status.Error(codes.FailedPrecondition, "cannot disable your own account")
+	}
+
+	var account database.Account
+	txErr := a.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		account, err = a.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		if (account.DisabledAt != nil) == params.Disabled {
+			return nil
+		}
+
+		action := auditLogActionEnableAccount
+		account.DisabledAt = nil
+		if params.Disabled {
+			action = auditLogActionDisableAccount
+			now := time.Now()
+			account.DisabledAt = &now
+		}
+
+		if err = a.accountDataAccessor.WithDatabase(td).UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, a.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     action,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   account.ID,
+		})
+	})
+	if txErr != nil {
+		return SetAccountDisabledOutput{}, txErr
+	}
+
+	return SetAccountDisabledOutput{
+		Account: databaseAccountToProtoAccount(account),
+	}, nil
+}
+
+func (a admin) GetSystemStats(ctx context.Context) (GetSystemStatsOutput, error) {
+	accountCount, err := a.accountDataAccessor.GetAccountCount(ctx, database.AccountFilter{})
+	if err != nil {
+		return GetSystemStatsOutput{}, err
+	}
+
+	disabledAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx, database.AccountFilter{Disabled: lo.ToPtr(true)})
+	if err != nil {
+		return GetSystemStatsOutput{}, err
+	}
+
+	adminAccountCount, err := a.accountDataAccessor.GetAccountCount(ctx, database.AccountFilter{Role: AccountRoleAdmin})
+	if err != nil {
+		return GetSystemStatsOutput{}, err
+	}
+
+	downloadTaskCountByStatus, err := a.downloadTaskDataAccessor.GetDownloadTaskCountByStatus(ctx)
+	if err != nil {
+		return GetSystemStatsOutput{}, err
+	}
+
+	output := GetSystemStatsOutput{
+		AccountCount:         accountCount,
+		DisabledAccountCount: disabledAccountCount,
+		AdminAccountCount:    adminAccountCount,
+	}
+
+	// Every status is listed, including the ones without any download task
+	for downloadStatus := int32(1); downloadStatus < int32(len(go_load.DownloadStatus_name)); downloadStatus++ {
+		count := downloadTaskCountByStatus[downloadStatus]
+		output.DownloadTaskCount += count
+		output.DownloadStatusCountList = append(output.DownloadStatusCountList, &go_load.DownloadStatusCount{
+			DownloadStatus: go_load.DownloadStatus(downloadStatus),
+			Count:          count,
+		})
+	}
+
+	return output, nil
+}
+
+func (a admin) ListAuditLogs(ctx context.Context, params ListAuditLogsParams) (ListAuditLogsOutput, error) {
+	filter := database.AuditLogFilter{
+		TargetType: params.TargetType,
+	}
+	if params.OfAccountID != 0 {
+		filter.OfAccountID = &params.OfAccountID
+	}
+	if params.TargetType != "" && params.TargetID != 0 {
+		filter.TargetID = &params.TargetID
+	}
+
+	auditLogList, totalCount, err := a.auditLogDataAccessor.GetAuditLogListByFilter(ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		return ListAuditLogsOutput{}, err
+	}
+
+	return ListAuditLogsOutput{
+		AuditLogList: lo.Map(auditLogList, func(item database.AuditLog, _ int) *go_load.AuditLog {
+			return databaseAuditLogToProtoAuditLog(item)
+		}),
+		TotalCount: totalCount,
+	}, nil
+}
@@ -0,0 +1,234 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type APIKeyScope string
+
+const (
+	APIKeyScopeRead          APIKeyScope = "read"
+	APIKeyScopeCreateTasks   APIKeyScope = "create_tasks"
+	APIKeyScopeManageTasks   APIKeyScope = "manage_tasks"
+	APIKeyScopeManageAccount APIKeyScope = "manage_account"
+)
+
+const (
+	// apiKeyPrefix tells api keys apart from session tokens, which are jwt and never start with it
+	apiKeyPrefix                   = "gla_"
+	apiKeyByteCount                = 32
+	apiKeyDisplayPrefixLength      = 12
+	apiKeyLastUsedAtUpdateInterval = time.Minute
+)
+
+var (
+	apiKeyScopeToProtoAPIKeyScope = map[APIKeyScope]go_load.APIKeyScope{
+		APIKeyScopeRead:          go_load.APIKeyScope_API_KEY_SCOPE_READ,
+		APIKeyScopeCreateTasks:   go_load.APIKeyScope_API_KEY_SCOPE_CREATE_TASKS,
+		APIKeyScopeManageTasks:   go_load.APIKeyScope_API_KEY_SCOPE_MANAGE_TASKS,
+		APIKeyScopeManageAccount: go_load.APIKeyScope_API_KEY_SCOPE_MANAGE_ACCOUNT,
+	}
+	protoAPIKeyScopeToAPIKeyScope = lo.Invert(apiKeyScopeToProtoAPIKeyScope)
+)
+
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, apiKeyPrefix)
+}
+
+// hashAPIKey uses sha256 instead of the password hash, api keys are random so they cannot be brute forced
+func hashAPIKey(key string) string {
+	keyHash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(keyHash[:])
+}
+
+func getAPIKeyScopeList(apiKey database.APIKey) ([]APIKeyScope, error) {
+	// The scope list is decoded from json text when read from the database
+	return convertJSONValue[[]APIKeyScope](apiKey.ScopeList.Data)
+}
+
+type CreateAPIKeyParams struct {
+	Principal Principal
+	Name      string
+	ScopeList []go_load.APIKeyScope
+	ExpiresAt *time.Time
+}
+
+type CreateAPIKeyOutput struct {
+	APIKey *go_load.APIKey
+	Key    string
+}
+
+type ListAPIKeysParams struct {
+	Principal Principal
+}
+
+type ListAPIKeysOutput struct {
+	APIKeyList []*go_load.APIKey
+}
+
+type RevokeAPIKeyParams struct {
+	Principal Principal
+	APIKeyID  uint64
+}
+
+type APIKey interface {
+	// CreateAPIKey returns the key only once, it cannot be retrieved again
+	CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error)
+	ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (ListAPIKeysOutput, error)
+	RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error
+}
+
+type apiKey struct {
+	apiKeyDataAccessor database.APIKeyDataAccessor
+	logger             *zap.Logger
+}
+
+func NewAPIKey(
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	logger *zap.Logger,
+) APIKey {
+	return &apiKey{
+		apiKeyDataAccessor: apiKeyDataAccessor,
+		logger:             logger,
+	}
+}
+
+func (a apiKey) databaseAPIKeyToProtoAPIKey(apiKey database.APIKey) (*go_load.APIKey, error) {
+	scopeList, err := getAPIKeyScopeList(apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	protoAPIKey := &go_load.APIKey{
+		Id:        apiKey.ID,
+		Name:      apiKey.Name,
+		KeyPrefix: apiKey.KeyPrefix,
+		ScopeList: lo.Map(scopeList, func(item APIKeyScope, _ int) go_load.APIKeyScope {
+			return apiKeyScopeToProtoAPIKeyScope[item]
+		}),
+		CreatedAt: timestamppb.New(apiKey.CreatedAt),
+	}
+
+	if apiKey.LastUsedAt != nil {
+		protoAPIKey.LastUsedAt = timestamppb.New(*apiKey.LastUsedAt)
+	}
+
+	if apiKey.ExpiresAt != nil {
+		protoAPIKey.ExpiresAt = timestamppb.New(*apiKey.ExpiresAt)
+	}
+
+	return protoAPIKey, nil
+}
+
+// generateAPIKey returns a random api key and its hash, only the hash is stored
+func (a apiKey) generateAPIKey() (string, string, error) {
+	keyBytes := make([]byte, apiKeyByteCount)
+	if _, err := rand.Read(keyBytes); err != nil {
+		return "", "", err
+	}
+
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(keyBytes)
+	return key, hashAPIKey(key), nil
+}
+
+func (a apiKey) CreateAPIKey(ctx context.Context, params CreateAPIKeyParams) (CreateAPIKeyOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", params.Principal.AccountID))
+
+	scopeList := make([]APIKeyScope, 0, len(params.ScopeList))
+	for _, protoScope := range params.ScopeList {
+		scope, ok := protoAPIKeyScopeToAPIKeyScope[protoScope]
+		if !ok {
+			return CreateAPIKeyOutput{}, status.Error(codes.InvalidArgument, "unsupported api key scope")
+		}
+		scopeList = append(scopeList, scope)
+	}
+
+	key, keyHash, err := a.generateAPIKey()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate api key")
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to generate api key")
+	}
+
+	apiKey := database.APIKey{
+		OfAccountID: params.Principal.AccountID,
+		Name:        params.Name,
+		KeyHash:     keyHash,
+		KeyPrefix:   key[:apiKeyDisplayPrefixLength],
+		ScopeList:   database.JSON{Data: lo.Uniq(scopeList)},
+		CreatedAt:   time.Now(),
+		ExpiresAt:   params.ExpiresAt,
+	}
+
+	apiKey.ID, err = a.apiKeyDataAccessor.CreateAPIKey(ctx, apiKey)
+	if err != nil {
+		return CreateAPIKeyOutput{}, err
+	}
+
+	protoAPIKey, err := a.databaseAPIKeyToProtoAPIKey(apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to convert api key")
+		return CreateAPIKeyOutput{}, status.Error(codes.Internal, "failed to convert api key")
+	}
+
+	return CreateAPIKeyOutput{
+		APIKey: protoAPIKey,
+		Key:    key,
+	}, nil
+}
+
+func (a apiKey) ListAPIKeys(ctx context.Context, params ListAPIKeysParams) (ListAPIKeysOutput, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).With(zap.Uint64("account_id", params.Principal.AccountID))
+
+	apiKeyList, err := a.apiKeyDataAccessor.GetUnrevokedAPIKeyListByAccount(ctx, params.Principal.AccountID)
+	if err != nil {
+		return ListAPIKeysOutput{}, err
+	}
+
+	protoAPIKeyList := make([]*go_load.APIKey, 0, len(apiKeyList))
+	for _, item := range apiKeyList {
+		protoAPIKey, err := a.databaseAPIKeyToProtoAPIKey(item)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to convert api key")
+			return ListAPIKeysOutput{}, status.Error(codes.Internal, "failed to convert api key")
+		}
+		protoAPIKeyList = append(protoAPIKeyList, protoAPIKey)
+	}
+
+	return ListAPIKeysOutput{
+		APIKeyList: protoAPIKeyList,
+	}, nil
+}
+
+func (a apiKey) RevokeAPIKey(ctx context.Context, params RevokeAPIKeyParams) error {
+	apiKey, err := a.apiKeyDataAccessor.GetAPIKey(ctx, params.APIKeyID)
+	if err != nil {
+		return err
+	}
+
+	if apiKey.OfAccountID != params.Principal.AccountID {
+		return status.Error(codes.PermissionDenied, "trying to revoke an api key the account does not own")
+	}
+
+	if apiKey.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	apiKey.RevokedAt = &now
+	return a.apiKeyDataAccessor.UpdateAPIKey(ctx, apiKey)
+}
@@ -0,0 +1,279 @@
+package logic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	archiveMagicBytesLength = 4
+)
+
+var (
+	archiveMagicBytesZip  = []byte{0x50, 0x4b, 0x03, 0x04}
+	archiveMagicBytesGzip = []byte{0x1f, 0x8b}
+	archiveMagicBytesZstd = []byte{0x28, 0xb5, 0x2f, 0xfd}
+
+	ErrArchiveUnsupportedFormat = errors.New("file is not a supported archive")
+	errArchiveTooManyEntries    = errors.New("archive has too many entries")
+	errArchiveTooLarge          = errors.New("archive expanded size is too large")
+	errArchiveInvalidEntryPath  = errors.New("archive entry path is outside of the extraction directory")
+)
+
+type ExtractedFile struct {
+	Path string `json:"path"`
+	Size uint64 `json:"size"`
+}
+
+type ArchiveExtractor interface {
+	// Extract extracts the archive stored at sourceFilePath into destinationPrefix of the file client,
+	// it returns ErrArchiveUnsupportedFormat if the file is not a zip, tar.gz or tar.zst archive
+	Extract(ctx context.Context, sourceFilePath, destinationPrefix string) ([]ExtractedFile, error)
+}
+
+type archiveExtractor struct {
+	fileClient             file.Client
+	maxEntryCount          int
+	maxExpandedSizeInBytes uint64
+	logger                 *zap.Logger
+}
+
+func NewArchiveExtractor(
+	fileClient file.Client,
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (ArchiveExtractor, error) {
+	maxExpandedSizeInBytes, err := downloadConfig.ArchiveExtraction.GetMaxExpandedSizeInBytes()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse archive_extraction.max_expanded_size")
+		return nil, err
+	}
+
+	return &archiveExtractor{
+		fileClient:             fileClient,
+		maxEntryCount:          downloadConfig.ArchiveExtraction.MaxEntryCount,
+		maxExpandedSizeInBytes: maxExpandedSizeInBytes,
+		logger:                 logger,
+	}, nil
+}
+
+// sanitizeArchiveEntryPath protects against zip slip by rejecting entries escaping the extraction directory
+func sanitizeArchiveEntryPath(entryPath string) (string, error) {
+	entryPath = strings.ReplaceAll(entryPath, "\\", "/")
+	if path.IsAbs(entryPath) || strings.Contains(entryPath, ":") {
+		return "", errArchiveInvalidEntryPath
+	}
+
+	cleanedPath := path.Clean(entryPath)
+	if cleanedPath == "." || cleanedPath == ".." || strings.HasPrefix(cleanedPath, "../") {
+		return "", errArchiveInvalidEntryPath
+	}
+
+	return cleanedPath, nil
+}
+
+// archiveEntryWriter writes archive entries into the file client while enforcing the zip bomb limits
+type archiveEntryWriter struct {
+	fileClient           file.Client
+	destinationPrefix    string
+	maxEntryCount        int
+	remainingSizeInBytes uint64
+	extractedFileList    []ExtractedFile
+}
+
+func (a *archiveEntryWriter) write(ctx context.Context, entryPath string, reader io.Reader) error {
+	if len(a.extractedFileList) >= a.maxEntryCount {
+		return errArchiveTooManyEntries
+	}
+
+	sanitizedPath, err := sanitizeArchiveEntryPath(entryPath)
+	if err != nil {
+		return err
+	}
+
+	writer, err := a.fileClient.Writer(ctx, path.Join(a.destinationPrefix, sanitizedPath))
+	if err != nil {
+		return err
+	}
+
+	// Do not trust the size declared in the archive header, count what is actually decompressed
+	writtenByteCount, copyErr := io.CopyN(writer, reader, int64(a.remainingSizeInBytes)+1)
+
+	// Keep the entry in the list before checking for errors so it is cleaned up on failure, closing the writer may
+	// store what was written so far
+	a.extractedFileList = append(a.extractedFileList, ExtractedFile{
+		Path: sanitizedPath,
+		Size: uint64(writtenByteCount),
+	})
+
+	if copyErr != nil && !errors.Is(copyErr, io.EOF) {
+		writer.Close()
+		return copyErr
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	if uint64(writtenByteCount) > a.remainingSizeInBytes {
+		return errArchiveTooLarge
+	}
+
+	a.remainingSizeInBytes -= uint64(writtenByteCount)
+	return nil
+}
+
+func (a archiveExtractor) extractTar(ctx context.Context, reader io.Reader, entryWriter *archiveEntryWriter) error {
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		// Directories are implied by file paths, links are skipped as they could point outside of the archive
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		if err := entryWriter.write(ctx, header.Name, tarReader); err != nil {
+			return err
+		}
+	}
+}
+
+func (a archiveExtractor) extractZip(ctx context.Context, reader io.Reader, entryWriter *archiveEntryWriter) error {
+	// zip needs random access to read its central directory, so the archive is staged in a temporary file
+	tempFile, err := os.CreateTemp("", "goload-archive-*.zip")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tempFile.Name())
+	defer tempFile.Close()
+
+	archiveSize, err := io.Copy(tempFile, reader)
+	if err != nil {
+		return err
+	}
+
+	zipReader, err := zip.NewReader(tempFile, archiveSize)
+	if err != nil {
+		return err
+	}
+
+	if len(zipReader.File) > a.maxEntryCount {
+		return errArchiveTooManyEntries
+	}
+
+	for _, zipFile := range zipReader.File {
+		if !zipFile.Mode().IsRegular() {
+			continue
+		}
+
+		if err := a.extractZipFile(ctx, zipFile, entryWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a archiveExtractor) extractZipFile(ctx context.Context, zipFile *zip.File, entryWriter *archiveEntryWriter) error {
+	zipFileReader, err := zipFile.Open()
+	if err != nil {
+		return err
+	}
+	defer zipFileReader.Close()
+
+	return entryWriter.write(ctx, zipFile.Name, zipFileReader)
+}
+
+func (a archiveExtractor) extract(ctx context.Context, reader io.Reader, entryWriter *archiveEntryWriter) error {
+	bufferedReader := bufio.NewReader(reader)
+	magicBytes, err := bufferedReader.Peek(archiveMagicBytesLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+
+	switch {
+	case bytes.HasPrefix(magicBytes, archiveMagicBytesZip):
+		return a.extractZip(ctx, bufferedReader, entryWriter)
+
+	case bytes.HasPrefix(magicBytes, archiveMagicBytesGzip):
+		gzipReader, err := gzip.NewReader(bufferedReader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+
+		return a.extractTar(ctx, gzipReader, entryWriter)
+
+	case bytes.HasPrefix(magicBytes, archiveMagicBytesZstd):
+		zstdReader, err := zstd.NewReader(bufferedReader)
+		if err != nil {
+			return err
+		}
+		defer zstdReader.Close()
+
+		return a.extractTar(ctx, zstdReader, entryWriter)
+
+	default:
+		return ErrArchiveUnsupportedFormat
+	}
+}
+
+func (a archiveExtractor) Extract(ctx context.Context, sourceFilePath, destinationPrefix string) ([]ExtractedFile, error) {
+	logger := utils.LoggerWithContext(ctx, a.logger).
+		With(zap.String("source_file_path", sourceFilePath)).
+		With(zap.String("destination_prefix", destinationPrefix))
+
+	reader, err := a.fileClient.Reader(ctx, sourceFilePath)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	entryWriter := &archiveEntryWriter{
+		fileClient:           a.fileClient,
+		destinationPrefix:    destinationPrefix,
+		maxEntryCount:        a.maxEntryCount,
+		remainingSizeInBytes: a.maxExpandedSizeInBytes,
+		extractedFileList:    make([]ExtractedFile, 0),
+	}
+
+	if err := a.extract(ctx, reader, entryWriter); err != nil {
+		if errors.Is(err, ErrArchiveUnsupportedFormat) {
+			return nil, err
+		}
+
+		logger.With(zap.Error(err)).Error("failed to extract archive, will remove extracted files")
+		for _, extractedFile := range entryWriter.extractedFileList {
+			if deleteErr := a.fileClient.Delete(ctx, path.Join(destinationPrefix, extractedFile.Path)); deleteErr != nil {
+				logger.With(zap.Error(deleteErr)).Warn("failed to remove extracted file")
+			}
+		}
+
+		return nil, fmt.Errorf("failed to extract archive: %w", err)
+	}
+
+	return entryWriter.extractedFileList, nil
+}
@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+)
+
+// archiveWriter writes the entries of an archive one after the other into an underlying writer,
+// so the archive can be streamed without knowing all of its content in advance
+type archiveWriter interface {
+	// WriteEntry copies exactly size bytes from reader into a new entry named name
+	WriteEntry(name string, size uint64, reader io.Reader) error
+	Close() error
+}
+
+func newArchiveWriter(archiveFormat go_load.ArchiveFormat, writer io.Writer) archiveWriter {
+	if archiveFormat == go_load.ArchiveFormat_ARCHIVE_FORMAT_TAR {
+		return &tarArchiveWriter{tarWriter: tar.NewWriter(writer)}
+	}
+
+	return &zipArchiveWriter{zipWriter: zip.NewWriter(writer)}
+}
+
+type zipArchiveWriter struct {
+	zipWriter *zip.Writer
+}
+
+func (z zipArchiveWriter) WriteEntry(name string, size uint64, reader io.Reader) error {
+	entryWriter, err := z.zipWriter.CreateHeader(&zip.FileHeader{
+		Name:               name,
+		Method:             zip.Deflate,
+		Modified:           time.Now(),
+		UncompressedSize64: size,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(entryWriter, reader, int64(size))
+	return err
+}
+
+func (z zipArchiveWriter) Close() error {
+	return z.zipWriter.Close()
+}
+
+type tarArchiveWriter struct {
+	tarWriter *tar.Writer
+}
+
+func (t tarArchiveWriter) WriteEntry(name string, size uint64, reader io.Reader) error {
+	if err := t.tarWriter.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     int64(size),
+		Mode:     0o644,
+		ModTime:  time.Now(),
+	}); err != nil {
+		return err
+	}
+
+	_, err := io.CopyN(t.tarWriter, reader, int64(size))
+	return err
+}
+
+func (t tarArchiveWriter) Close() error {
+	return t.tarWriter.Close()
+}
@@ -0,0 +1,91 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	auditLogTargetTypeAccount           = "account"
+	auditLogTargetTypeDownloadTask      = "download_task"
+	auditLogTargetTypeTeam              = "team"
+	auditLogTargetTypeDeadLetterMessage = "dead_letter_message"
+
+	auditLogActionUpdateAccountRole       = "update_account_role"
+	auditLogActionBootstrapAdmin          = "bootstrap_admin"
+	auditLogActionDisableAccount          = "disable_account"
+	auditLogActionEnableAccount           = "enable_account"
+	auditLogActionRetryDownloadTask       = "retry_download_task"
+	auditLogActionCancelDownloadTask      = "cancel_download_task"
+	auditLogActionChangePassword          = "change_password"
+	auditLogActionResetPassword           = "reset_password"
+	auditLogActionDeleteAccount           = "delete_account"
+	auditLogActionEnableTOTP              = "enable_totp"
+	auditLogActionDisableTOTP             = "disable_totp"
+	auditLogActionDeleteTeam              = "delete_team"
+	auditLogActionUpdateTeamMemberRole    = "update_team_member_role"
+	auditLogActionRemoveTeamMember        = "remove_team_member"
+	auditLogActionCreateTeamInvitation    = "create_team_invitation"
+	auditLogActionShareDownloadTask       = "share_download_task"
+	auditLogActionUnshareDownloadTask     = "unshare_download_task"
+	auditLogActionReplayDeadLetterMessage = "replay_dead_letter_message"
+	auditLogActionDeleteDeadLetterMessage = "delete_dead_letter_message"
+
+	auditLogMetadataFieldNamePreviousRole           = "previous_role"
+	auditLogMetadataFieldNameRole                   = "role"
+	auditLogMetadataFieldNamePreviousDownloadStatus = "previous_download_status"
+	auditLogMetadataFieldNameAccountName            = "account_name"
+	auditLogMetadataFieldNameAccountID              = "account_id"
+	auditLogMetadataFieldNameAccountIDList          = "account_id_list"
+	auditLogMetadataFieldNameTeamName               = "team_name"
+	auditLogMetadataFieldNameQueueName              = "queue_name"
+)
+
+type auditLogEntry struct {
+	Action     string
+	TargetType string
+	TargetID   uint64
+	Metadata   map[string]any
+}
+
+// createAuditLog is called with the data accessor of the transaction making the change, so the change and
+// its audit log are committed together
+func createAuditLog(
+	ctx context.Context,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	principal Principal,
+	entry auditLogEntry,
+) error {
+	_, err := auditLogDataAccessor.CreateAuditLog(ctx, database.AuditLog{
+		OfAccountID: principal.AccountID,
+		Action:      entry.Action,
+		TargetType:  entry.TargetType,
+		TargetID:    entry.TargetID,
+		Metadata:    database.JSON{Data: entry.Metadata},
+		CreatedAt:   time.Now(),
+	})
+	return err
+}
+
+func databaseAuditLogToProtoAuditLog(auditLog database.AuditLog) *go_load.AuditLog {
+	protoAuditLog := &go_load.AuditLog{
+		Id:          auditLog.ID,
+		OfAccountId: auditLog.OfAccountID,
+		Action:      auditLog.Action,
+		TargetType:  auditLog.TargetType,
+		TargetId:    auditLog.TargetID,
+		CreatedAt:   timestamppb.New(auditLog.CreatedAt),
+	}
+
+	if metadata, ok := auditLog.Metadata.Data.(map[string]any); ok {
+		if protoMetadata, err := mapToProtoStruct(metadata); err == nil {
+			protoAuditLog.Metadata = protoMetadata
+		}
+	}
+
+	return protoAuditLog
+}
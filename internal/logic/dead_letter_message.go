@@ -0,0 +1,170 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ListDeadLetterMessagesParams struct {
+	// QueueName is empty to list dead letter messages of every queue
+	QueueName string
+	Limit     uint64
+	Offset    uint64
+}
+
+type ListDeadLetterMessagesOutput struct {
+	DeadLetterMessageList []*go_load.DeadLetterMessage
+	TotalCount            uint64
+}
+
+type ReplayDeadLetterMessageParams struct {
+	Principal           Principal
+	DeadLetterMessageID uint64
+}
+
+type DeleteDeadLetterMessageParams struct {
+	Principal           Principal
+	DeadLetterMessageID uint64
+}
+
+// DeadLetterMessage is only called by the admin service, the admin role of the principal is checked by the auth
+// interceptors
+type DeadLetterMessage interface {
+	ListDeadLetterMessages(ctx context.Context, params ListDeadLetterMessagesParams) (ListDeadLetterMessagesOutput, error)
+	// ReplayDeadLetterMessage produces the payload to its queue again, it is handled like a new message with its
+	// delivery count starting from 0
+	ReplayDeadLetterMessage(ctx context.Context, params ReplayDeadLetterMessageParams) error
+	DeleteDeadLetterMessage(ctx context.Context, params DeleteDeadLetterMessageParams) error
+}
+
+type deadLetterMessage struct {
+	goquDatabase                  *goqu.Database
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor
+	auditLogDataAccessor          database.AuditLogDataAccessor
+	mqClient                      producer.Client
+	logger                        *zap.Logger
+}
+
+func NewDeadLetterMessage(
+	goquDatabase *goqu.Database,
+	deadLetterMessageDataAccessor database.DeadLetterMessageDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	mqClient producer.Client,
+	logger *zap.Logger,
+) DeadLetterMessage {
+	return &deadLetterMessage{
+		goquDatabase:                  goquDatabase,
+		deadLetterMessageDataAccessor: deadLetterMessageDataAccessor,
+		auditLogDataAccessor:          auditLogDataAccessor,
+		mqClient:                      mqClient,
+		logger:                        logger,
+	}
+}
+
+func getDeadLetterMessageHeaders(deadLetterMessage database.DeadLetterMessage) map[string]string {
+	headers := make(map[string]string)
+	if headerMap, ok := deadLetterMessage.Headers.Data.(map[string]any); ok {
+		for key, value := range headerMap {
+			if stringValue, ok := value.(string); ok {
+				headers[key] = stringValue
+			}
+		}
+	}
+
+	return headers
+}
+
+func databaseDeadLetterMessageToProtoDeadLetterMessage(
+	deadLetterMessage database.DeadLetterMessage,
+) *go_load.DeadLetterMessage {
+	protoDeadLetterMessage := &go_load.DeadLetterMessage{
+		Id:            deadLetterMessage.ID,
+		QueueName:     deadLetterMessage.QueueName,
+		Payload:       deadLetterMessage.Payload,
+		Headers:       getDeadLetterMessageHeaders(deadLetterMessage),
+		Error:         deadLetterMessage.Error,
+		DeliveryCount: deadLetterMessage.DeliveryCount,
+		CreatedAt:     timestamppb.New(deadLetterMessage.CreatedAt),
+	}
+
+	return protoDeadLetterMessage
+}
+
+func (d deadLetterMessage) ListDeadLetterMessages(
+	ctx context.Context,
+	params ListDeadLetterMessagesParams,
+) (ListDeadLetterMessagesOutput, error) {
+	deadLetterMessageList, totalCount, err := d.deadLetterMessageDataAccessor.GetDeadLetterMessageList(
+		ctx, params.QueueName, params.Limit, params.Offset,
+	)
+	if err != nil {
+		return ListDeadLetterMessagesOutput{}, err
+	}
+
+	return ListDeadLetterMessagesOutput{
+		DeadLetterMessageList: lo.Map(deadLetterMessageList, func(item database.DeadLetterMessage, _ int) *go_load.DeadLetterMessage {
+			return databaseDeadLetterMessageToProtoDeadLetterMessage(item)
+		}),
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (d deadLetterMessage) ReplayDeadLetterMessage(ctx context.Context, params ReplayDeadLetterMessageParams) error {
+	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		deadLetterMessage, err := d.deadLetterMessageDataAccessor.WithDatabase(td).
+			GetDeadLetterMessageWithXLock(ctx, params.DeadLetterMessageID)
+		if err != nil {
+			return err
+		}
+
+		if err = d.deadLetterMessageDataAccessor.WithDatabase(td).DeleteDeadLetterMessage(ctx, deadLetterMessage.ID); err != nil {
+			return err
+		}
+
+		if err = createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionReplayDeadLetterMessage,
+			TargetType: auditLogTargetTypeDeadLetterMessage,
+			TargetID:   deadLetterMessage.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameQueueName: deadLetterMessage.QueueName,
+			},
+		}); err != nil {
+			return err
+		}
+
+		// Produced last, so the dead letter message is kept if producing fails. The headers keep the envelope, so the
+		// replayed message has the event id and trace id of the original one
+		return d.mqClient.Produce(
+			ctx, deadLetterMessage.QueueName, getDeadLetterMessageHeaders(deadLetterMessage), deadLetterMessage.Payload)
+	})
+}
+
+func (d deadLetterMessage) DeleteDeadLetterMessage(ctx context.Context, params DeleteDeadLetterMessageParams) error {
+	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		deadLetterMessage, err := d.deadLetterMessageDataAccessor.WithDatabase(td).
+			GetDeadLetterMessageWithXLock(ctx, params.DeadLetterMessageID)
+		if err != nil {
+			return err
+		}
+
+		if err = d.deadLetterMessageDataAccessor.WithDatabase(td).DeleteDeadLetterMessage(ctx, deadLetterMessage.ID); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionDeleteDeadLetterMessage,
+			TargetType: auditLogTargetTypeDeadLetterMessage,
+			TargetID:   deadLetterMessage.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameQueueName: deadLetterMessage.QueueName,
+			},
+		})
+	})
+}
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/url"
+	"path"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
@@ -16,16 +20,44 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
-	downloadTaskMetadataFieldNameFileName = "file-name"
+	downloadTaskMetadataFieldNameFileName                = "file-name"
+	downloadTaskMetadataFieldNamePostProcessorNameList   = "post-processor-name-list"
+	downloadTaskMetadataFieldNamePostProcessorResultList = "post-processor-result-list"
+	// downloadTaskMetadataFieldNameExpiresAtFromRetention is set when expires at comes from the retention policy
+	// instead of being chosen for the download task
+	downloadTaskMetadataFieldNameExpiresAtFromRetention = "expires-at-from-retention"
+
+	expireDownloadTasksBatchSize = 100
+
+	maxDownloadTaskFilesArchiveEntryCount = 1000
 )
 
+func newDownloadTaskLifecycleEvent(eventType string, downloadTask database.DownloadTask) producer.DownloadTaskLifecycleEvent {
+	return producer.DownloadTaskLifecycleEvent{
+		Type:           eventType,
+		ID:             downloadTask.ID,
+		OfAccountID:    downloadTask.OfAccountID,
+		OfTeamID:       downloadTask.OfTeamID,
+		DownloadStatus: go_load.DownloadStatus(downloadTask.DownloadStatus).String(),
+		OccurredAt:     time.Now(),
+	}
+}
+
 type CreateDownloadTaskParams struct {
-	Token        string
+	Principal    Principal
 	URL          string
 	DownloadType go_load.DownloadType
+	// ExpiresAt is nil to use the retention policy of the account
+	ExpiresAt      *time.Time
+	ExtractArchive bool
+	// PostProcessorNameList is nil to use the post processor list of the account
+	PostProcessorNameList []string
+	// TeamID is 0 to create a download task only reachable by the account
+	TeamID uint64
 }
 
 type CreateDownloadTaskOutput struct {
@@ -33,7 +65,9 @@ type CreateDownloadTaskOutput struct {
 }
 
 type GetDownloadTaskListParams struct {
-	Token  string
+	Principal Principal
+	// TeamID is 0 to list download tasks of the account and of every team it is a member of
+	TeamID uint64
 	Limit  uint64
 	Offset uint64
 }
@@ -44,14 +78,14 @@ type GetDownloadTaskListOutput struct {
 }
 
 type GetDownloadTaskFileParams struct {
-	Token string
-	ID    uint64
+	Principal Principal
+	ID        uint64
 }
 
 type UpdateDownloadTaskParams struct {
-	Token string
-	ID    uint64
-	URL   string
+	Principal Principal
+	ID        uint64
+	URL       string
 }
 
 type UpdateDownloadTaskOutput struct {
@@ -59,54 +93,185 @@ type UpdateDownloadTaskOutput struct {
 }
 
 type DeleteDownloadTaskParams struct {
-	Token string
-	ID    uint64
+	Principal Principal
+	ID        uint64
+}
+
+type GetDownloadTaskExtractedFileListParams struct {
+	Principal Principal
+	ID        uint64
+}
+
+type GetDownloadTaskExtractedFileListOutput struct {
+	ExtractedFileList []*go_load.ExtractedFile
+}
+
+type GetDownloadTaskExtractedFileParams struct {
+	Principal Principal
+	ID        uint64
+	Path      string
+}
+
+type ExtendDownloadTaskExpiryParams struct {
+	Principal Principal
+	ID        uint64
+	ExpiresAt time.Time
+}
+
+type ExtendDownloadTaskExpiryOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
+type GetDownloadTaskFilesAsArchiveParams struct {
+	Principal Principal
+	// IDList is empty to archive every succeeded download task reachable by the account matching URLContains
+	IDList        []uint64
+	URLContains   string
+	ArchiveFormat go_load.ArchiveFormat
+}
+
+type GetAllDownloadTaskListParams struct {
+	// OfAccountID is 0 to list download tasks of every account
+	OfAccountID        uint64
+	DownloadStatusList []go_load.DownloadStatus
+	URLContains        string
+	Limit              uint64
+	Offset             uint64
+}
+
+type GetAllDownloadTaskListOutput struct {
+	DownloadTaskList []*go_load.DownloadTask
+	TotalCount       uint64
+}
+
+type RetryDownloadTaskParams struct {
+	Principal Principal
+	ID        uint64
+}
+
+type RetryDownloadTaskOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
+type CancelDownloadTaskParams struct {
+	Principal Principal
+	ID        uint64
+}
+
+type CancelDownloadTaskOutput struct {
+	DownloadTask *go_load.DownloadTask
+}
+
+type ShareDownloadTaskParams struct {
+	Principal       Principal
+	ID              uint64
+	AccountNameList []string
+}
+
+type ShareDownloadTaskOutput struct {
+	SharedWithAccountList []*go_load.Account
+}
+
+type UnshareDownloadTaskParams struct {
+	Principal       Principal
+	ID              uint64
+	AccountNameList []string
+}
+
+type UnshareDownloadTaskOutput struct {
+	SharedWithAccountList []*go_load.Account
+}
+
+type GetSharedDownloadTaskListParams struct {
+	Principal Principal
+	Limit     uint64
+	Offset    uint64
+}
+
+type GetSharedDownloadTaskListOutput struct {
+	DownloadTaskList []*go_load.DownloadTask
+	TotalCount       uint64
 }
 
 type DownloadTask interface {
 	CreateDownloadTask(context.Context, CreateDownloadTaskParams) (CreateDownloadTaskOutput, error)
-	ExecuteDownloadTask(context.Context, uint64) error
 	GetDownloadTaskList(context.Context, GetDownloadTaskListParams) (GetDownloadTaskListOutput, error)
 	GetDownloadTaskFile(context.Context, GetDownloadTaskFileParams) (io.ReadCloser, error)
 	UpdateDownloadTask(context.Context, UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error)
 	DeleteDownloadTask(context.Context, DeleteDownloadTaskParams) error
+	ExtendDownloadTaskExpiry(context.Context, ExtendDownloadTaskExpiryParams) (ExtendDownloadTaskExpiryOutput, error)
+	ExpireDownloadTasks(context.Context) error
+	GetDownloadTaskExtractedFileList(context.Context, GetDownloadTaskExtractedFileListParams) (GetDownloadTaskExtractedFileListOutput, error)
+	GetDownloadTaskExtractedFile(context.Context, GetDownloadTaskExtractedFileParams) (io.ReadCloser, error)
+	GetDownloadTaskFilesAsArchive(context.Context, GetDownloadTaskFilesAsArchiveParams) (io.ReadCloser, error)
+	// ShareDownloadTask gives accounts read access to a succeeded download task, sharing it again with an account
+	// it is already shared with does nothing
+	ShareDownloadTask(context.Context, ShareDownloadTaskParams) (ShareDownloadTaskOutput, error)
+	UnshareDownloadTask(context.Context, UnshareDownloadTaskParams) (UnshareDownloadTaskOutput, error)
+	GetSharedDownloadTaskList(context.Context, GetSharedDownloadTaskListParams) (GetSharedDownloadTaskListOutput, error)
+	// GetAllDownloadTaskList, RetryDownloadTask and CancelDownloadTask are admin operations, they do not check
+	// the download task is owned by the principal
+	GetAllDownloadTaskList(context.Context, GetAllDownloadTaskListParams) (GetAllDownloadTaskListOutput, error)
+	RetryDownloadTask(context.Context, RetryDownloadTaskParams) (RetryDownloadTaskOutput, error)
+	CancelDownloadTask(context.Context, CancelDownloadTaskParams) (CancelDownloadTaskOutput, error)
 }
 
 type downloadTask struct {
-	goquDatabase                *goqu.Database
-	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
-	accountDataAccessor         database.AccountDataAccessor
-	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer
-	fileClient                  file.Client
-	tokenLogic                  Token
-	logger                      *zap.Logger
+	goquDatabase                  *goqu.Database
+	downloadTaskDataAccessor      database.DownloadTaskDataAccessor
+	accountDataAccessor           database.AccountDataAccessor
+	teamMemberDataAccessor        database.TeamMemberDataAccessor
+	downloadTaskShareDataAccessor database.DownloadTaskShareDataAccessor
+	auditLogDataAccessor          database.AuditLogDataAccessor
+	downloadTaskCreatedProducer   producer.DownloadTaskCreatedProducer
+	lifecycleEventProducer        producer.DownloadTaskLifecycleEventProducer
+	fileClient                    file.Client
+	postProcessorCatalog          PostProcessorCatalog
+	defaultPostProcessorList      []string
+	logger                        *zap.Logger
 }
 
 func NewDownloadTask(
 	goquDatabase *goqu.Database,
 	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
 	accountDataAccessor database.AccountDataAccessor,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	downloadTaskShareDataAccessor database.DownloadTaskShareDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
 	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer,
+	lifecycleEventProducer producer.DownloadTaskLifecycleEventProducer,
 	fileClient file.Client,
-	tokenLogic Token,
+	postProcessorCatalog PostProcessorCatalog,
+	downloadConfig configs.Download,
 	logger *zap.Logger,
-) DownloadTask {
-	return &downloadTask{
-		goquDatabase:                goquDatabase,
-		downloadTaskDataAccessor:    downloadTaskDataAccessor,
-		accountDataAccessor:         accountDataAccessor,
-		downloadTaskCreatedProducer: downloadTaskCreatedProducer,
-		fileClient:                  fileClient,
-		tokenLogic:                  tokenLogic,
-		logger:                      logger,
+) (DownloadTask, error) {
+	defaultPostProcessorList := downloadConfig.PostProcessing.DefaultPostProcessorList
+	if err := postProcessorCatalog.ValidatePostProcessorNameList(defaultPostProcessorList); err != nil {
+		logger.With(zap.Error(err)).Error("invalid post_processing.default_post_processor_list")
+		return nil, err
 	}
+
+	return &downloadTask{
+		goquDatabase:                  goquDatabase,
+		downloadTaskDataAccessor:      downloadTaskDataAccessor,
+		accountDataAccessor:           accountDataAccessor,
+		teamMemberDataAccessor:        teamMemberDataAccessor,
+		downloadTaskShareDataAccessor: downloadTaskShareDataAccessor,
+		auditLogDataAccessor:          auditLogDataAccessor,
+		downloadTaskCreatedProducer:   downloadTaskCreatedProducer,
+		lifecycleEventProducer:        lifecycleEventProducer,
+		fileClient:                    fileClient,
+		postProcessorCatalog:          postProcessorCatalog,
+		defaultPostProcessorList:      defaultPostProcessorList,
+		logger:                        logger,
+	}, nil
 }
 
 func (d *downloadTask) databaseDownloadTaskToProtoDownloadTask(
 	downloadTask database.DownloadTask,
 	account database.Account,
 ) *go_load.DownloadTask {
-	return &go_load.DownloadTask{
+	protoDownloadTask := &go_load.DownloadTask{
 		Id: downloadTask.ID,
 		OfAccount: &go_load.Account{
 			Id:          account.ID,
@@ -116,30 +281,188 @@ func (d *downloadTask) databaseDownloadTaskToProtoDownloadTask(
 		Url:            downloadTask.URL,
 		DownloadStatus: go_load.DownloadStatus(downloadTask.DownloadStatus),
 	}
+
+	if downloadTask.ExpiresAt != nil {
+		protoDownloadTask.ExpiresAt = timestamppb.New(*downloadTask.ExpiresAt)
+	}
+
+	if downloadTask.OfTeamID != nil {
+		protoDownloadTask.OfTeamId = *downloadTask.OfTeamID
+	}
+
+	postProcessorResultList, err := getPostProcessorResultList(getDownloadTaskMetadata(downloadTask))
+	if err == nil {
+		protoDownloadTask.PostProcessorResultList = lo.Map(postProcessorResultList, func(item PostProcessorResult, _ int) *go_load.PostProcessorResult {
+			return item.ToProto()
+		})
+	}
+
+	return protoDownloadTask
+}
+
+// databaseDownloadTaskListToProtoDownloadTaskList looks up the account of every download task, since download tasks
+// of teams are created by different accounts
+func (d *downloadTask) databaseDownloadTaskListToProtoDownloadTaskList(
+	ctx context.Context,
+	downloadTaskList []database.DownloadTask,
+) ([]*go_load.DownloadTask, error) {
+	accountMap := make(map[uint64]database.Account)
+	protoDownloadTaskList := make([]*go_load.DownloadTask, 0, len(downloadTaskList))
+	for _, downloadTask := range downloadTaskList {
+		account, ok := accountMap[downloadTask.OfAccountID]
+		if !ok {
+			var err error
+			account, err = d.accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+			if err != nil {
+				return nil, err
+			}
+			accountMap[downloadTask.OfAccountID] = account
+		}
+
+		protoDownloadTaskList = append(protoDownloadTaskList, d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account))
+	}
+
+	return protoDownloadTaskList, nil
+}
+
+type downloadTaskAccess int
+
+const (
+	// downloadTaskAccessRead is needed to see a download task and get its files
+	downloadTaskAccessRead downloadTaskAccess = iota
+	// downloadTaskAccessManage is needed to change a download task and share it
+	downloadTaskAccessManage
+)
+
+// canAccessDownloadTask lets accounts reach the download tasks they created outside of teams, download tasks of
+// teams depending on their role in the team, and read download tasks shared with them. teamRole is the role of the
+// account in the team of the download task, isGrantee is whether the download task is shared with the account
+func canAccessDownloadTask(
+	accountID uint64,
+	downloadTask database.DownloadTask,
+	teamRole TeamRole,
+	isGrantee bool,
+	access downloadTaskAccess,
+) bool {
+	if downloadTask.OfTeamID == nil && downloadTask.OfAccountID == accountID {
+		return true
+	}
+
+	if downloadTask.OfTeamID != nil {
+		requiredTeamRole := TeamRoleViewer
+		if access == downloadTaskAccessManage {
+			requiredTeamRole = TeamRoleMember
+		}
+
+		if teamRole.IsAtLeast(requiredTeamRole) {
+			return true
+		}
+	}
+
+	return access == downloadTaskAccessRead && isGrantee
+}
+
+// checkDownloadTaskAccess is called with the data accessors of the transaction, if there is one
+func (d downloadTask) checkDownloadTaskAccess(
+	ctx context.Context,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	downloadTaskShareDataAccessor database.DownloadTaskShareDataAccessor,
+	accountID uint64,
+	downloadTask database.DownloadTask,
+	access downloadTaskAccess,
+) (bool, error) {
+	var teamRole TeamRole
+	if downloadTask.OfTeamID != nil {
+		var err error
+		teamRole, err = getTeamRole(ctx, teamMemberDataAccessor, *downloadTask.OfTeamID, accountID)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if canAccessDownloadTask(accountID, downloadTask, teamRole, false, access) {
+		return true, nil
+	}
+
+	if access != downloadTaskAccessRead {
+		return false, nil
+	}
+
+	downloadTaskShareList, err := downloadTaskShareDataAccessor.GetDownloadTaskShareListByGrantee(
+		ctx, accountID, []uint64{downloadTask.ID})
+	if err != nil {
+		return false, err
+	}
+
+	return canAccessDownloadTask(accountID, downloadTask, teamRole, len(downloadTaskShareList) > 0, access), nil
+}
+
+func (d downloadTask) getPostProcessorNameList(account database.Account, params CreateDownloadTaskParams) ([]string, error) {
+	postProcessorNameList := d.defaultPostProcessorList
+	if params.PostProcessorNameList != nil {
+		postProcessorNameList = params.PostProcessorNameList
+	} else if account.PostProcessorList.Data != nil {
+		accountPostProcessorNameList, err := convertJSONValue[[]string](account.PostProcessorList.Data)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to read post processor list of account")
+		}
+		postProcessorNameList = accountPostProcessorNameList
+	}
+
+	if params.ExtractArchive && !lo.Contains(postProcessorNameList, PostProcessorNameArchiveExtraction) {
+		postProcessorNameList = append(append([]string{}, postProcessorNameList...), PostProcessorNameArchiveExtraction)
+	}
+
+	if err := d.postProcessorCatalog.ValidatePostProcessorNameList(postProcessorNameList); err != nil {
+		return nil, err
+	}
+
+	return d.postProcessorCatalog.WithMandatoryPostProcessorNameList(postProcessorNameList), nil
 }
 
 func (d *downloadTask) CreateDownloadTask(
 	ctx context.Context,
 	params CreateDownloadTaskParams,
 ) (CreateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+	accountID := params.Principal.AccountID
+
+	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
 	if err != nil {
 		return CreateDownloadTaskOutput{}, err
 	}
 
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	postProcessorNameList, err := d.getPostProcessorNameList(account, params)
 	if err != nil {
 		return CreateDownloadTaskOutput{}, err
 	}
 
+	var ofTeamID *uint64
+	if params.TeamID != 0 {
+		teamRole, getTeamRoleErr := getTeamRole(ctx, d.teamMemberDataAccessor, params.TeamID, accountID)
+		if getTeamRoleErr != nil {
+			return CreateDownloadTaskOutput{}, getTeamRoleErr
+		}
+
+		if !teamRole.IsAtLeast(TeamRoleMember) {
+			return CreateDownloadTaskOutput{}, status.Error(codes.PermissionDenied,
+				"trying to create a download task in a team the account cannot create download tasks in")
+		}
+
+		ofTeamID = &params.TeamID
+	}
+
 	downloadTask := database.DownloadTask{
 		OfAccountID:    account.ID,
 		DownloadType:   int32(params.DownloadType),
 		URL:            params.URL,
 		DownloadStatus: int32(go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING),
 		Metadata: database.JSON{
-			Data: make(map[string]any),
+			Data: map[string]any{
+				downloadTaskMetadataFieldNamePostProcessorNameList: postProcessorNameList,
+			},
 		},
+		ExpiresAt: params.ExpiresAt,
+		OfTeamID:  ofTeamID,
 	}
 	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
 		downloadTaskID, createDownloadTaskErr := d.downloadTaskDataAccessor.
@@ -170,219 +493,628 @@ func (d *downloadTask) CreateDownloadTask(
 	}, nil
 }
 
-func (d downloadTask) updateDownloadStatusFromPendingToDownloading(ctx context.Context, id uint64) (bool, database.DownloadTask, error) {
-	var (
-		logger       = utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
-		updated      = false
-		downloadTask database.DownloadTask
-		err          error
-	)
+func getDownloadTaskMetadata(downloadTask database.DownloadTask) map[string]any {
+	metadata, ok := downloadTask.Metadata.Data.(map[string]any)
+	if !ok {
+		return make(map[string]any)
+	}
 
-	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
-		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
-		if err != nil {
-			if errors.Is(err, database.ErrAccountNotFound) {
-				logger.Warn("download task not found, will skip download")
-				return nil
-			}
-			return err
-		}
+	return metadata
+}
 
-		if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING) {
-			logger.Warn("download is not pending status, will not execute")
-			updated = false
-			return nil
-		}
+func (d downloadTask) GetDownloadTaskList(ctx context.Context, params GetDownloadTaskListParams) (GetDownloadTaskListOutput, error) {
+	accountID := params.Principal.AccountID
 
-		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING)
-		err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
-		if err != nil {
-			return err
-		}
+	filter := database.DownloadTaskFilter{
+		AccessibleByAccountID: &accountID,
+	}
+	if params.TeamID != 0 {
+		filter.OfTeamID = &params.TeamID
+	}
 
-		updated = true
-		return nil
-	})
+	downloadTaskList, count, err := d.downloadTaskDataAccessor.GetDownloadTaskListByFilter(ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
+	}
 
-	if txErr != nil {
-		return false, database.DownloadTask{}, err
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, downloadTaskList)
+	if err != nil {
+		return GetDownloadTaskListOutput{}, err
 	}
 
-	return updated, downloadTask, nil
+	return GetDownloadTaskListOutput{
+		DonwloadTaskList: protoDownloadTaskList,
+		TotalCount:       count,
+	}, nil
 }
 
-func (d downloadTask) updateDownloadStatusFromDownloadingToFailed(ctx context.Context, downloadTask database.DownloadTask) error {
-	logger := utils.LoggerWithContext(ctx, d.logger)
+func (d downloadTask) getSucceededDownloadTaskMetadataOfAccount(
+	ctx context.Context,
+	principal Principal,
+	id uint64,
+) (map[string]any, error) {
+	accountID := principal.AccountID
 
-	downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED)
-	updateDownloadErr := d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask)
-	if updateDownloadErr != nil {
-		logger.With(zap.Error(updateDownloadErr)).Error("failed to update download task to failed")
-		return updateDownloadErr
+	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, id)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
-}
-
-func (d downloadTask) ExecuteDownloadTask(ctx context.Context, id uint64) error {
-	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
-	updated, downloadTask, err := d.updateDownloadStatusFromPendingToDownloading(ctx, id)
+	canAccess, err := d.checkDownloadTaskAccess(
+		ctx, d.teamMemberDataAccessor, d.downloadTaskShareDataAccessor, accountID, downloadTask, downloadTaskAccessRead)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if !updated {
-		return nil
+
+	if !canAccess {
+		return nil, status.Error(codes.PermissionDenied, "trying to get file of a download task the account cannot access")
 	}
 
-	var downloader Downloader
-	switch downloadTask.DownloadType {
-	case int32(go_load.DownloadType_DOWNLOAD_TYPE_HTTP):
-		downloader = NewDownloader(downloadTask.URL, d.logger)
+	if downloadTask.DownloadStatus == int32(go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED) {
+		return nil, status.Error(codes.PermissionDenied, "download task file is quarantined")
+	}
 
-	default:
-		logger.With(zap.Any("download_type", downloadTask.DownloadType)).Error("unsupported download type")
-		err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask)
-		if err != nil {
-			return err
-		}
-		return nil
+	if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS) {
+		return nil, status.Error(codes.Internal, "download task does not have a status of success")
 	}
 
-	fileName := fmt.Sprintf("download_file_%d", id)
-	fileWriterCloser, err := d.fileClient.Writer(ctx, fileName)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to get file writer")
-		if err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask); err != nil {
-			return err
-		}
-		return err
+	metadata, ok := downloadTask.Metadata.Data.(map[string]any)
+	if !ok {
+		return nil, status.Error(codes.Internal, "download task is not a map[string]any")
 	}
-	defer fileWriterCloser.Close()
 
-	metadata, err := downloader.Download(ctx, fileWriterCloser)
+	return metadata, nil
+}
+
+func (d downloadTask) GetDownloadTaskFile(ctx context.Context, params GetDownloadTaskFileParams) (io.ReadCloser, error) {
+	metadata, err := d.getSucceededDownloadTaskMetadataOfAccount(ctx, params.Principal, params.ID)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to download task")
-		if err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask); err != nil {
-			return err
-		}
-		return err
+		return nil, err
+	}
+
+	fileName, ok := metadata[downloadTaskMetadataFieldNameFileName]
+	if !ok {
+		return nil, status.Error(codes.Internal, "download task metadata does not contain file name")
 	}
 
-	metadata[downloadTaskMetadataFieldNameFileName] = fileName
-	downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS)
-	downloadTask.Metadata = database.JSON{
-		Data: metadata,
+	return d.fileClient.Reader(ctx, fileName.(string))
+}
+
+func getPostProcessorResultList(metadata map[string]any) ([]PostProcessorResult, error) {
+	postProcessorResultListMetadata, ok := metadata[downloadTaskMetadataFieldNamePostProcessorResultList]
+	if !ok {
+		return make([]PostProcessorResult, 0), nil
 	}
 
-	err = d.downloadTaskDataAccessor.UpdateDownloadTask(ctx, downloadTask)
+	postProcessorResultList, err := convertJSONValue[[]PostProcessorResult](postProcessorResultListMetadata)
 	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to update download task status to success")
-		return err
+		return nil, status.Error(codes.Internal, "failed to read post processor result list")
 	}
 
-	logger.Info("Download task executed successfully")
-	return nil
+	return postProcessorResultList, nil
 }
 
-func (d downloadTask) GetDownloadTaskList(ctx context.Context, params GetDownloadTaskListParams) (GetDownloadTaskListOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+func getExtractedFilePrefixAndList(metadata map[string]any) (string, []ExtractedFile, error) {
+	postProcessorResultList, err := getPostProcessorResultList(metadata)
 	if err != nil {
-		return GetDownloadTaskListOutput{}, err
+		return "", nil, err
 	}
 
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
-	if err != nil {
-		return GetDownloadTaskListOutput{}, err
+	archiveExtractionResult, found := lo.Find(postProcessorResultList, func(item PostProcessorResult) bool {
+		return item.Name == PostProcessorNameArchiveExtraction && item.Status == PostProcessorStatusSucceeded
+	})
+	if !found {
+		return "", nil, status.Error(codes.FailedPrecondition, "download task does not have extracted files")
 	}
 
-	downloadTaskList, count, err := d.downloadTaskDataAccessor.GetDownloadTaskListByAccount(ctx, accountID, params.Limit, params.Offset)
+	extractedFilePrefix, ok := archiveExtractionResult.Output[postProcessorOutputFieldNameExtractedFilePrefix].(string)
+	if !ok {
+		return "", nil, status.Error(codes.Internal, "archive extraction output does not contain extracted file prefix")
+	}
+
+	extractedFileList, err := convertJSONValue[[]ExtractedFile](archiveExtractionResult.Output[postProcessorOutputFieldNameExtractedFileList])
 	if err != nil {
-		return GetDownloadTaskListOutput{}, err
+		return "", nil, status.Error(codes.Internal, "failed to read extracted file list")
 	}
 
-	return GetDownloadTaskListOutput{
-		DonwloadTaskList: lo.Map(downloadTaskList, func(item database.DownloadTask, _ int) *go_load.DownloadTask {
-			return d.databaseDownloadTaskToProtoDownloadTask(item, account)
-		}),
-		TotalCount: count,
-	}, nil
+	return extractedFilePrefix, extractedFileList, nil
 }
 
-func (d downloadTask) GetDownloadTaskFile(ctx context.Context, params GetDownloadTaskFileParams) (io.ReadCloser, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+func getThumbnailFilePath(metadata map[string]any) (string, bool) {
+	postProcessorResultList, err := getPostProcessorResultList(metadata)
 	if err != nil {
-		return nil, err
+		return "", false
 	}
 
-	downloadTask, err := d.downloadTaskDataAccessor.GetDownloadTask(ctx, params.ID)
-	if err != nil {
-		return nil, err
+	thumbnailResult, found := lo.Find(postProcessorResultList, func(item PostProcessorResult) bool {
+		return item.Name == PostProcessorNameThumbnail && item.Status == PostProcessorStatusSucceeded
+	})
+	if !found {
+		return "", false
 	}
 
-	if downloadTask.OfAccountID != accountID {
-		return nil, status.Error(codes.PermissionDenied, "trying to get file of a download task the account does not own")
+	thumbnailFilePath, ok := thumbnailResult.Output[postProcessorOutputFieldNameThumbnailFilePath].(string)
+	return thumbnailFilePath, ok
+}
+
+// getStoredFilePathList returns the path of the downloaded file and of the files post processors made from it, it
+// is empty if the download task does not have a stored file
+func getStoredFilePathList(metadata map[string]any) []string {
+	fileName, ok := metadata[downloadTaskMetadataFieldNameFileName].(string)
+	if !ok {
+		return make([]string, 0)
 	}
 
-	if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS) {
-		return nil, status.Error(codes.Internal, "download task does not have a status of success")
+	filePathList := []string{fileName}
+	if thumbnailFilePath, ok := getThumbnailFilePath(metadata); ok {
+		filePathList = append(filePathList, thumbnailFilePath)
 	}
 
-	metadata, ok := downloadTask.Metadata.Data.(map[string]any)
-	if !ok {
-		return nil, status.Error(codes.Internal, "download task is not a map[string]any")
+	extractedFilePrefix, extractedFileList, err := getExtractedFilePrefixAndList(metadata)
+	if err != nil {
+		return filePathList
 	}
 
-	fileName, ok := metadata[downloadTaskMetadataFieldNameFileName]
-	if !ok {
-		return nil, status.Error(codes.Internal, "download task metadata does not contain file name")
+	for _, extractedFile := range extractedFileList {
+		filePathList = append(filePathList, path.Join(extractedFilePrefix, extractedFile.Path))
 	}
 
-	return d.fileClient.Reader(ctx, fileName.(string))
+	return filePathList
 }
 
-func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error) {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
+func (d downloadTask) GetDownloadTaskExtractedFileList(
+	ctx context.Context,
+	params GetDownloadTaskExtractedFileListParams,
+) (GetDownloadTaskExtractedFileListOutput, error) {
+	metadata, err := d.getSucceededDownloadTaskMetadataOfAccount(ctx, params.Principal, params.ID)
 	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
+		return GetDownloadTaskExtractedFileListOutput{}, err
 	}
 
-	var output UpdateDownloadTaskOutput
-	account, err := d.accountDataAccessor.GetAccountByID(ctx, accountID)
+	_, extractedFileList, err := getExtractedFilePrefixAndList(metadata)
 	if err != nil {
-		return UpdateDownloadTaskOutput{}, err
+		return GetDownloadTaskExtractedFileListOutput{}, err
 	}
 
-	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
-		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
-		if getDownloadTaskWithXLockErr != nil {
-			return getDownloadTaskWithXLockErr
-		}
-		if downloadTask.OfAccountID != accountID {
-			return status.Error(codes.PermissionDenied, "trying to update a download task the account does not own")
-		}
+	return GetDownloadTaskExtractedFileListOutput{
+		ExtractedFileList: lo.Map(extractedFileList, func(item ExtractedFile, _ int) *go_load.ExtractedFile {
+			return &go_load.ExtractedFile{
+				Path: item.Path,
+				Size: item.Size,
+			}
+		}),
+	}, nil
+}
 
-		downloadTask.URL = params.URL
-		updateErr := d.downloadTaskDataAccessor.WithDatabase(tx).UpdateDownloadTask(ctx, downloadTask)
-		if updateErr != nil {
-			return updateErr
-		}
+func (d downloadTask) GetDownloadTaskExtractedFile(
+	ctx context.Context,
+	params GetDownloadTaskExtractedFileParams,
+) (io.ReadCloser, error) {
+	metadata, err := d.getSucceededDownloadTaskMetadataOfAccount(ctx, params.Principal, params.ID)
+	if err != nil {
+		return nil, err
+	}
 
-		output.DownloadTask = d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
-		return nil
-	})
+	extractedFilePrefix, extractedFileList, err := getExtractedFilePrefixAndList(metadata)
+	if err != nil {
+		return nil, err
+	}
 
-	if txErr != nil {
-		return UpdateDownloadTaskOutput{}, txErr
+	// Only paths recorded at extraction time can be read, so the request cannot reach outside of the prefix
+	_, found := lo.Find(extractedFileList, func(item ExtractedFile) bool {
+		return item.Path == params.Path
+	})
+	if !found {
+		return nil, status.Error(codes.NotFound, "extracted file not found")
 	}
 
-	return output, nil
+	return d.fileClient.Reader(ctx, path.Join(extractedFilePrefix, params.Path))
 }
 
-func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownloadTaskParams) error {
-	accountID, _, err := d.tokenLogic.GetAccountIDAndExpireTime(ctx, params.Token)
-	if err != nil {
+// getArchivableDownloadTaskList checks that every requested download task can be archived before anything is streamed,
+// so the caller gets a proper error instead of a truncated archive
+func (d downloadTask) getArchivableDownloadTaskList(
+	ctx context.Context,
+	accountID uint64,
+	params GetDownloadTaskFilesAsArchiveParams,
+) ([]database.DownloadTask, error) {
+	if len(params.IDList) == 0 {
+		downloadTaskList, count, err := d.downloadTaskDataAccessor.GetDownloadTaskListByFilter(ctx, database.DownloadTaskFilter{
+			AccessibleByAccountID: &accountID,
+			DownloadStatusList:    []int32{int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS)},
+			URLContains:           params.URLContains,
+		}, maxDownloadTaskFilesArchiveEntryCount, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if count > maxDownloadTaskFilesArchiveEntryCount {
+			return nil, status.Error(codes.InvalidArgument, "too many download tasks match the filter")
+		}
+
+		if len(downloadTaskList) == 0 {
+			return nil, status.Error(codes.NotFound, "no download task file matches the filter")
+		}
+
+		return downloadTaskList, nil
+	}
+
+	downloadTaskList, _, err := d.downloadTaskDataAccessor.GetDownloadTaskListByFilter(ctx, database.DownloadTaskFilter{
+		IDList: params.IDList,
+	}, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(downloadTaskList) != len(lo.Uniq(params.IDList)) {
+		return nil, database.ErrDownloadTaskNotFound
+	}
+
+	// Roles are read once for every team instead of once for every download task
+	teamMemberList, err := d.teamMemberDataAccessor.GetTeamMemberListByAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	teamRoleByTeamID := lo.SliceToMap(teamMemberList, func(item database.TeamMember) (uint64, TeamRole) {
+		return item.OfTeamID, TeamRole(item.Role)
+	})
+
+	downloadTaskShareList, err := d.downloadTaskShareDataAccessor.GetDownloadTaskShareListByGrantee(ctx, accountID, params.IDList)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedDownloadTaskIDSet := lo.SliceToMap(downloadTaskShareList, func(item database.DownloadTaskShare) (uint64, struct{}) {
+		return item.OfDownloadTaskID, struct{}{}
+	})
+
+	for _, downloadTask := range downloadTaskList {
+		var teamRole TeamRole
+		if downloadTask.OfTeamID != nil {
+			teamRole = teamRoleByTeamID[*downloadTask.OfTeamID]
+		}
+
+		_, isGrantee := sharedDownloadTaskIDSet[downloadTask.ID]
+		if !canAccessDownloadTask(accountID, downloadTask, teamRole, isGrantee, downloadTaskAccessRead) {
+			return nil, status.Error(codes.PermissionDenied, "trying to get file of a download task the account cannot access")
+		}
+
+		if downloadTask.DownloadStatus == int32(go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED) {
+			return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("file of download task %d is quarantined", downloadTask.ID))
+		}
+
+		if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS) {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("download task %d does not have a status of success", downloadTask.ID))
+		}
+	}
+
+	return downloadTaskList, nil
+}
+
+// getDownloadTaskArchiveEntryName prefixes the entry with the download task id so entries downloaded from the same
+// file name do not collide
+func (d downloadTask) getDownloadTaskArchiveEntryName(downloadTask database.DownloadTask) string {
+	baseName := "download_file"
+	if parsedURL, err := url.Parse(downloadTask.URL); err == nil {
+		if urlBaseName := path.Base(parsedURL.Path); urlBaseName != "." && urlBaseName != "/" {
+			baseName = urlBaseName
+		}
+	}
+
+	return fmt.Sprintf("%d_%s", downloadTask.ID, baseName)
+}
+
+func (d downloadTask) writeDownloadTaskArchiveEntry(
+	ctx context.Context,
+	archiveWriter archiveWriter,
+	downloadTask database.DownloadTask,
+) error {
+	fileName, ok := getDownloadTaskMetadata(downloadTask)[downloadTaskMetadataFieldNameFileName].(string)
+	if !ok {
+		return status.Error(codes.Internal, "download task metadata does not contain file name")
+	}
+
+	fileSize, err := d.fileClient.Size(ctx, fileName)
+	if err != nil {
+		return err
+	}
+
+	reader, err := d.fileClient.Reader(ctx, fileName)
+	if err != nil {
 		return err
 	}
+	defer reader.Close()
+
+	return archiveWriter.WriteEntry(d.getDownloadTaskArchiveEntryName(downloadTask), fileSize, reader)
+}
+
+func (d downloadTask) writeDownloadTaskFilesArchive(
+	ctx context.Context,
+	writer io.Writer,
+	archiveFormat go_load.ArchiveFormat,
+	downloadTaskList []database.DownloadTask,
+) error {
+	archiveWriter := newArchiveWriter(archiveFormat, writer)
+	for _, downloadTask := range downloadTaskList {
+		if err := d.writeDownloadTaskArchiveEntry(ctx, archiveWriter, downloadTask); err != nil {
+			return err
+		}
+	}
+
+	return archiveWriter.Close()
+}
+
+func (d downloadTask) GetDownloadTaskFilesAsArchive(
+	ctx context.Context,
+	params GetDownloadTaskFilesAsArchiveParams,
+) (io.ReadCloser, error) {
+	accountID := params.Principal.AccountID
+
+	downloadTaskList, err := d.getArchivableDownloadTaskList(ctx, accountID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	logger := utils.LoggerWithContext(ctx, d.logger).
+		With(zap.Uint64("account_id", accountID)).
+		With(zap.Int("download_task_count", len(downloadTaskList)))
+
+	// The archive is built on the fly while the reader is consumed, closing the reader stops the archiving
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		err := d.writeDownloadTaskFilesArchive(ctx, pipeWriter, params.ArchiveFormat, downloadTaskList)
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			logger.With(zap.Error(err)).Error("failed to write download task files archive")
+		}
+
+		pipeWriter.CloseWithError(err)
+	}()
+
+	return pipeReader, nil
+}
+
+// getAccountIDListByAccountName fails if one of the accounts does not exist, so a mistyped name is not ignored
+func (d downloadTask) getAccountIDListByAccountName(
+	ctx context.Context,
+	accountDataAccessor database.AccountDataAccessor,
+	accountNameList []string,
+) ([]uint64, error) {
+	accountIDList := make([]uint64, 0, len(accountNameList))
+	for _, accountName := range lo.Uniq(accountNameList) {
+		account, err := accountDataAccessor.GetAccountByAccountName(ctx, accountName)
+		if err != nil {
+			return nil, err
+		}
+
+		accountIDList = append(accountIDList, account.ID)
+	}
+
+	return accountIDList, nil
+}
+
+func (d downloadTask) getSharedWithAccountList(
+	ctx context.Context,
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskShareDataAccessor database.DownloadTaskShareDataAccessor,
+	downloadTaskID uint64,
+) ([]*go_load.Account, error) {
+	downloadTaskShareList, err := downloadTaskShareDataAccessor.GetDownloadTaskShareListByDownloadTask(ctx, downloadTaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWithAccountList := make([]*go_load.Account, 0, len(downloadTaskShareList))
+	for _, downloadTaskShare := range downloadTaskShareList {
+		account, err := accountDataAccessor.GetAccountByID(ctx, downloadTaskShare.GranteeAccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		sharedWithAccountList = append(sharedWithAccountList, &go_load.Account{
+			Id:          account.ID,
+			AccountName: account.Name,
+		})
+	}
+
+	return sharedWithAccountList, nil
+}
+
+func (d downloadTask) ShareDownloadTask(
+	ctx context.Context,
+	params ShareDownloadTaskParams,
+) (ShareDownloadTaskOutput, error) {
+	accountID := params.Principal.AccountID
+
+	var output ShareDownloadTaskOutput
+	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
+		downloadTaskShareDataAccessor := d.downloadTaskShareDataAccessor.WithDatabase(tx)
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		canAccess, err := d.checkDownloadTaskAccess(
+			ctx, d.teamMemberDataAccessor.WithDatabase(tx), downloadTaskShareDataAccessor,
+			accountID, downloadTask, downloadTaskAccessManage)
+		if err != nil {
+			return err
+		}
+
+		if !canAccess {
+			return status.Error(codes.PermissionDenied, "trying to share a download task the account cannot manage")
+		}
+
+		if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS) {
+			return status.Error(codes.FailedPrecondition, "only download tasks with a status of success can be shared")
+		}
+
+		granteeAccountIDList, err := d.getAccountIDListByAccountName(ctx, d.accountDataAccessor.WithDatabase(tx), params.AccountNameList)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = downloadTaskShareDataAccessor.CreateDownloadTaskShareList(ctx, lo.Map(granteeAccountIDList,
+			func(item uint64, _ int) database.DownloadTaskShare {
+				return database.DownloadTaskShare{
+					OfDownloadTaskID: downloadTask.ID,
+					GranteeAccountID: item,
+					CreatedAt:        now,
+				}
+			}))
+		if err != nil {
+			return err
+		}
+
+		output.SharedWithAccountList, err = d.getSharedWithAccountList(
+			ctx, d.accountDataAccessor.WithDatabase(tx), downloadTaskShareDataAccessor, downloadTask.ID)
+		if err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(tx), params.Principal, auditLogEntry{
+			Action:     auditLogActionShareDownloadTask,
+			TargetType: auditLogTargetTypeDownloadTask,
+			TargetID:   downloadTask.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountIDList: granteeAccountIDList,
+			},
+		})
+	})
+	if txErr != nil {
+		return ShareDownloadTaskOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) UnshareDownloadTask(
+	ctx context.Context,
+	params UnshareDownloadTaskParams,
+) (UnshareDownloadTaskOutput, error) {
+	accountID := params.Principal.AccountID
+
+	var output UnshareDownloadTaskOutput
+	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
+		downloadTaskShareDataAccessor := d.downloadTaskShareDataAccessor.WithDatabase(tx)
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		canAccess, err := d.checkDownloadTaskAccess(
+			ctx, d.teamMemberDataAccessor.WithDatabase(tx), downloadTaskShareDataAccessor,
+			accountID, downloadTask, downloadTaskAccessManage)
+		if err != nil {
+			return err
+		}
+
+		if !canAccess {
+			return status.Error(codes.PermissionDenied, "trying to unshare a download task the account cannot manage")
+		}
+
+		granteeAccountIDList, err := d.getAccountIDListByAccountName(ctx, d.accountDataAccessor.WithDatabase(tx), params.AccountNameList)
+		if err != nil {
+			return err
+		}
+
+		if err = downloadTaskShareDataAccessor.DeleteDownloadTaskShareList(ctx, downloadTask.ID, granteeAccountIDList); err != nil {
+			return err
+		}
+
+		output.SharedWithAccountList, err = d.getSharedWithAccountList(
+			ctx, d.accountDataAccessor.WithDatabase(tx), downloadTaskShareDataAccessor, downloadTask.ID)
+		if err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(tx), params.Principal, auditLogEntry{
+			Action:     auditLogActionUnshareDownloadTask,
+			TargetType: auditLogTargetTypeDownloadTask,
+			TargetID:   downloadTask.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountIDList: granteeAccountIDList,
+			},
+		})
+	})
+	if txErr != nil {
+		return UnshareDownloadTaskOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) GetSharedDownloadTaskList(
+	ctx context.Context,
+	params GetSharedDownloadTaskListParams,
+) (GetSharedDownloadTaskListOutput, error) {
+	accountID := params.Principal.AccountID
+
+	downloadTaskList, count, err := d.downloadTaskDataAccessor.GetDownloadTaskListByFilter(ctx, database.DownloadTaskFilter{
+		SharedWithAccountID: &accountID,
+	}, params.Limit, params.Offset)
+	if err != nil {
+		return GetSharedDownloadTaskListOutput{}, err
+	}
+
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, downloadTaskList)
+	if err != nil {
+		return GetSharedDownloadTaskListOutput{}, err
+	}
+
+	return GetSharedDownloadTaskListOutput{
+		DownloadTaskList: protoDownloadTaskList,
+		TotalCount:       count,
+	}, nil
+}
+
+func (d downloadTask) UpdateDownloadTask(ctx context.Context, params UpdateDownloadTaskParams) (UpdateDownloadTaskOutput, error) {
+	accountID := params.Principal.AccountID
+
+	var output UpdateDownloadTaskOutput
+	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
+		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
+		if getDownloadTaskWithXLockErr != nil {
+			return getDownloadTaskWithXLockErr
+		}
+
+		canAccess, checkErr := d.checkDownloadTaskAccess(
+			ctx, d.teamMemberDataAccessor.WithDatabase(tx), d.downloadTaskShareDataAccessor.WithDatabase(tx),
+			accountID, downloadTask, downloadTaskAccessManage)
+		if checkErr != nil {
+			return checkErr
+		}
+
+		if !canAccess {
+			return status.Error(codes.PermissionDenied, "trying to update a download task the account cannot manage")
+		}
+
+		// The download task of a team may have been created by another account
+		account, getAccountErr := d.accountDataAccessor.WithDatabase(tx).GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		downloadTask.URL = params.URL
+		updateErr := d.downloadTaskDataAccessor.WithDatabase(tx).UpdateDownloadTask(ctx, downloadTask)
+		if updateErr != nil {
+			return updateErr
+		}
+
+		output.DownloadTask = d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+		return nil
+	})
+
+	if txErr != nil {
+		return UpdateDownloadTaskOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownloadTaskParams) error {
+	accountID := params.Principal.AccountID
 
 	return d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
 		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
@@ -390,10 +1122,335 @@ func (d downloadTask) DeleteDownloadTask(ctx context.Context, params DeleteDownl
 			return getDownloadTaskWithXLockErr
 		}
 
-		if downloadTask.OfAccountID != accountID {
-			return status.Error(codes.PermissionDenied, "trying to delete a download task the account does not own")
+		canAccess, checkErr := d.checkDownloadTaskAccess(
+			ctx, d.teamMemberDataAccessor.WithDatabase(tx), d.downloadTaskShareDataAccessor.WithDatabase(tx),
+			accountID, downloadTask, downloadTaskAccessManage)
+		if checkErr != nil {
+			return checkErr
+		}
+
+		if !canAccess {
+			return status.Error(codes.PermissionDenied, "trying to delete a download task the account cannot manage")
+		}
+
+		deleteShareErr := d.downloadTaskShareDataAccessor.WithDatabase(tx).DeleteDownloadTaskShareListByDownloadTask(ctx, params.ID)
+		if deleteShareErr != nil {
+			return deleteShareErr
+		}
+
+		deleteErr := d.downloadTaskDataAccessor.WithDatabase(tx).DeleteDownloadTask(ctx, params.ID)
+		if deleteErr != nil {
+			return deleteErr
+		}
+
+		return d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskDeleted, downloadTask))
+	})
+}
+
+func (d downloadTask) ExtendDownloadTaskExpiry(
+	ctx context.Context,
+	params ExtendDownloadTaskExpiryParams,
+) (ExtendDownloadTaskExpiryOutput, error) {
+	accountID := params.Principal.AccountID
+
+	var output ExtendDownloadTaskExpiryOutput
+	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
+		downloadTask, getDownloadTaskWithXLockErr := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, params.ID)
+		if getDownloadTaskWithXLockErr != nil {
+			return getDownloadTaskWithXLockErr
+		}
+
+		canAccess, checkErr := d.checkDownloadTaskAccess(
+			ctx, d.teamMemberDataAccessor.WithDatabase(tx), d.downloadTaskShareDataAccessor.WithDatabase(tx),
+			accountID, downloadTask, downloadTaskAccessManage)
+		if checkErr != nil {
+			return checkErr
+		}
+
+		if !canAccess {
+			return status.Error(codes.PermissionDenied, "trying to extend expiry of a download task the account cannot manage")
+		}
+
+		account, getAccountErr := d.accountDataAccessor.WithDatabase(tx).GetAccountByID(ctx, downloadTask.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		if downloadTask.DownloadStatus == int32(go_load.DownloadStatus_DOWNLOAD_STATUS_EXPIRED) {
+			return status.Error(codes.FailedPrecondition, "download task has already expired")
+		}
+
+		downloadTask.ExpiresAt = &params.ExpiresAt
+		metadata := getDownloadTaskMetadata(downloadTask)
+		delete(metadata, downloadTaskMetadataFieldNameExpiresAtFromRetention)
+		downloadTask.Metadata = database.JSON{Data: metadata}
+		updateErr := d.downloadTaskDataAccessor.WithDatabase(tx).UpdateDownloadTask(ctx, downloadTask)
+		if updateErr != nil {
+			return updateErr
 		}
 
-		return d.downloadTaskDataAccessor.WithDatabase(tx).DeleteDownloadTask(ctx, params.ID)
+		output.DownloadTask = d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account)
+		return nil
 	})
+
+	if txErr != nil {
+		return ExtendDownloadTaskExpiryOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (d downloadTask) expireDownloadTask(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+
+	var filePathList []string
+	txErr := d.goquDatabase.WithTx(func(tx *goqu.TxDatabase) error {
+		downloadTask, err := d.downloadTaskDataAccessor.WithDatabase(tx).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// The task might have been extended or deleted since it was listed
+		if downloadTask.ExpiresAt == nil || downloadTask.ExpiresAt.After(time.Now()) {
+			return nil
+		}
+
+		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_EXPIRED)
+		if err = d.downloadTaskDataAccessor.WithDatabase(tx).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		err = d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskExpired, downloadTask))
+		if err != nil {
+			return err
+		}
+
+		filePathList = getStoredFilePathList(getDownloadTaskMetadata(downloadTask))
+		return nil
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	// Files are deleted after the commit so a failed transaction does not leave a succeeded task without its file,
+	// a file failing to be deleted is only logged
+	for _, filePath := range filePathList {
+		if err := d.fileClient.Delete(ctx, filePath); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("file_path", filePath)).
+				Error("failed to delete file of expired download task")
+		}
+	}
+
+	return nil
+}
+
+func (d downloadTask) ExpireDownloadTasks(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	expirableDownloadStatusList := []int32{
+		int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS),
+		int32(go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED),
+		int32(go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED),
+		int32(go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELED),
+	}
+
+	for {
+		downloadTaskList, err := d.downloadTaskDataAccessor.GetExpiredDownloadTaskList(
+			ctx, expirableDownloadStatusList, time.Now(), expireDownloadTasksBatchSize)
+		if err != nil {
+			return err
+		}
+
+		// A task failing to expire does not stop the others from expiring, it is tried again on the next run
+		expiredCount := 0
+		for _, downloadTask := range downloadTaskList {
+			if err := d.expireDownloadTask(ctx, downloadTask.ID); err != nil {
+				logger.With(zap.Uint64("id", downloadTask.ID)).With(zap.Error(err)).Error("failed to expire download task")
+				continue
+			}
+
+			expiredCount++
+		}
+
+		logger.With(zap.Int("count", expiredCount)).Info("expired download tasks")
+
+		// Failed tasks are listed again, the batch is not retried if none of its tasks could be expired
+		if len(downloadTaskList) < expireDownloadTasksBatchSize || expiredCount == 0 {
+			return nil
+		}
+	}
+}
+
+func (d downloadTask) GetAllDownloadTaskList(
+	ctx context.Context,
+	params GetAllDownloadTaskListParams,
+) (GetAllDownloadTaskListOutput, error) {
+	filter := database.DownloadTaskFilter{
+		DownloadStatusList: lo.Map(params.DownloadStatusList, func(item go_load.DownloadStatus, _ int) int32 {
+			return int32(item)
+		}),
+		URLContains: params.URLContains,
+	}
+	if params.OfAccountID != 0 {
+		filter.OfAccountID = &params.OfAccountID
+	}
+
+	downloadTaskList, totalCount, err := d.downloadTaskDataAccessor.GetDownloadTaskListByFilter(
+		ctx, filter, params.Limit, params.Offset)
+	if err != nil {
+		return GetAllDownloadTaskListOutput{}, err
+	}
+
+	protoDownloadTaskList, err := d.databaseDownloadTaskListToProtoDownloadTaskList(ctx, downloadTaskList)
+	if err != nil {
+		return GetAllDownloadTaskListOutput{}, err
+	}
+
+	return GetAllDownloadTaskListOutput{
+		DownloadTaskList: protoDownloadTaskList,
+		TotalCount:       totalCount,
+	}, nil
+}
+
+func (d downloadTask) RetryDownloadTask(ctx context.Context, params RetryDownloadTaskParams) (RetryDownloadTaskOutput, error) {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", params.ID))
+
+	var (
+		downloadTask database.DownloadTask
+		filePathList []string
+	)
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		previousDownloadStatus := go_load.DownloadStatus(downloadTask.DownloadStatus)
+		switch previousDownloadStatus {
+		case go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED,
+			go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELED:
+		default:
+			return status.Error(codes.FailedPrecondition, "only finished download tasks can be retried")
+		}
+
+		metadata := getDownloadTaskMetadata(downloadTask)
+		filePathList = getStoredFilePathList(metadata)
+
+		// The retention policy applies again from the end of the new attempt, an expiry chosen for the download task
+		// is kept unless it has already passed, which would expire the new attempt right away
+		expiresAtFromRetention, _ := metadata[downloadTaskMetadataFieldNameExpiresAtFromRetention].(bool)
+		if expiresAtFromRetention || (downloadTask.ExpiresAt != nil && downloadTask.ExpiresAt.Before(time.Now())) {
+			downloadTask.ExpiresAt = nil
+		}
+
+		// Only the settings of the download task are kept, results of the previous attempt are dropped
+		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING)
+		downloadTask.Metadata = database.JSON{
+			Data: map[string]any{
+				downloadTaskMetadataFieldNamePostProcessorNameList: metadata[downloadTaskMetadataFieldNamePostProcessorNameList],
+			},
+		}
+		if err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		if err = createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionRetryDownloadTask,
+			TargetType: auditLogTargetTypeDownloadTask,
+			TargetID:   downloadTask.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNamePreviousDownloadStatus: previousDownloadStatus.String(),
+			},
+		}); err != nil {
+			return err
+		}
+
+		return d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskRetried, downloadTask))
+	})
+	if txErr != nil {
+		return RetryDownloadTaskOutput{}, txErr
+	}
+
+	// Files of the previous attempt are deleted after the commit so a failed transaction does not leave a succeeded
+	// task without its file, and before the task is produced since the new attempt writes to the same paths. A file
+	// failing to be deleted is only logged
+	for _, filePath := range filePathList {
+		if err := d.fileClient.Delete(ctx, filePath); err != nil {
+			logger.With(zap.Error(err)).With(zap.String("file_path", filePath)).
+				Error("failed to delete file of retried download task")
+		}
+	}
+
+	// The task stays pending if it cannot be produced, it can then be canceled and retried again
+	err := d.downloadTaskCreatedProducer.Produce(ctx, producer.DownloadTaskCreated{
+		ID: downloadTask.ID,
+	})
+	if err != nil {
+		return RetryDownloadTaskOutput{}, err
+	}
+
+	account, err := d.accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+	if err != nil {
+		return RetryDownloadTaskOutput{}, err
+	}
+
+	return RetryDownloadTaskOutput{
+		DownloadTask: d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account),
+	}, nil
+}
+
+func (d downloadTask) CancelDownloadTask(ctx context.Context, params CancelDownloadTaskParams) (CancelDownloadTaskOutput, error) {
+	var downloadTask database.DownloadTask
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var err error
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, params.ID)
+		if err != nil {
+			return err
+		}
+
+		// A downloading task is only marked as canceled, its worker drops the file once the download finishes
+		previousDownloadStatus := go_load.DownloadStatus(downloadTask.DownloadStatus)
+		if previousDownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING &&
+			previousDownloadStatus != go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING {
+			return status.Error(codes.FailedPrecondition, "only pending or downloading download tasks can be canceled")
+		}
+
+		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_CANCELED)
+		if err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		err = d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskCanceled, downloadTask))
+		if err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, d.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionCancelDownloadTask,
+			TargetType: auditLogTargetTypeDownloadTask,
+			TargetID:   downloadTask.ID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNamePreviousDownloadStatus: previousDownloadStatus.String(),
+			},
+		})
+	})
+	if txErr != nil {
+		return CancelDownloadTaskOutput{}, txErr
+	}
+
+	account, err := d.accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+	if err != nil {
+		return CancelDownloadTaskOutput{}, err
+	}
+
+	return CancelDownloadTaskOutput{
+		DownloadTask: d.databaseDownloadTaskToProtoDownloadTask(downloadTask, account),
+	}, nil
 }
@@ -0,0 +1,382 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+// downloadTaskProgressMilestonePercentList is only used for files whose size is known before downloading
+var downloadTaskProgressMilestonePercentList = []int{25, 50, 75}
+
+// DownloadTaskExecutor downloads the files of download tasks and runs their post processors, it is separate from
+// DownloadTask so only the download worker builds the downloader and the post processing pipeline
+type DownloadTaskExecutor interface {
+	ExecuteDownloadTask(context.Context, uint64) error
+}
+
+type downloadTaskExecutor struct {
+	goquDatabase                *goqu.Database
+	downloadTaskDataAccessor    database.DownloadTaskDataAccessor
+	accountDataAccessor         database.AccountDataAccessor
+	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer
+	lifecycleEventProducer      producer.DownloadTaskLifecycleEventProducer
+	fileClient                  file.Client
+	postProcessorCatalog        PostProcessorCatalog
+	postProcessingPipeline      PostProcessingPipeline
+	defaultRetention            time.Duration
+	logger                      *zap.Logger
+}
+
+func NewDownloadTaskExecutor(
+	goquDatabase *goqu.Database,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskCreatedProducer producer.DownloadTaskCreatedProducer,
+	lifecycleEventProducer producer.DownloadTaskLifecycleEventProducer,
+	fileClient file.Client,
+	postProcessorCatalog PostProcessorCatalog,
+	postProcessingPipeline PostProcessingPipeline,
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (DownloadTaskExecutor, error) {
+	defaultRetention, err := downloadConfig.GetDefaultRetentionDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse default_retention")
+		return nil, err
+	}
+
+	return &downloadTaskExecutor{
+		goquDatabase:                goquDatabase,
+		downloadTaskDataAccessor:    downloadTaskDataAccessor,
+		accountDataAccessor:         accountDataAccessor,
+		downloadTaskCreatedProducer: downloadTaskCreatedProducer,
+		lifecycleEventProducer:      lifecycleEventProducer,
+		fileClient:                  fileClient,
+		postProcessorCatalog:        postProcessorCatalog,
+		postProcessingPipeline:      postProcessingPipeline,
+		defaultRetention:            defaultRetention,
+		logger:                      logger,
+	}, nil
+}
+
+func (d downloadTaskExecutor) getRetention(account database.Account) time.Duration {
+	if account.DownloadTaskRetention != nil {
+		return time.Duration(*account.DownloadTaskRetention) * time.Second
+	}
+
+	return d.defaultRetention
+}
+
+func (d downloadTaskExecutor) updateDownloadStatusFromPendingToDownloading(ctx context.Context, id uint64) (bool, database.DownloadTask, error) {
+	var (
+		logger       = utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+		updated      = false
+		downloadTask database.DownloadTask
+		err          error
+	)
+
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		downloadTask, err = d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountNotFound) {
+				logger.Warn("download task not found, will skip download")
+				return nil
+			}
+			return err
+		}
+
+		if downloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING) {
+			logger.Warn("download is not pending status, will not execute")
+			updated = false
+			return nil
+		}
+
+		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING)
+		err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask)
+		if err != nil {
+			return err
+		}
+
+		err = d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskStarted, downloadTask))
+		if err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+
+	if txErr != nil {
+		return false, database.DownloadTask{}, err
+	}
+
+	return updated, downloadTask, nil
+}
+
+// updateDownloadTaskIfDownloading returns false without updating if the download task is not downloading anymore,
+// which happens when it is canceled or its account is deleted while its file is downloaded. The lifecycle event is
+// only produced when the download task is updated
+func (d downloadTaskExecutor) updateDownloadTaskIfDownloading(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	lifecycleEvent producer.DownloadTaskLifecycleEvent,
+) (bool, error) {
+	updated := false
+	txErr := d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		currentDownloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, downloadTask.ID)
+		if err != nil {
+			if errors.Is(err, database.ErrDownloadTaskNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if currentDownloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING) {
+			return nil
+		}
+
+		if err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, downloadTask); err != nil {
+			return err
+		}
+
+		if err = d.lifecycleEventProducer.Produce(ctx, lifecycleEvent); err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+	if txErr != nil {
+		return false, txErr
+	}
+
+	return updated, nil
+}
+
+// requeueDownloadTask puts a download task that was interrupted before finishing back to pending and produces it
+// again, so it is downloaded from the start by the next consumer instead of being left downloading
+func (d downloadTaskExecutor) requeueDownloadTask(ctx context.Context, downloadTask database.DownloadTask) error {
+	return d.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		currentDownloadTask, err := d.downloadTaskDataAccessor.WithDatabase(td).GetDownloadTaskWithXLock(ctx, downloadTask.ID)
+		if err != nil {
+			if errors.Is(err, database.ErrDownloadTaskNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if currentDownloadTask.DownloadStatus != int32(go_load.DownloadStatus_DOWNLOAD_STATUS_DOWNLOADING) {
+			return nil
+		}
+
+		currentDownloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_PENDING)
+		if err = d.downloadTaskDataAccessor.WithDatabase(td).UpdateDownloadTask(ctx, currentDownloadTask); err != nil {
+			return err
+		}
+
+		err = d.lifecycleEventProducer.Produce(ctx, newDownloadTaskLifecycleEvent(
+			producer.MessageQueueDownloadTaskRequeued, currentDownloadTask))
+		if err != nil {
+			return err
+		}
+
+		return d.downloadTaskCreatedProducer.Produce(ctx, producer.DownloadTaskCreated{
+			ID: currentDownloadTask.ID,
+		})
+	})
+}
+
+func (d downloadTaskExecutor) updateDownloadStatusFromDownloadingToFailed(
+	ctx context.Context,
+	downloadTask database.DownloadTask,
+	failureReason string,
+) error {
+	logger := utils.LoggerWithContext(ctx, d.logger)
+
+	downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_FAILED)
+	lifecycleEvent := newDownloadTaskLifecycleEvent(producer.MessageQueueDownloadTaskFailed, downloadTask)
+	lifecycleEvent.FailureReason = failureReason
+	_, updateDownloadErr := d.updateDownloadTaskIfDownloading(ctx, downloadTask, lifecycleEvent)
+	if updateDownloadErr != nil {
+		logger.With(zap.Error(updateDownloadErr)).Error("failed to update download task to failed")
+		return updateDownloadErr
+	}
+
+	return nil
+}
+
+// runPostProcessingPipeline records the post processor results in metadata and returns whether the file is quarantined,
+// failed post processors do not fail the download
+func (d downloadTaskExecutor) runPostProcessingPipeline(ctx context.Context, downloadTask database.DownloadTask, metadata map[string]any) bool {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+
+	postProcessorNameList, err := convertJSONValue[[]string](metadata[downloadTaskMetadataFieldNamePostProcessorNameList])
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to read post processor list of download task")
+		return false
+	}
+
+	// Download tasks created before a post processor became mandatory get it too
+	postProcessorNameList = d.postProcessorCatalog.WithMandatoryPostProcessorNameList(postProcessorNameList)
+
+	if len(postProcessorNameList) == 0 {
+		return false
+	}
+
+	output := d.postProcessingPipeline.Run(ctx, postProcessorNameList, PostProcessorInput{
+		DownloadTaskID: downloadTask.ID,
+		FileName:       metadata[downloadTaskMetadataFieldNameFileName].(string),
+		Metadata:       metadata,
+	})
+
+	metadata[downloadTaskMetadataFieldNamePostProcessorResultList] = output.ResultList
+	return output.Quarantined
+}
+
+// newDownloadProgressFunc produces a progress milestone event whenever the download passes one, failing to produce it
+// does not fail the download
+func (d downloadTaskExecutor) newDownloadProgressFunc(ctx context.Context, downloadTask database.DownloadTask) DownloadProgressFunc {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", downloadTask.ID))
+	nextMilestoneIndex := 0
+
+	return func(downloadedBytes int64, totalBytes int64) {
+		if totalBytes <= 0 {
+			return
+		}
+
+		progressPercent := int(downloadedBytes * 100 / totalBytes)
+		for nextMilestoneIndex < len(downloadTaskProgressMilestonePercentList) &&
+			progressPercent >= downloadTaskProgressMilestonePercentList[nextMilestoneIndex] {
+			lifecycleEvent := newDownloadTaskLifecycleEvent(
+				producer.MessageQueueDownloadTaskProgressMilestoneReached, downloadTask)
+			lifecycleEvent.ProgressPercent = downloadTaskProgressMilestonePercentList[nextMilestoneIndex]
+			nextMilestoneIndex++
+
+			if err := d.lifecycleEventProducer.Produce(ctx, lifecycleEvent); err != nil {
+				logger.With(zap.Error(err)).Warn("failed to produce download task progress milestone event")
+			}
+		}
+	}
+}
+
+func (d downloadTaskExecutor) ExecuteDownloadTask(ctx context.Context, id uint64) error {
+	logger := utils.LoggerWithContext(ctx, d.logger).With(zap.Uint64("id", id))
+	updated, downloadTask, err := d.updateDownloadStatusFromPendingToDownloading(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return nil
+	}
+
+	var downloader Downloader
+	switch downloadTask.DownloadType {
+	case int32(go_load.DownloadType_DOWNLOAD_TYPE_HTTP):
+		downloader = NewDownloader(downloadTask.URL, d.newDownloadProgressFunc(ctx, downloadTask), d.logger)
+
+	default:
+		logger.With(zap.Any("download_type", downloadTask.DownloadType)).Error("unsupported download type")
+		err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask, "unsupported download type")
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	fileName := fmt.Sprintf("download_file_%d", id)
+	fileWriterCloser, err := d.fileClient.Writer(ctx, fileName)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get file writer")
+		if err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask, "failed to store downloaded file"); err != nil {
+			return err
+		}
+		return err
+	}
+
+	downloadMetadata, err := downloader.Download(ctx, fileWriterCloser)
+	if closeErr := fileWriterCloser.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil && ctx.Err() != nil {
+		logger.With(zap.Error(err)).Warn("download task interrupted by shutdown, requeuing it")
+		return d.requeueDownloadTask(context.WithoutCancel(ctx), downloadTask)
+	}
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to download task")
+		if err := d.updateDownloadStatusFromDownloadingToFailed(ctx, downloadTask, err.Error()); err != nil {
+			return err
+		}
+		return err
+	}
+
+	metadata := getDownloadTaskMetadata(downloadTask)
+	for key, value := range downloadMetadata {
+		metadata[key] = value
+	}
+
+	metadata[downloadTaskMetadataFieldNameFileName] = fileName
+	downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_SUCCESS)
+	if quarantined := d.runPostProcessingPipeline(ctx, downloadTask, metadata); quarantined {
+		downloadTask.DownloadStatus = int32(go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED)
+	}
+
+	downloadTask.Metadata = database.JSON{
+		Data: metadata,
+	}
+
+	if downloadTask.ExpiresAt == nil {
+		account, getAccountErr := d.accountDataAccessor.GetAccountByID(ctx, downloadTask.OfAccountID)
+		// A deleted account also deleted the download task, which is handled when updating it below
+		if getAccountErr != nil && !errors.Is(getAccountErr, database.ErrAccountNotFound) {
+			logger.With(zap.Error(getAccountErr)).Error("failed to get account of download task")
+			return getAccountErr
+		}
+
+		if getAccountErr == nil {
+			if retention := d.getRetention(account); retention > 0 {
+				expiresAt := time.Now().Add(retention)
+				downloadTask.ExpiresAt = &expiresAt
+				metadata[downloadTaskMetadataFieldNameExpiresAtFromRetention] = true
+			}
+		}
+	}
+
+	lifecycleEventType := producer.MessageQueueDownloadTaskSucceeded
+	if downloadTask.DownloadStatus == int32(go_load.DownloadStatus_DOWNLOAD_STATUS_QUARANTINED) {
+		lifecycleEventType = producer.MessageQueueDownloadTaskQuarantined
+	}
+
+	updated, err = d.updateDownloadTaskIfDownloading(
+		ctx, downloadTask, newDownloadTaskLifecycleEvent(lifecycleEventType, downloadTask))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to update download task status to success")
+		return err
+	}
+
+	if !updated {
+		logger.Warn("download task was canceled or deleted while downloading, will delete its files")
+		for _, filePath := range getStoredFilePathList(metadata) {
+			if err = d.fileClient.Delete(ctx, filePath); err != nil {
+				logger.With(zap.Error(err)).With(zap.String("file_path", filePath)).
+					Error("failed to delete file of canceled download task")
+				return err
+			}
+		}
+		return nil
+	}
+
+	logger.Info("Download task executed successfully")
+	return nil
+}
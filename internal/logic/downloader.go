@@ -14,25 +14,47 @@ const (
 	HTTPMetadataKeyContentType    = "content-type"
 )
 
+// DownloadProgressFunc is called after every write with the number of bytes downloaded so far, totalBytes is -1 when
+// the size of the file is unknown
+type DownloadProgressFunc func(downloadedBytes int64, totalBytes int64)
+
 type Downloader interface {
 	Download(ctx context.Context, writer io.Writer) (map[string]any, error)
 }
 
 type downloader struct {
-	url    string
-	logger *zap.Logger
+	url          string
+	progressFunc DownloadProgressFunc
+	logger       *zap.Logger
 }
 
+// NewDownloader does not report progress when progressFunc is nil
 func NewDownloader(
 	url string,
+	progressFunc DownloadProgressFunc,
 	logger *zap.Logger,
 ) Downloader {
 	return &downloader{
-		url:    url,
-		logger: logger,
+		url:          url,
+		progressFunc: progressFunc,
+		logger:       logger,
 	}
 }
 
+type progressWriter struct {
+	writer          io.Writer
+	downloadedBytes int64
+	totalBytes      int64
+	progressFunc    DownloadProgressFunc
+}
+
+func (p *progressWriter) Write(data []byte) (int, error) {
+	writtenByteCount, err := p.writer.Write(data)
+	p.downloadedBytes += int64(writtenByteCount)
+	p.progressFunc(p.downloadedBytes, p.totalBytes)
+	return writtenByteCount, err
+}
+
 func (d downloader) Download(ctx context.Context, writer io.Writer) (map[string]any, error) {
 	logger := utils.LoggerWithContext(ctx, d.logger)
 
@@ -49,6 +71,14 @@ func (d downloader) Download(ctx context.Context, writer io.Writer) (map[string]
 	}
 	defer response.Body.Close()
 
+	if d.progressFunc != nil {
+		writer = &progressWriter{
+			writer:       writer,
+			totalBytes:   response.ContentLength,
+			progressFunc: d.progressFunc,
+		}
+	}
+
 	_, err = io.Copy(writer, response.Body)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to read response and write to writer")
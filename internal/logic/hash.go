@@ -2,48 +2,163 @@ package logic
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/nhtuan0700/GoLoad/internal/configs"
-	"golang.org/x/crypto/bcrypt"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var (
+	errUnknownHashFormat = errors.New("unknown hash format")
+
+	// phcEncoding is the base64 variant of the phc string format, standard alphabet without padding
+	phcEncoding = base64.RawStdEncoding
+)
+
 type Hash interface {
 	Hash(ctx context.Context, data string) (string, error)
 	IsHashEqual(ctx context.Context, data string, hashed string) (bool, error)
+	// NeedsRehash reports whether hashed was not made with the configured algorithm and parameters, it should be
+	// replaced by a new hash the next time the data is known, such as when an account signs in
+	NeedsRehash(hashed string) bool
+}
+
+// hashAlgorithm is implemented for each supported algorithm, hashes of every algorithm can be verified whichever
+// algorithm is configured for new hashes
+type hashAlgorithm interface {
+	hash(data string) (string, error)
+	// isHashEqual is only called with hashes accepted by matchesHash
+	isHashEqual(data, hashed string) (bool, error)
+	matchesHash(hashed string) bool
+	// isUpToDate reports whether hashed was made with the configured parameters
+	isUpToDate(hashed string) bool
 }
 
 type hash struct {
-	authConfig configs.Auth
+	algorithm     hashAlgorithm
+	algorithmList []hashAlgorithm
 }
 
 func NewHash(
 	authConfig configs.Auth,
-) Hash {
-	return &hash{
-		authConfig: authConfig,
+	logger *zap.Logger,
+) (Hash, error) {
+	hashConfig := authConfig.Hash
+
+	bcryptAlgorithm := newBcryptHashAlgorithm(hashConfig.Bcrypt)
+	argon2idAlgorithm := newArgon2idHashAlgorithm(hashConfig.Argon2id)
+	scryptAlgorithm := newScryptHashAlgorithm(hashConfig.Scrypt)
+
+	h := &hash{
+		algorithmList: []hashAlgorithm{bcryptAlgorithm, argon2idAlgorithm, scryptAlgorithm},
+	}
+
+	var err error
+	switch hashConfig.Algorithm {
+	// bcrypt was the only algorithm before it could be configured
+	case configs.HashAlgorithmBcrypt, "":
+		h.algorithm, err = bcryptAlgorithm, bcryptAlgorithm.validate()
+	case configs.HashAlgorithmArgon2id:
+		h.algorithm, err = argon2idAlgorithm, argon2idAlgorithm.validate()
+	case configs.HashAlgorithmScrypt:
+		h.algorithm, err = scryptAlgorithm, scryptAlgorithm.validate()
+	default:
+		err = fmt.Errorf("unsupported hash algorithm: %s", hashConfig.Algorithm)
+	}
+
+	if err != nil {
+		logger.With(zap.Error(err)).Error("invalid hash config")
+		return nil, err
 	}
+
+	return h, nil
 }
 
 func (h *hash) Hash(_ context.Context, data string) (string, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(data), h.authConfig.Hash.Cost)
-
+	hashed, err := h.algorithm.hash(data)
 	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return "", err
+		}
 		return "", status.Error(codes.Internal, "failed to hash data")
 	}
 
-	return string(hashed), nil
+	return hashed, nil
 }
 
 func (h *hash) IsHashEqual(_ context.Context, data, hashed string) (bool, error) {
-	if err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(data)); err != nil {
-		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-			return false, nil
+	for _, algorithm := range h.algorithmList {
+		if !algorithm.matchesHash(hashed) {
+			continue
+		}
+
+		isHashEqual, err := algorithm.isHashEqual(data, hashed)
+		if err != nil {
+			return false, status.Error(codes.Internal, "failed to check if data is equal hash")
+		}
+
+		return isHashEqual, nil
+	}
+
+	return false, status.Error(codes.Internal, "failed to check if data is equal hash")
+}
+
+func (h *hash) NeedsRehash(hashed string) bool {
+	return !h.algorithm.matchesHash(hashed) || !h.algorithm.isUpToDate(hashed)
+}
+
+// phcHash is a hash in the phc string format: $<id>[$v=<version>][$<param>=<value>(,<param>=<value>)*]$<salt>$<hash>
+type phcHash struct {
+	ID       string
+	Version  string
+	ParamMap map[string]string
+	Salt     []byte
+	Hash     []byte
+}
+
+func parsePHCHash(hashed string) (phcHash, error) {
+	partList := strings.Split(hashed, "$")
+	if len(partList) < 4 || partList[0] != "" {
+		return phcHash{}, errUnknownHashFormat
+	}
+
+	parsedHash := phcHash{
+		ID:       partList[1],
+		ParamMap: make(map[string]string),
+	}
+
+	partList = partList[2:]
+	if strings.HasPrefix(partList[0], "v=") {
+		parsedHash.Version = strings.TrimPrefix(partList[0], "v=")
+		partList = partList[1:]
+	}
+
+	if len(partList) != 3 {
+		return phcHash{}, errUnknownHashFormat
+	}
+
+	for _, param := range strings.Split(partList[0], ",") {
+		name, value, found := strings.Cut(param, "=")
+		if !found {
+			return phcHash{}, errUnknownHashFormat
 		}
-		return false, status.Error(codes.Internal, "failed to check if data is equal hash")
+
+		parsedHash.ParamMap[name] = value
+	}
+
+	var err error
+	if parsedHash.Salt, err = phcEncoding.DecodeString(partList[1]); err != nil {
+		return phcHash{}, errUnknownHashFormat
+	}
+
+	if parsedHash.Hash, err = phcEncoding.DecodeString(partList[2]); err != nil {
+		return phcHash{}, errUnknownHashFormat
 	}
 
-	return true, nil
+	return parsedHash, nil
 }
@@ -0,0 +1,120 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	argon2idHashID = "argon2id"
+
+	argon2idParamNameMemory      = "m"
+	argon2idParamNameIterations  = "t"
+	argon2idParamNameParallelism = "p"
+
+	argon2idMinSaltLength = 8
+	argon2idMinKeyLength  = 16
+)
+
+type argon2idHashAlgorithm struct {
+	config configs.Argon2idHash
+}
+
+func newArgon2idHashAlgorithm(config configs.Argon2idHash) *argon2idHashAlgorithm {
+	return &argon2idHashAlgorithm{
+		config: config,
+	}
+}
+
+func (a argon2idHashAlgorithm) validate() error {
+	if a.config.MemoryKiB == 0 || a.config.Iterations == 0 || a.config.Parallelism == 0 {
+		return errors.New("argon2id memory_kib, iterations and parallelism must be positive")
+	}
+
+	if a.config.SaltLength < argon2idMinSaltLength || a.config.KeyLength < argon2idMinKeyLength {
+		return fmt.Errorf("argon2id salt_length must be at least %d and key_length at least %d",
+			argon2idMinSaltLength, argon2idMinKeyLength)
+	}
+
+	return nil
+}
+
+func (a argon2idHashAlgorithm) hash(data string) (string, error) {
+	salt := make([]byte, a.config.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(data), salt, a.config.Iterations, a.config.MemoryKiB, a.config.Parallelism, a.config.KeyLength)
+	return fmt.Sprintf("$%s$v=%d$%s=%d,%s=%d,%s=%d$%s$%s",
+		argon2idHashID, argon2.Version,
+		argon2idParamNameMemory, a.config.MemoryKiB,
+		argon2idParamNameIterations, a.config.Iterations,
+		argon2idParamNameParallelism, a.config.Parallelism,
+		phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+}
+
+type argon2idParams struct {
+	memoryKiB   uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+func (a argon2idHashAlgorithm) parseHash(hashed string) (phcHash, argon2idParams, error) {
+	parsedHash, err := parsePHCHash(hashed)
+	if err != nil {
+		return phcHash{}, argon2idParams{}, err
+	}
+
+	if parsedHash.ID != argon2idHashID || parsedHash.Version != strconv.Itoa(argon2.Version) {
+		return phcHash{}, argon2idParams{}, errUnknownHashFormat
+	}
+
+	memoryKiB, memoryErr := strconv.ParseUint(parsedHash.ParamMap[argon2idParamNameMemory], 10, 32)
+	iterations, iterationsErr := strconv.ParseUint(parsedHash.ParamMap[argon2idParamNameIterations], 10, 32)
+	parallelism, parallelismErr := strconv.ParseUint(parsedHash.ParamMap[argon2idParamNameParallelism], 10, 8)
+	if err = errors.Join(memoryErr, iterationsErr, parallelismErr); err != nil {
+		return phcHash{}, argon2idParams{}, errUnknownHashFormat
+	}
+
+	return parsedHash, argon2idParams{
+		memoryKiB:   uint32(memoryKiB),
+		iterations:  uint32(iterations),
+		parallelism: uint8(parallelism),
+	}, nil
+}
+
+func (a argon2idHashAlgorithm) isHashEqual(data, hashed string) (bool, error) {
+	parsedHash, params, err := a.parseHash(hashed)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey(
+		[]byte(data), parsedHash.Salt, params.iterations, params.memoryKiB, params.parallelism, uint32(len(parsedHash.Hash)))
+	return subtle.ConstantTimeCompare(key, parsedHash.Hash) == 1, nil
+}
+
+func (a argon2idHashAlgorithm) matchesHash(hashed string) bool {
+	return strings.HasPrefix(hashed, "$"+argon2idHashID+"$")
+}
+
+func (a argon2idHashAlgorithm) isUpToDate(hashed string) bool {
+	parsedHash, params, err := a.parseHash(hashed)
+	if err != nil {
+		return false
+	}
+
+	return params.memoryKiB == a.config.MemoryKiB &&
+		params.iterations == a.config.Iterations &&
+		params.parallelism == a.config.Parallelism &&
+		len(parsedHash.Salt) == int(a.config.SaltLength) &&
+		len(parsedHash.Hash) == int(a.config.KeyLength)
+}
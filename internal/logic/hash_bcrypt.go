@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// bcryptMaxPasswordByteCount is where bcrypt would silently truncate passwords, longer ones are rejected
+	bcryptMaxPasswordByteCount = 72
+)
+
+var (
+	errPasswordTooLongForBcrypt = status.Error(codes.InvalidArgument,
+		fmt.Sprintf("password must not be longer than %d bytes", bcryptMaxPasswordByteCount))
+
+	// bcryptHashPrefixList are the versions of the modular crypt format of bcrypt, used as is instead of a phc string
+	bcryptHashPrefixList = []string{"$2a$", "$2b$", "$2y$"}
+)
+
+type bcryptHashAlgorithm struct {
+	config configs.BcryptHash
+}
+
+func newBcryptHashAlgorithm(config configs.BcryptHash) *bcryptHashAlgorithm {
+	return &bcryptHashAlgorithm{
+		config: config,
+	}
+}
+
+func (b bcryptHashAlgorithm) validate() error {
+	if b.config.Cost < bcrypt.MinCost || b.config.Cost > bcrypt.MaxCost {
+		return fmt.Errorf("bcrypt cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	return nil
+}
+
+func (b bcryptHashAlgorithm) hash(data string) (string, error) {
+	if len(data) > bcryptMaxPasswordByteCount {
+		return "", errPasswordTooLongForBcrypt
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(data), b.config.Cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashed), nil
+}
+
+func (b bcryptHashAlgorithm) isHashEqual(data, hashed string) (bool, error) {
+	// Such passwords could never have been hashed, comparing them would only match on their first bytes
+	if len(data) > bcryptMaxPasswordByteCount {
+		return false, nil
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(hashed), []byte(data)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b bcryptHashAlgorithm) matchesHash(hashed string) bool {
+	for _, prefix := range bcryptHashPrefixList {
+		if strings.HasPrefix(hashed, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b bcryptHashAlgorithm) isUpToDate(hashed string) bool {
+	cost, err := bcrypt.Cost([]byte(hashed))
+	return err == nil && cost == b.config.Cost
+}
@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	scryptHashID = "scrypt"
+
+	scryptParamNameCostLog2    = "ln"
+	scryptParamNameBlockSize   = "r"
+	scryptParamNameParallelism = "p"
+
+	scryptMaxCostLog2   = 31
+	scryptMinSaltLength = 8
+	scryptMinKeyLength  = 16
+)
+
+type scryptHashAlgorithm struct {
+	config configs.ScryptHash
+}
+
+func newScryptHashAlgorithm(config configs.ScryptHash) *scryptHashAlgorithm {
+	return &scryptHashAlgorithm{
+		config: config,
+	}
+}
+
+func (s scryptHashAlgorithm) validate() error {
+	if s.config.CostLog2 == 0 || s.config.CostLog2 >= scryptMaxCostLog2 {
+		return fmt.Errorf("scrypt cost_log2 must be between 1 and %d", scryptMaxCostLog2-1)
+	}
+
+	if s.config.BlockSize <= 0 || s.config.Parallelism <= 0 {
+		return errors.New("scrypt block_size and parallelism must be positive")
+	}
+
+	if s.config.SaltLength < scryptMinSaltLength || s.config.KeyLength < scryptMinKeyLength {
+		return fmt.Errorf("scrypt salt_length must be at least %d and key_length at least %d",
+			scryptMinSaltLength, scryptMinKeyLength)
+	}
+
+	return nil
+}
+
+func (s scryptHashAlgorithm) hash(data string) (string, error) {
+	salt := make([]byte, s.config.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key, err := scrypt.Key([]byte(data), salt, 1<<s.config.CostLog2, s.config.BlockSize, s.config.Parallelism, s.config.KeyLength)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("$%s$%s=%d,%s=%d,%s=%d$%s$%s",
+		scryptHashID,
+		scryptParamNameCostLog2, s.config.CostLog2,
+		scryptParamNameBlockSize, s.config.BlockSize,
+		scryptParamNameParallelism, s.config.Parallelism,
+		phcEncoding.EncodeToString(salt), phcEncoding.EncodeToString(key)), nil
+}
+
+type scryptParams struct {
+	costLog2    uint8
+	blockSize   int
+	parallelism int
+}
+
+func (s scryptHashAlgorithm) parseHash(hashed string) (phcHash, scryptParams, error) {
+	parsedHash, err := parsePHCHash(hashed)
+	if err != nil {
+		return phcHash{}, scryptParams{}, err
+	}
+
+	if parsedHash.ID != scryptHashID {
+		return phcHash{}, scryptParams{}, errUnknownHashFormat
+	}
+
+	costLog2, costLog2Err := strconv.ParseUint(parsedHash.ParamMap[scryptParamNameCostLog2], 10, 8)
+	blockSize, blockSizeErr := strconv.Atoi(parsedHash.ParamMap[scryptParamNameBlockSize])
+	parallelism, parallelismErr := strconv.Atoi(parsedHash.ParamMap[scryptParamNameParallelism])
+	if err = errors.Join(costLog2Err, blockSizeErr, parallelismErr); err != nil || costLog2 >= scryptMaxCostLog2 {
+		return phcHash{}, scryptParams{}, errUnknownHashFormat
+	}
+
+	return parsedHash, scryptParams{
+		costLog2:    uint8(costLog2),
+		blockSize:   blockSize,
+		parallelism: parallelism,
+	}, nil
+}
+
+func (s scryptHashAlgorithm) isHashEqual(data, hashed string) (bool, error) {
+	parsedHash, params, err := s.parseHash(hashed)
+	if err != nil {
+		return false, err
+	}
+
+	key, err := scrypt.Key(
+		[]byte(data), parsedHash.Salt, 1<<params.costLog2, params.blockSize, params.parallelism, len(parsedHash.Hash))
+	if err != nil {
+		return false, err
+	}
+
+	return subtle.ConstantTimeCompare(key, parsedHash.Hash) == 1, nil
+}
+
+func (s scryptHashAlgorithm) matchesHash(hashed string) bool {
+	return strings.HasPrefix(hashed, "$"+scryptHashID+"$")
+}
+
+func (s scryptHashAlgorithm) isUpToDate(hashed string) bool {
+	parsedHash, params, err := s.parseHash(hashed)
+	if err != nil {
+		return false
+	}
+
+	return params.costLog2 == s.config.CostLog2 &&
+		params.blockSize == s.config.BlockSize &&
+		params.parallelism == s.config.Parallelism &&
+		len(parsedHash.Salt) == int(s.config.SaltLength) &&
+		len(parsedHash.Hash) == s.config.KeyLength
+}
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// convertJSONValue converts a value decoded from JSON into a typed value, such as metadata read back from the database
+func convertJSONValue[T any](value any) (T, error) {
+	var converted T
+
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return converted, err
+	}
+
+	if err := json.Unmarshal(valueBytes, &converted); err != nil {
+		return converted, err
+	}
+
+	return converted, nil
+}
+
+func mapToProtoStruct(data map[string]any) (*structpb.Struct, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	// structpb only accepts JSON compatible types, so typed values are converted first
+	jsonData, err := convertJSONValue[map[string]any](data)
+	if err != nil {
+		return nil, err
+	}
+
+	return structpb.NewStruct(jsonData)
+}
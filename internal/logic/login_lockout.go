@@ -0,0 +1,167 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	loginLockoutSubjectPrefixAccountName = "account_name:"
+	loginLockoutSubjectPrefixIP          = "ip:"
+)
+
+type LoginLockoutParams struct {
+	AccountName string
+	// ClientIP is empty if it is not known, only the account name is then counted
+	ClientIP string
+}
+
+// LoginLockout protects password logins against brute force, failed logins are counted per account name and per
+// client ip and each one gets locked out once it has too many failures. The cache being unavailable does not block
+// logins, failures are only logged
+type LoginLockout interface {
+	// Check returns RESOURCE_EXHAUSTED with the remaining lockout as retry delay if the login is locked out
+	Check(ctx context.Context, params LoginLockoutParams) error
+	RecordFailure(ctx context.Context, params LoginLockoutParams)
+	// RecordSuccess only resets the account name counter, so an attacker owning an account cannot reset the
+	// counter of their ip with it
+	RecordSuccess(ctx context.Context, params LoginLockoutParams)
+}
+
+type loginLockout struct {
+	loginAttemptCache               cache.LoginAttempt
+	maxFailedAttemptsPerAccountName uint64
+	maxFailedAttemptsPerIP          uint64
+	window                          time.Duration
+	baseLockout                     time.Duration
+	maxLockout                      time.Duration
+	logger                          *zap.Logger
+}
+
+func NewLoginLockout(
+	loginAttemptCache cache.LoginAttempt,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (LoginLockout, error) {
+	loginLockoutConfig := authConfig.LoginLockout
+
+	window, err := loginLockoutConfig.GetWindowDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse login_lockout.window")
+		return nil, err
+	}
+
+	baseLockout, err := loginLockoutConfig.GetBaseLockoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse login_lockout.base_lockout")
+		return nil, err
+	}
+
+	maxLockout, err := loginLockoutConfig.GetMaxLockoutDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse login_lockout.max_lockout")
+		return nil, err
+	}
+
+	return &loginLockout{
+		loginAttemptCache:               loginAttemptCache,
+		maxFailedAttemptsPerAccountName: loginLockoutConfig.MaxFailedAttemptsPerAccountName,
+		maxFailedAttemptsPerIP:          loginLockoutConfig.MaxFailedAttemptsPerIP,
+		window:                          window,
+		baseLockout:                     baseLockout,
+		maxLockout:                      maxLockout,
+		logger:                          logger,
+	}, nil
+}
+
+func (l loginLockout) getSubjectList(params LoginLockoutParams) []string {
+	subjectList := []string{loginLockoutSubjectPrefixAccountName + params.AccountName}
+	if params.ClientIP != "" {
+		subjectList = append(subjectList, loginLockoutSubjectPrefixIP+params.ClientIP)
+	}
+
+	return subjectList
+}
+
+func (l loginLockout) getMaxFailedAttempts(subject string) uint64 {
+	if strings.HasPrefix(subject, loginLockoutSubjectPrefixIP) {
+		return l.maxFailedAttemptsPerIP
+	}
+
+	return l.maxFailedAttemptsPerAccountName
+}
+
+// getLockoutDuration doubles the base lockout for every failure past the allowed attempts, up to the max lockout
+func (l loginLockout) getLockoutDuration(failedCount, maxFailedAttempts uint64) time.Duration {
+	lockout := l.baseLockout
+	for i := maxFailedAttempts; i < failedCount && lockout < l.maxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, l.maxLockout)
+}
+
+func (l loginLockout) Check(ctx context.Context, params LoginLockoutParams) error {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	var lockedUntil time.Time
+	for _, subject := range l.getSubjectList(params) {
+		subjectLockedUntil, err := l.loginAttemptCache.GetLockedUntil(ctx, subject)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to get login lockout, will allow the login")
+			continue
+		}
+
+		if subjectLockedUntil.After(lockedUntil) {
+			lockedUntil = subjectLockedUntil
+		}
+	}
+
+	if retryDelay := time.Until(lockedUntil); retryDelay > 0 {
+		return NewResourceExhaustedError("too many failed logins, try again later", retryDelay)
+	}
+
+	return nil
+}
+
+func (l loginLockout) RecordFailure(ctx context.Context, params LoginLockoutParams) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	for _, subject := range l.getSubjectList(params) {
+		failedCount, err := l.loginAttemptCache.IncrementFailedCount(ctx, subject, l.window)
+		if err != nil {
+			logger.With(zap.Error(err)).Warn("failed to record failed login")
+			continue
+		}
+
+		maxFailedAttempts := l.getMaxFailedAttempts(subject)
+		if failedCount < maxFailedAttempts {
+			continue
+		}
+
+		lockout := l.getLockoutDuration(failedCount, maxFailedAttempts)
+		if err = l.loginAttemptCache.SetLockedUntil(ctx, subject, time.Now().Add(lockout)); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to set login lockout")
+			continue
+		}
+
+		logger.With(zap.String("subject", subject)).
+			With(zap.Uint64("failed_count", failedCount)).
+			With(zap.Duration("lockout", lockout)).
+			Warn("login locked out after too many failures")
+	}
+}
+
+func (l loginLockout) RecordSuccess(ctx context.Context, params LoginLockoutParams) {
+	logger := utils.LoggerWithContext(ctx, l.logger)
+
+	if err := l.loginAttemptCache.ResetFailedCount(ctx, loginLockoutSubjectPrefixAccountName+params.AccountName); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to reset failed login count")
+	}
+}
@@ -0,0 +1,476 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	oidcStateByteCount = 32
+	oidcNonceByteCount = 32
+	// oidcAccountPasswordByteCount is the random password given to provisioned accounts, it is never shown so these
+	// accounts can only sign in through the identity provider until they reset their password
+	oidcAccountPasswordByteCount = 32
+
+	oidcAccountNameMinLength = 6
+	oidcAccountNameMaxLength = 32
+	// oidcAccountNameSuffixDigitCount is appended to a taken account name
+	oidcAccountNameSuffixDigitCount = 6
+	oidcAccountNameMaxAttemptCount  = 5
+	oidcAccountNameFallbackPrefix   = "user"
+)
+
+var (
+	errOIDCNotEnabled          = status.Error(codes.FailedPrecondition, "oidc login is not enabled")
+	errInvalidOIDCLoginState   = status.Error(codes.InvalidArgument, "invalid or expired oidc login state")
+	errOIDCLoginFailed         = status.Error(codes.Unauthenticated, "oidc login failed")
+	errOIDCAccountNotLinked    = status.Error(codes.PermissionDenied, "no account is linked to this identity")
+	errOIDCProviderUnavailable = status.Error(codes.Unavailable, "oidc identity provider is unavailable")
+)
+
+type StartOIDCLoginOutput struct {
+	AuthorizationURL string
+	State            string
+}
+
+type FinishOIDCLoginParams struct {
+	State     string
+	Code      string
+	UserAgent string
+}
+
+type FinishOIDCLoginOutput struct {
+	Token        string
+	RefreshToken string
+	Account      *go_load.Account
+}
+
+// oidcIDTokenClaims are the standard claims used to provision accounts
+type oidcIDTokenClaims struct {
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// OIDC signs accounts in through an openid connect identity provider with the authorization code flow and pkce. A
+// subject is mapped to an account by its issuer and subject, the identity provider is trusted for the second factor
+// so accounts with totp are not challenged
+type OIDC interface {
+	StartOIDCLogin(ctx context.Context) (StartOIDCLoginOutput, error)
+	// FinishOIDCLogin exchanges the authorization code and starts a session for the account of the subject
+	FinishOIDCLogin(ctx context.Context, params FinishOIDCLoginParams) (FinishOIDCLoginOutput, error)
+}
+
+type oidcLogin struct {
+	goquDatabase                        *goqu.Database
+	accountDataAccessor                 database.AccountDataAccessor
+	accountPasswordDataAccessor         database.AccountPasswordDataAccessor
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor
+	oidcLoginCache                      cache.OIDCLogin
+	takenAccountNameCache               cache.TakeAccountName
+	hashLogic                           Hash
+	sessionLogic                        Session
+	oidcConfig                          configs.OIDC
+	loginExpiresIn                      time.Duration
+	// provider is discovered on the first login instead of at startup, so an identity provider that is down does
+	// not keep the server from starting
+	providerMutex sync.Mutex
+	provider      *oidc.Provider
+	logger        *zap.Logger
+}
+
+func NewOIDC(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	accountExternalIdentityDataAccessor database.AccountExternalIdentityDataAccessor,
+	oidcLoginCache cache.OIDCLogin,
+	takenAccountNameCache cache.TakeAccountName,
+	hashLogic Hash,
+	sessionLogic Session,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (OIDC, error) {
+	o := &oidcLogin{
+		goquDatabase:                        goquDatabase,
+		accountDataAccessor:                 accountDataAccessor,
+		accountPasswordDataAccessor:         accountPasswordDataAccessor,
+		accountExternalIdentityDataAccessor: accountExternalIdentityDataAccessor,
+		oidcLoginCache:                      oidcLoginCache,
+		takenAccountNameCache:               takenAccountNameCache,
+		hashLogic:                           hashLogic,
+		sessionLogic:                        sessionLogic,
+		oidcConfig:                          authConfig.OIDC,
+		logger:                              logger,
+	}
+
+	if !authConfig.OIDC.Enabled {
+		return o, nil
+	}
+
+	if authConfig.OIDC.IssuerURL == "" || authConfig.OIDC.ClientID == "" || authConfig.OIDC.RedirectURL == "" {
+		logger.Error("oidc.issuer_url, oidc.client_id and oidc.redirect_url are required when oidc is enabled")
+		return nil, errors.New("oidc is not fully configured")
+	}
+
+	loginExpiresIn, err := authConfig.OIDC.GetLoginExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse oidc.login_expires_in")
+		return nil, err
+	}
+
+	o.loginExpiresIn = loginExpiresIn
+	return o, nil
+}
+
+func (o *oidcLogin) getProvider(ctx context.Context) (*oidc.Provider, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	o.providerMutex.Lock()
+	defer o.providerMutex.Unlock()
+
+	if o.provider != nil {
+		return o.provider, nil
+	}
+
+	// The provider keeps this context to fetch rotated signing keys later, so it must outlive the request
+	provider, err := oidc.NewProvider(context.WithoutCancel(ctx), o.oidcConfig.IssuerURL)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to discover oidc identity provider")
+		return nil, errOIDCProviderUnavailable
+	}
+
+	o.provider = provider
+	return provider, nil
+}
+
+func (o *oidcLogin) getOAuth2Config(provider *oidc.Provider) oauth2.Config {
+	return oauth2.Config{
+		ClientID:     o.oidcConfig.ClientID,
+		ClientSecret: o.oidcConfig.ClientSecret,
+		RedirectURL:  o.oidcConfig.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       append([]string{oidc.ScopeOpenID}, o.oidcConfig.ScopeList...),
+	}
+}
+
+func generateOIDCRandomString(byteCount int) (string, error) {
+	randomBytes := make([]byte, byteCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(randomBytes), nil
+}
+
+func (o *oidcLogin) StartOIDCLogin(ctx context.Context) (StartOIDCLoginOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if !o.oidcConfig.Enabled {
+		return StartOIDCLoginOutput{}, errOIDCNotEnabled
+	}
+
+	provider, err := o.getProvider(ctx)
+	if err != nil {
+		return StartOIDCLoginOutput{}, err
+	}
+
+	state, err := generateOIDCRandomString(oidcStateByteCount)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate oidc state")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to generate oidc state")
+	}
+
+	nonce, err := generateOIDCRandomString(oidcNonceByteCount)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate oidc nonce")
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to generate oidc nonce")
+	}
+
+	codeVerifier := oauth2.GenerateVerifier()
+	err = o.oidcLoginCache.Set(ctx, state, cache.OIDCLoginState{
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+	}, o.loginExpiresIn)
+	if err != nil {
+		return StartOIDCLoginOutput{}, status.Error(codes.Internal, "failed to start oidc login")
+	}
+
+	oauth2Config := o.getOAuth2Config(provider)
+	return StartOIDCLoginOutput{
+		AuthorizationURL: oauth2Config.AuthCodeURL(state, oidc.Nonce(nonce), oauth2.S256ChallengeOption(codeVerifier)),
+		State:            state,
+	}, nil
+}
+
+// verifyIDToken exchanges the authorization code and returns the verified id token of the subject
+func (o *oidcLogin) verifyIDToken(
+	ctx context.Context,
+	provider *oidc.Provider,
+	code string,
+	loginState cache.OIDCLoginState,
+) (*oidc.IDToken, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	oauth2Config := o.getOAuth2Config(provider)
+	oauth2Token, err := oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(loginState.CodeVerifier))
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to exchange oidc authorization code")
+		return nil, errOIDCLoginFailed
+	}
+
+	rawIDToken, ok := oauth2Token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		logger.Warn("oidc token response does not have an id token")
+		return nil, errOIDCLoginFailed
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: o.oidcConfig.ClientID}).Verify(ctx, rawIDToken)
+	if err != nil {
+		logger.With(zap.Error(err)).Warn("failed to verify oidc id token")
+		return nil, errOIDCLoginFailed
+	}
+
+	if idToken.Nonce != loginState.Nonce {
+		logger.Warn("oidc id token nonce does not match the login")
+		return nil, errOIDCLoginFailed
+	}
+
+	return idToken, nil
+}
+
+// sanitizeOIDCAccountName turns a claim into a name matching the account name rules, it returns an empty string
+// if nothing usable is left
+func sanitizeOIDCAccountName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return -1
+	}, name)
+
+	if name == "" {
+		return ""
+	}
+
+	if len(name) < oidcAccountNameMinLength {
+		name = oidcAccountNameFallbackPrefix + name
+	}
+
+	return name[:min(len(name), oidcAccountNameMaxLength-oidcAccountNameSuffixDigitCount)]
+}
+
+func generateOIDCAccountNameSuffix() (string, error) {
+	suffix, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", oidcAccountNameSuffixDigitCount, suffix.Int64()), nil
+}
+
+// getAvailableOIDCAccountName prefers the preferred username of the subject, then the local part of its email, and
+// appends random digits while the name is taken
+func (o *oidcLogin) getAvailableOIDCAccountName(ctx context.Context, claims oidcIDTokenClaims) (string, error) {
+	baseName := sanitizeOIDCAccountName(claims.PreferredUsername)
+	if baseName == "" {
+		baseName = sanitizeOIDCAccountName(strings.Split(claims.Email, "@")[0])
+	}
+
+	if baseName == "" {
+		baseName = oidcAccountNameFallbackPrefix
+	}
+
+	accountName := baseName
+	for range oidcAccountNameMaxAttemptCount {
+		if len(accountName) >= oidcAccountNameMinLength {
+			_, err := o.accountDataAccessor.GetAccountByAccountName(ctx, accountName)
+			if errors.Is(err, database.ErrAccountNotFound) {
+				return accountName, nil
+			}
+
+			if err != nil {
+				return "", err
+			}
+		}
+
+		suffix, err := generateOIDCAccountNameSuffix()
+		if err != nil {
+			return "", status.Error(codes.Internal, "failed to generate account name")
+		}
+
+		accountName = baseName + suffix
+	}
+
+	return "", status.Error(codes.Internal, "failed to find an available account name")
+}
+
+// provisionAccount is called for a subject signing in for the first time. Subjects are never linked to an existing
+// account by email, GoLoad does not verify the emails of its accounts so anyone could claim one
+func (o *oidcLogin) provisionAccount(
+	ctx context.Context,
+	idToken *oidc.IDToken,
+	claims oidcIDTokenClaims,
+) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger).
+		With(zap.String("issuer", idToken.Issuer)).
+		With(zap.String("subject", idToken.Subject))
+
+	if !o.oidcConfig.AutoProvision {
+		return 0, errOIDCAccountNotLinked
+	}
+
+	accountName, err := o.getAvailableOIDCAccountName(ctx, claims)
+	if err != nil {
+		return 0, err
+	}
+
+	password, err := generateOIDCRandomString(oidcAccountPasswordByteCount)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate account password")
+		return 0, status.Error(codes.Internal, "failed to generate account password")
+	}
+
+	hashedPassword, err := o.hashLogic.Hash(ctx, password)
+	if err != nil {
+		return 0, err
+	}
+
+	var email string
+	if claims.EmailVerified {
+		email = claims.Email
+	}
+
+	var accountID uint64
+	txErr := o.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountID, err = o.accountDataAccessor.WithDatabase(td).CreateAccount(ctx, database.Account{
+			Name:  accountName,
+			Role:  AccountRoleUser,
+			Email: emailToDatabaseEmail(email),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = o.accountPasswordDataAccessor.WithDatabase(td).CreateAccountPassword(ctx, database.AccountPassword{
+			OfAccountID: accountID,
+			Hash:        hashedPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = o.accountExternalIdentityDataAccessor.WithDatabase(td).CreateAccountExternalIdentity(ctx, database.AccountExternalIdentity{
+			OfAccountID: accountID,
+			Issuer:      idToken.Issuer,
+			Subject:     idToken.Subject,
+			CreatedAt:   time.Now(),
+		})
+		return err
+	})
+	if txErr != nil {
+		return 0, txErr
+	}
+
+	if err = o.takenAccountNameCache.Add(ctx, accountName); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to set account name into taken set in cache")
+	}
+
+	logger.With(zap.Uint64("account_id", accountID)).Info("provisioned account for oidc identity")
+	return accountID, nil
+}
+
+func (o *oidcLogin) FinishOIDCLogin(ctx context.Context, params FinishOIDCLoginParams) (FinishOIDCLoginOutput, error) {
+	logger := utils.LoggerWithContext(ctx, o.logger)
+
+	if !o.oidcConfig.Enabled {
+		return FinishOIDCLoginOutput{}, errOIDCNotEnabled
+	}
+
+	loginState, err := o.oidcLoginCache.Get(ctx, params.State)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return FinishOIDCLoginOutput{}, errInvalidOIDCLoginState
+		}
+		return FinishOIDCLoginOutput{}, status.Error(codes.Internal, "failed to get oidc login state")
+	}
+
+	// The state is used up even if the login fails, the browser has to start over
+	if err = o.oidcLoginCache.Delete(ctx, params.State); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete used oidc login state from cache")
+	}
+
+	provider, err := o.getProvider(ctx)
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	idToken, err := o.verifyIDToken(ctx, provider, params.Code, loginState)
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	var claims oidcIDTokenClaims
+	if err = idToken.Claims(&claims); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to parse oidc id token claims")
+		return FinishOIDCLoginOutput{}, errOIDCLoginFailed
+	}
+
+	var accountID uint64
+	accountExternalIdentity, err := o.accountExternalIdentityDataAccessor.
+		GetAccountExternalIdentityByIssuerAndSubject(ctx, idToken.Issuer, idToken.Subject)
+	switch {
+	case err == nil:
+		accountID = accountExternalIdentity.OfAccountID
+	case errors.Is(err, database.ErrAccountExternalIdentityNotFound):
+		accountID, err = o.provisionAccount(ctx, idToken, claims)
+		if err != nil {
+			return FinishOIDCLoginOutput{}, err
+		}
+	default:
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	account, err := o.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	if account.DisabledAt != nil {
+		return FinishOIDCLoginOutput{}, errAccountDisabled
+	}
+
+	startSessionOutput, err := o.sessionLogic.StartSession(ctx, StartSessionParams{
+		AccountID: accountID,
+		UserAgent: params.UserAgent,
+	})
+	if err != nil {
+		return FinishOIDCLoginOutput{}, err
+	}
+
+	return FinishOIDCLoginOutput{
+		Token:        startSessionOutput.Token,
+		RefreshToken: startSessionOutput.RefreshToken,
+		Account:      databaseAccountToProtoAccount(account),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package logic
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1" //nolint:gosec // sha1 is only used to look passwords up in breach lists which are published as sha1
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	passwordBreachListFormatPlain = "plain"
+	passwordBreachListFormatSHA1  = "sha1"
+)
+
+var (
+	errPasswordBreached = status.Error(codes.InvalidArgument,
+		"password appears in a list of breached passwords, choose another one")
+)
+
+// PasswordPolicy is checked for every new password, passwords that were set before the policy changed keep working
+type PasswordPolicy interface {
+	Validate(ctx context.Context, password string) error
+}
+
+type passwordPolicy struct {
+	minLength int
+	maxLength int
+	// breachedPasswordHashSet holds the sha1 of the breached passwords, so both list formats are looked up the same
+	breachedPasswordHashSet map[[sha1.Size]byte]struct{}
+	logger                  *zap.Logger
+}
+
+func NewPasswordPolicy(
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (PasswordPolicy, error) {
+	policyConfig := authConfig.PasswordPolicy
+	if policyConfig.MinLength <= 0 || policyConfig.MaxLength < policyConfig.MinLength {
+		logger.Error("password_policy.min_length must be positive and not greater than password_policy.max_length")
+		return nil, errors.New("invalid password policy length")
+	}
+
+	isBcrypt := authConfig.Hash.Algorithm == configs.HashAlgorithmBcrypt || authConfig.Hash.Algorithm == ""
+	if isBcrypt && policyConfig.MaxLength > bcryptMaxPasswordByteCount {
+		logger.With(zap.Int("max_length", policyConfig.MaxLength)).
+			Warn("bcrypt rejects passwords over 72 bytes, long passwords allowed by the policy may still be rejected")
+	}
+
+	p := &passwordPolicy{
+		minLength:               policyConfig.MinLength,
+		maxLength:               policyConfig.MaxLength,
+		breachedPasswordHashSet: make(map[[sha1.Size]byte]struct{}),
+		logger:                  logger,
+	}
+
+	if policyConfig.BreachListFile != "" {
+		if err := p.loadBreachList(policyConfig.BreachListFile, policyConfig.BreachListFormat); err != nil {
+			logger.With(zap.Error(err)).Error("failed to load password_policy.breach_list_file")
+			return nil, err
+		}
+
+		logger.With(zap.Int("breached_password_count", len(p.breachedPasswordHashSet))).Info("loaded password breach list")
+	}
+
+	return p, nil
+}
+
+func (p *passwordPolicy) loadBreachList(filePath, format string) error {
+	if format != passwordBreachListFormatPlain && format != passwordBreachListFormatSHA1 {
+		return fmt.Errorf("unsupported breach list format: %s", format)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+
+		if format == passwordBreachListFormatPlain {
+			p.breachedPasswordHashSet[sha1.Sum([]byte(line))] = struct{}{}
+			continue
+		}
+
+		// The count after the colon tells how often the password was seen, every listed password is rejected
+		hashHex, _, _ := strings.Cut(line, ":")
+		var passwordHash [sha1.Size]byte
+		if len(hashHex) != hex.EncodedLen(sha1.Size) {
+			return fmt.Errorf("invalid sha1 hash on line %d of the breach list", lineNumber)
+		}
+
+		if _, decodeErr := hex.Decode(passwordHash[:], []byte(hashHex)); decodeErr != nil {
+			return fmt.Errorf("invalid sha1 hash on line %d of the breach list", lineNumber)
+		}
+
+		p.breachedPasswordHashSet[passwordHash] = struct{}{}
+	}
+
+	return scanner.Err()
+}
+
+func (p *passwordPolicy) Validate(ctx context.Context, password string) error {
+	logger := utils.LoggerWithContext(ctx, p.logger)
+
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength || length > p.maxLength {
+		return status.Error(codes.InvalidArgument,
+			fmt.Sprintf("password must be between %d and %d characters long", p.minLength, p.maxLength))
+	}
+
+	if _, ok := p.breachedPasswordHashSet[sha1.Sum([]byte(password))]; ok {
+		logger.Info("rejected breached password")
+		return errPasswordBreached
+	}
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/notifier"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	passwordResetTokenByteCount    = 32
+	passwordResetURLQueryNameToken = "token"
+	passwordResetMessageSubject    = "Reset your GoLoad password"
+)
+
+var (
+	errInvalidPasswordResetToken = status.Error(codes.InvalidArgument, "invalid or expired password reset token")
+)
+
+type RequestPasswordResetParams struct {
+	AccountName string
+}
+
+type ResetPasswordParams struct {
+	ResetToken  string
+	NewPassword string
+}
+
+type PasswordReset interface {
+	// RequestPasswordReset sends a reset token to the email of the account, it succeeds without sending anything
+	// if the account does not exist or does not have an email so the caller cannot tell accounts apart
+	RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error
+	// ResetPassword uses up the reset token and revokes every session of the account
+	ResetPassword(ctx context.Context, params ResetPasswordParams) error
+}
+
+type passwordReset struct {
+	goquDatabase                   *goqu.Database
+	accountDataAccessor            database.AccountDataAccessor
+	accountPasswordDataAccessor    database.AccountPasswordDataAccessor
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor
+	auditLogDataAccessor           database.AuditLogDataAccessor
+	notifier                       notifier.Notifier
+	hashLogic                      Hash
+	passwordPolicyLogic            PasswordPolicy
+	sessionLogic                   Session
+	expiresIn                      time.Duration
+	url                            string
+	logger                         *zap.Logger
+}
+
+func NewPasswordReset(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	passwordResetTokenDataAccessor database.PasswordResetTokenDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	notifier notifier.Notifier,
+	hashLogic Hash,
+	passwordPolicyLogic PasswordPolicy,
+	sessionLogic Session,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (PasswordReset, error) {
+	expiresIn, err := authConfig.PasswordReset.GetExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse password_reset.expires_in")
+		return nil, err
+	}
+
+	if authConfig.PasswordReset.URL != "" {
+		if _, err = url.Parse(authConfig.PasswordReset.URL); err != nil {
+			logger.With(zap.Error(err)).Error("failed to parse password_reset.url")
+			return nil, err
+		}
+	}
+
+	return &passwordReset{
+		goquDatabase:                   goquDatabase,
+		accountDataAccessor:            accountDataAccessor,
+		accountPasswordDataAccessor:    accountPasswordDataAccessor,
+		passwordResetTokenDataAccessor: passwordResetTokenDataAccessor,
+		auditLogDataAccessor:           auditLogDataAccessor,
+		notifier:                       notifier,
+		hashLogic:                      hashLogic,
+		passwordPolicyLogic:            passwordPolicyLogic,
+		sessionLogic:                   sessionLogic,
+		expiresIn:                      expiresIn,
+		url:                            authConfig.PasswordReset.URL,
+		logger:                         logger,
+	}, nil
+}
+
+// hashPasswordResetToken uses sha256 like refresh tokens, reset tokens are random so they cannot be brute forced
+func hashPasswordResetToken(resetToken string) string {
+	resetTokenHash := sha256.Sum256([]byte(resetToken))
+	return hex.EncodeToString(resetTokenHash[:])
+}
+
+func (p passwordReset) generatePasswordResetToken() (string, string, error) {
+	resetTokenBytes := make([]byte, passwordResetTokenByteCount)
+	if _, err := rand.Read(resetTokenBytes); err != nil {
+		return "", "", err
+	}
+
+	resetToken := base64.RawURLEncoding.EncodeToString(resetTokenBytes)
+	return resetToken, hashPasswordResetToken(resetToken), nil
+}
+
+func (p passwordReset) getPasswordResetMessageBody(accountName, resetToken string, expiresAt time.Time) string {
+	resetInstruction := "Use this token to reset your password: " + resetToken
+	if p.url != "" {
+		// The url was validated when the logic was created
+		resetURL, _ := url.Parse(p.url)
+		query := resetURL.Query()
+		query.Set(passwordResetURLQueryNameToken, resetToken)
+		resetURL.RawQuery = query.Encode()
+		resetInstruction = "Open this link to reset your password: " + resetURL.String()
+	}
+
+	return fmt.Sprintf(
+		"Hello %s,\n\nA password reset was requested for your account.\n%s\n\n"+
+			"The token expires at %s and can only be used once. If you did not request it, you can ignore this message.\n",
+		accountName, resetInstruction, expiresAt.UTC().Format(time.RFC1123))
+}
+
+func (p passwordReset) RequestPasswordReset(ctx context.Context, params RequestPasswordResetParams) error {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.String("account_name", params.AccountName))
+
+	account, err := p.accountDataAccessor.GetAccountByAccountName(ctx, params.AccountName)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	if account.Email == nil || account.DisabledAt != nil {
+		logger.Info("account cannot reset its password, no reset token is sent")
+		return nil
+	}
+
+	resetToken, resetTokenHash, err := p.generatePasswordResetToken()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate password reset token")
+		return status.Error(codes.Internal, "failed to generate password reset token")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(p.expiresIn)
+	_, err = p.passwordResetTokenDataAccessor.CreatePasswordResetToken(ctx, database.PasswordResetToken{
+		OfAccountID: account.ID,
+		TokenHash:   resetTokenHash,
+		CreatedAt:   now,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The mail is sent in the background and a failure is only logged, waiting for it or returning its error would
+	// tell the caller that the account exists and has an email. The notifier enforces its own timeout
+	message := notifier.Message{
+		To:      *account.Email,
+		Subject: passwordResetMessageSubject,
+		Body:    p.getPasswordResetMessageBody(account.Name, resetToken, expiresAt),
+	}
+	go func() {
+		if err := p.notifier.Send(context.WithoutCancel(ctx), message); err != nil {
+			logger.With(zap.Error(err)).Error("failed to send password reset token")
+		}
+	}()
+
+	return nil
+}
+
+func (p passwordReset) ResetPassword(ctx context.Context, params ResetPasswordParams) error {
+	if params.ResetToken == "" {
+		return errInvalidPasswordResetToken
+	}
+
+	if err := p.passwordPolicyLogic.Validate(ctx, params.NewPassword); err != nil {
+		return err
+	}
+
+	var accountID uint64
+	txErr := p.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		passwordResetToken, getTokenErr := p.passwordResetTokenDataAccessor.
+			WithDatabase(td).
+			GetPasswordResetTokenByTokenHashWithXLock(ctx, hashPasswordResetToken(params.ResetToken))
+		if getTokenErr != nil {
+			if errors.Is(getTokenErr, database.ErrPasswordResetTokenNotFound) {
+				return errInvalidPasswordResetToken
+			}
+			return getTokenErr
+		}
+
+		now := time.Now()
+		if passwordResetToken.UsedAt != nil || !passwordResetToken.ExpiresAt.After(now) {
+			return errInvalidPasswordResetToken
+		}
+
+		// Hashed once the token is checked, so invalid tokens do not cost a password hash
+		hashedPassword, err := p.hashLogic.Hash(ctx, params.NewPassword)
+		if err != nil {
+			return err
+		}
+
+		accountID = passwordResetToken.OfAccountID
+		passwordResetToken.UsedAt = &now
+		if err = p.passwordResetTokenDataAccessor.WithDatabase(td).UpdatePasswordResetToken(ctx, passwordResetToken); err != nil {
+			return err
+		}
+
+		err = p.accountPasswordDataAccessor.WithDatabase(td).UpdateAccountPassword(ctx, database.AccountPassword{
+			OfAccountID: accountID,
+			Hash:        hashedPassword,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The request is not authenticated, the reset token proves it is made by the account
+		return createAuditLog(ctx, p.auditLogDataAccessor.WithDatabase(td), Principal{AccountID: accountID}, auditLogEntry{
+			Action:     auditLogActionResetPassword,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   accountID,
+		})
+	})
+	if txErr != nil {
+		return txErr
+	}
+
+	return p.sessionLogic.RevokeAccountSessions(ctx, RevokeAccountSessionsParams{
+		AccountID: accountID,
+	})
+}
@@ -0,0 +1,222 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PostProcessorStatusSucceeded = "succeeded"
+	PostProcessorStatusFailed    = "failed"
+	PostProcessorStatusSkipped   = "skipped"
+)
+
+var (
+	// ErrPostProcessorSkipped is returned by a post processor that does not apply to the downloaded file
+	ErrPostProcessorSkipped = errors.New("post processor skipped")
+)
+
+type PostProcessorInput struct {
+	DownloadTaskID uint64
+	FileName       string
+	// Metadata is the metadata of the download task, post processors must not modify it
+	Metadata map[string]any
+}
+
+type PostProcessorOutput struct {
+	// Data is recorded as the output of the post processor in the download task metadata
+	Data map[string]any
+	// Quarantine blocks access to the downloaded file and stops the following post processors
+	Quarantine bool
+}
+
+type PostProcessor interface {
+	Name() string
+	Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error)
+}
+
+type PostProcessorResult struct {
+	Name   string         `json:"name"`
+	Status string         `json:"status"`
+	Error  string         `json:"error,omitempty"`
+	Output map[string]any `json:"output,omitempty"`
+}
+
+func (p PostProcessorResult) ToProto() *go_load.PostProcessorResult {
+	protoResult := &go_load.PostProcessorResult{
+		Name:  p.Name,
+		Error: p.Error,
+	}
+
+	switch p.Status {
+	case PostProcessorStatusSucceeded:
+		protoResult.Status = go_load.PostProcessorStatus_POST_PROCESSOR_STATUS_SUCCEEDED
+	case PostProcessorStatusFailed:
+		protoResult.Status = go_load.PostProcessorStatus_POST_PROCESSOR_STATUS_FAILED
+	case PostProcessorStatusSkipped:
+		protoResult.Status = go_load.PostProcessorStatus_POST_PROCESSOR_STATUS_SKIPPED
+	}
+
+	if output, err := mapToProtoStruct(p.Output); err == nil {
+		protoResult.Output = output
+	}
+
+	return protoResult
+}
+
+type PostProcessingPipelineOutput struct {
+	ResultList  []PostProcessorResult
+	Quarantined bool
+}
+
+// PostProcessorCatalog knows the post processors by name only, so the api server can validate the post processors
+// of accounts and download tasks without building them
+type PostProcessorCatalog interface {
+	ValidatePostProcessorNameList(postProcessorNameList []string) error
+	// WithMandatoryPostProcessorNameList returns the list with the post processors users cannot opt out of put first,
+	// so the downloaded file is scanned before it is extracted or sent anywhere
+	WithMandatoryPostProcessorNameList(postProcessorNameList []string) []string
+}
+
+type postProcessorCatalog struct {
+	postProcessorNameList          []string
+	mandatoryPostProcessorNameList []string
+}
+
+// NewPostProcessorCatalog lists the name of every post processor of NewPostProcessingPipeline. Malware scanning is
+// mandatory once download.post_processing.malware_scanning is configured
+func NewPostProcessorCatalog(downloadConfig configs.Download) PostProcessorCatalog {
+	mandatoryPostProcessorNameList := make([]string, 0, 1)
+	if downloadConfig.PostProcessing.MalwareScanning.Address != "" {
+		mandatoryPostProcessorNameList = append(mandatoryPostProcessorNameList, PostProcessorNameMalwareScanning)
+	}
+
+	return &postProcessorCatalog{
+		postProcessorNameList: []string{
+			PostProcessorNameMalwareScanning,
+			PostProcessorNameArchiveExtraction,
+			PostProcessorNameMIMESniffing,
+			PostProcessorNameThumbnail,
+			PostProcessorNameWebhook,
+		},
+		mandatoryPostProcessorNameList: mandatoryPostProcessorNameList,
+	}
+}
+
+func (p postProcessorCatalog) ValidatePostProcessorNameList(postProcessorNameList []string) error {
+	for _, postProcessorName := range postProcessorNameList {
+		if !lo.Contains(p.postProcessorNameList, postProcessorName) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("unsupported post processor: %s", postProcessorName))
+		}
+	}
+
+	return nil
+}
+
+func (p postProcessorCatalog) WithMandatoryPostProcessorNameList(postProcessorNameList []string) []string {
+	optionalPostProcessorNameList := lo.Without(postProcessorNameList, p.mandatoryPostProcessorNameList...)
+	return append(append([]string{}, p.mandatoryPostProcessorNameList...), optionalPostProcessorNameList...)
+}
+
+type PostProcessingPipeline interface {
+	// Run runs the post processors in order, a failed post processor does not stop the following ones
+	Run(ctx context.Context, postProcessorNameList []string, input PostProcessorInput) PostProcessingPipelineOutput
+}
+
+type postProcessingPipeline struct {
+	postProcessorMap map[string]PostProcessor
+	logger           *zap.Logger
+}
+
+func newPostProcessingPipeline(
+	postProcessorList []PostProcessor,
+	logger *zap.Logger,
+) PostProcessingPipeline {
+	postProcessorMap := make(map[string]PostProcessor, len(postProcessorList))
+	for _, postProcessor := range postProcessorList {
+		postProcessorMap[postProcessor.Name()] = postProcessor
+	}
+
+	return &postProcessingPipeline{
+		postProcessorMap: postProcessorMap,
+		logger:           logger,
+	}
+}
+
+// NewPostProcessingPipeline lists every available post processor, a new post processor has to be added here and to
+// NewPostProcessorCatalog. Only the download worker builds it
+func NewPostProcessingPipeline(
+	archiveExtractionPostProcessor ArchiveExtractionPostProcessor,
+	mimeSniffingPostProcessor MIMESniffingPostProcessor,
+	webhookPostProcessor WebhookPostProcessor,
+	malwareScanningPostProcessor MalwareScanningPostProcessor,
+	thumbnailPostProcessor ThumbnailPostProcessor,
+	logger *zap.Logger,
+) PostProcessingPipeline {
+	return newPostProcessingPipeline([]PostProcessor{
+		malwareScanningPostProcessor,
+		archiveExtractionPostProcessor,
+		mimeSniffingPostProcessor,
+		thumbnailPostProcessor,
+		webhookPostProcessor,
+	}, logger)
+}
+
+func (p postProcessingPipeline) Run(
+	ctx context.Context,
+	postProcessorNameList []string,
+	input PostProcessorInput,
+) PostProcessingPipelineOutput {
+	logger := utils.LoggerWithContext(ctx, p.logger).With(zap.Uint64("download_task_id", input.DownloadTaskID))
+
+	resultList := make([]PostProcessorResult, 0, len(postProcessorNameList))
+	for _, postProcessorName := range postProcessorNameList {
+		result := PostProcessorResult{
+			Name: postProcessorName,
+		}
+
+		postProcessor, ok := p.postProcessorMap[postProcessorName]
+		if !ok {
+			result.Status = PostProcessorStatusFailed
+			result.Error = "unsupported post processor"
+			resultList = append(resultList, result)
+			continue
+		}
+
+		output, err := postProcessor.Process(ctx, input)
+		switch {
+		case err == nil:
+			result.Status = PostProcessorStatusSucceeded
+		case errors.Is(err, ErrPostProcessorSkipped):
+			result.Status = PostProcessorStatusSkipped
+		default:
+			logger.With(zap.String("post_processor_name", postProcessorName)).With(zap.Error(err)).Warn("post processor failed")
+			result.Status = PostProcessorStatusFailed
+			result.Error = err.Error()
+		}
+
+		result.Output = output.Data
+		resultList = append(resultList, result)
+
+		if output.Quarantine {
+			logger.With(zap.String("post_processor_name", postProcessorName)).Warn("downloaded file is quarantined")
+			return PostProcessingPipelineOutput{
+				ResultList:  resultList,
+				Quarantined: true,
+			}
+		}
+	}
+
+	return PostProcessingPipelineOutput{
+		ResultList: resultList,
+	}
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+const (
+	PostProcessorNameArchiveExtraction = "archive_extraction"
+
+	postProcessorOutputFieldNameExtractedFilePrefix = "extracted-file-prefix"
+	postProcessorOutputFieldNameExtractedFileList   = "extracted-file-list"
+)
+
+type ArchiveExtractionPostProcessor interface {
+	PostProcessor
+}
+
+type archiveExtractionPostProcessor struct {
+	archiveExtractorLogic ArchiveExtractor
+	logger                *zap.Logger
+}
+
+func NewArchiveExtractionPostProcessor(
+	archiveExtractorLogic ArchiveExtractor,
+	logger *zap.Logger,
+) ArchiveExtractionPostProcessor {
+	return &archiveExtractionPostProcessor{
+		archiveExtractorLogic: archiveExtractorLogic,
+		logger:                logger,
+	}
+}
+
+func (a archiveExtractionPostProcessor) Name() string {
+	return PostProcessorNameArchiveExtraction
+}
+
+func (a archiveExtractionPostProcessor) Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error) {
+	extractedFilePrefix := input.FileName + "_extracted"
+	extractedFileList, err := a.archiveExtractorLogic.Extract(ctx, input.FileName, extractedFilePrefix)
+	if err != nil {
+		if errors.Is(err, ErrArchiveUnsupportedFormat) {
+			return PostProcessorOutput{}, ErrPostProcessorSkipped
+		}
+		return PostProcessorOutput{}, err
+	}
+
+	return PostProcessorOutput{
+		Data: map[string]any{
+			postProcessorOutputFieldNameExtractedFilePrefix: extractedFilePrefix,
+			postProcessorOutputFieldNameExtractedFileList:   extractedFileList,
+		},
+	}, nil
+}
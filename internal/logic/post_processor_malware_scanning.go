@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/clamd"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"go.uber.org/zap"
+)
+
+const (
+	PostProcessorNameMalwareScanning = "malware_scanning"
+
+	postProcessorOutputFieldNameInfected  = "infected"
+	postProcessorOutputFieldNameSignature = "signature"
+)
+
+type MalwareScanningPostProcessor interface {
+	PostProcessor
+}
+
+type malwareScanningPostProcessor struct {
+	clamdClient           clamd.Client
+	fileClient            file.Client
+	quarantineOnScanError bool
+	logger                *zap.Logger
+}
+
+func NewMalwareScanningPostProcessor(
+	clamdClient clamd.Client,
+	fileClient file.Client,
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) MalwareScanningPostProcessor {
+	return &malwareScanningPostProcessor{
+		clamdClient:           clamdClient,
+		fileClient:            fileClient,
+		quarantineOnScanError: downloadConfig.PostProcessing.MalwareScanning.QuarantineOnScanError,
+		logger:                logger,
+	}
+}
+
+func (m malwareScanningPostProcessor) Name() string {
+	return PostProcessorNameMalwareScanning
+}
+
+func (m malwareScanningPostProcessor) Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error) {
+	reader, err := m.fileClient.Reader(ctx, input.FileName)
+	if err != nil {
+		return PostProcessorOutput{Quarantine: m.quarantineOnScanError}, err
+	}
+	defer reader.Close()
+
+	scanResult, err := m.clamdClient.ScanStream(ctx, reader)
+	if err != nil {
+		if errors.Is(err, clamd.ErrNotConfigured) {
+			return PostProcessorOutput{}, ErrPostProcessorSkipped
+		}
+
+		return PostProcessorOutput{Quarantine: m.quarantineOnScanError}, err
+	}
+
+	output := PostProcessorOutput{
+		Data: map[string]any{
+			postProcessorOutputFieldNameInfected: scanResult.Infected,
+		},
+		Quarantine: scanResult.Infected,
+	}
+
+	if scanResult.Infected {
+		output.Data[postProcessorOutputFieldNameSignature] = scanResult.Signature
+	}
+
+	return output, nil
+}
@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"go.uber.org/zap"
+)
+
+const (
+	PostProcessorNameMIMESniffing = "mime_sniffing"
+
+	postProcessorOutputFieldNameMIMEType = "mime-type"
+
+	// http.DetectContentType considers at most the first 512 bytes
+	mimeSniffingByteCount = 512
+)
+
+type MIMESniffingPostProcessor interface {
+	PostProcessor
+}
+
+type mimeSniffingPostProcessor struct {
+	fileClient file.Client
+	logger     *zap.Logger
+}
+
+func NewMIMESniffingPostProcessor(
+	fileClient file.Client,
+	logger *zap.Logger,
+) MIMESniffingPostProcessor {
+	return &mimeSniffingPostProcessor{
+		fileClient: fileClient,
+		logger:     logger,
+	}
+}
+
+func (m mimeSniffingPostProcessor) Name() string {
+	return PostProcessorNameMIMESniffing
+}
+
+func (m mimeSniffingPostProcessor) Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error) {
+	reader, err := m.fileClient.Reader(ctx, input.FileName)
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+	defer reader.Close()
+
+	dataBuffer := make([]byte, mimeSniffingByteCount)
+	readByteCount, err := io.ReadFull(reader, dataBuffer)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return PostProcessorOutput{}, err
+	}
+
+	return PostProcessorOutput{
+		Data: map[string]any{
+			postProcessorOutputFieldNameMIMEType: http.DetectContentType(dataBuffer[:readByteCount]),
+		},
+	}, nil
+}
@@ -0,0 +1,145 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"  // Registers the gif decoder for image.Decode
+	_ "image/jpeg" // Registers the jpeg decoder for image.Decode
+	"image/png"
+	"path"
+
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
+	"go.uber.org/zap"
+)
+
+const (
+	PostProcessorNameThumbnail = "thumbnail"
+
+	postProcessorOutputFieldNameThumbnailFilePath = "thumbnail-file-path"
+	postProcessorOutputFieldNameThumbnailWidth    = "thumbnail-width"
+	postProcessorOutputFieldNameThumbnailHeight   = "thumbnail-height"
+
+	thumbnailFileName = "thumbnail.png"
+	// thumbnailMaxSize is the maximum width and height of thumbnails, the aspect ratio of the image is kept
+	thumbnailMaxSize = 256
+	// thumbnailMaxSourcePixelCount keeps images that would take too much memory once decoded from being decoded
+	thumbnailMaxSourcePixelCount = 25_000_000
+)
+
+var (
+	errThumbnailSourceTooLarge = errors.New("image is too large to make a thumbnail")
+)
+
+type ThumbnailPostProcessor interface {
+	PostProcessor
+}
+
+type thumbnailPostProcessor struct {
+	fileClient file.Client
+	logger     *zap.Logger
+}
+
+func NewThumbnailPostProcessor(
+	fileClient file.Client,
+	logger *zap.Logger,
+) ThumbnailPostProcessor {
+	return &thumbnailPostProcessor{
+		fileClient: fileClient,
+		logger:     logger,
+	}
+}
+
+func (t thumbnailPostProcessor) Name() string {
+	return PostProcessorNameThumbnail
+}
+
+// decodeImage checks the dimensions of the image before decoding it, it returns ErrPostProcessorSkipped if the file
+// is not a gif, jpeg or png image
+func (t thumbnailPostProcessor) decodeImage(ctx context.Context, fileName string) (image.Image, error) {
+	configReader, err := t.fileClient.Reader(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer configReader.Close()
+
+	imageConfig, _, err := image.DecodeConfig(configReader)
+	if err != nil {
+		if errors.Is(err, image.ErrFormat) {
+			return nil, ErrPostProcessorSkipped
+		}
+		return nil, err
+	}
+
+	if imageConfig.Width*imageConfig.Height > thumbnailMaxSourcePixelCount {
+		return nil, errThumbnailSourceTooLarge
+	}
+
+	reader, err := t.fileClient.Reader(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	sourceImage, _, err := image.Decode(reader)
+	return sourceImage, err
+}
+
+// scaleImage uses nearest neighbor sampling, which is good enough for previews and only reads the sampled pixels
+func (t thumbnailPostProcessor) scaleImage(sourceImage image.Image) image.Image {
+	sourceBounds := sourceImage.Bounds()
+	sourceWidth, sourceHeight := sourceBounds.Dx(), sourceBounds.Dy()
+
+	width, height := sourceWidth, sourceHeight
+	if width > thumbnailMaxSize || height > thumbnailMaxSize {
+		if width >= height {
+			width, height = thumbnailMaxSize, max(1, sourceHeight*thumbnailMaxSize/sourceWidth)
+		} else {
+			width, height = max(1, sourceWidth*thumbnailMaxSize/sourceHeight), thumbnailMaxSize
+		}
+	}
+
+	thumbnailImage := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		sourceY := sourceBounds.Min.Y + y*sourceHeight/height
+		for x := 0; x < width; x++ {
+			thumbnailImage.Set(x, y, sourceImage.At(sourceBounds.Min.X+x*sourceWidth/width, sourceY))
+		}
+	}
+
+	return thumbnailImage
+}
+
+func (t thumbnailPostProcessor) Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error) {
+	sourceImage, err := t.decodeImage(ctx, input.FileName)
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+
+	thumbnailImage := t.scaleImage(sourceImage)
+
+	// Thumbnails are stored next to the downloaded file, so every download task has its own
+	thumbnailFilePath := path.Join(input.FileName+"_thumbnail", thumbnailFileName)
+	writer, err := t.fileClient.Writer(ctx, thumbnailFilePath)
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+
+	if err = png.Encode(writer, thumbnailImage); err != nil {
+		writer.Close()
+		return PostProcessorOutput{}, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		return PostProcessorOutput{}, err
+	}
+
+	return PostProcessorOutput{
+		Data: map[string]any{
+			postProcessorOutputFieldNameThumbnailFilePath: thumbnailFilePath,
+			postProcessorOutputFieldNameThumbnailWidth:    thumbnailImage.Bounds().Dx(),
+			postProcessorOutputFieldNameThumbnailHeight:   thumbnailImage.Bounds().Dy(),
+		},
+	}, nil
+}
@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"go.uber.org/zap"
+)
+
+const (
+	PostProcessorNameWebhook = "webhook"
+
+	postProcessorOutputFieldNameStatusCode = "status-code"
+)
+
+type webhookRequestBody struct {
+	DownloadTaskID uint64         `json:"download_task_id"`
+	Metadata       map[string]any `json:"metadata"`
+}
+
+type WebhookPostProcessor interface {
+	PostProcessor
+}
+
+type webhookPostProcessor struct {
+	url        string
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+func NewWebhookPostProcessor(
+	downloadConfig configs.Download,
+	logger *zap.Logger,
+) (WebhookPostProcessor, error) {
+	var timeout time.Duration
+	if downloadConfig.PostProcessing.Webhook.Timeout != "" {
+		var err error
+		timeout, err = downloadConfig.PostProcessing.Webhook.GetTimeoutDuration()
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to parse post_processing.webhook.timeout")
+			return nil, err
+		}
+	}
+
+	return &webhookPostProcessor{
+		url:        downloadConfig.PostProcessing.Webhook.URL,
+		httpClient: &http.Client{Timeout: timeout},
+		logger:     logger,
+	}, nil
+}
+
+func (w webhookPostProcessor) Name() string {
+	return PostProcessorNameWebhook
+}
+
+func (w webhookPostProcessor) Process(ctx context.Context, input PostProcessorInput) (PostProcessorOutput, error) {
+	if w.url == "" {
+		return PostProcessorOutput{}, ErrPostProcessorSkipped
+	}
+
+	requestBody, err := json.Marshal(webhookRequestBody{
+		DownloadTaskID: input.DownloadTaskID,
+		Metadata:       input.Metadata,
+	})
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(requestBody))
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+	request.Header.Set(HTTPResponseHeaderContentType, "application/json")
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return PostProcessorOutput{}, err
+	}
+	defer response.Body.Close()
+
+	output := PostProcessorOutput{
+		Data: map[string]any{
+			postProcessorOutputFieldNameStatusCode: response.StatusCode,
+		},
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return output, fmt.Errorf("webhook responded with status code %d", response.StatusCode)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+)
+
+type principalContextKey struct{}
+
+// Principal is the caller of a request, resolved once from its session token or api key
+type Principal struct {
+	AccountID uint64
+	// SessionID is only set for principals authenticated with a session token
+	SessionID uint64
+	// APIKeyID is only set for principals authenticated with an api key
+	APIKeyID        uint64
+	APIKeyScopeList []APIKeyScope
+	// Admin is whether the account has the admin role
+	Admin bool
+}
+
+func (p Principal) IsAnonymous() bool {
+	return p.AccountID == 0
+}
+
+func (p Principal) IsSession() bool {
+	return p.SessionID != 0
+}
+
+// HasScope is always true for session principals, only api keys are restricted to their scope list
+func (p Principal) HasScope(scope APIKeyScope) bool {
+	if p.IsSession() {
+		return true
+	}
+
+	return lo.Contains(p.APIKeyScopeList, scope)
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns an anonymous principal if the request was not authenticated
+func PrincipalFromContext(ctx context.Context) Principal {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	if !ok {
+		return Principal{}
+	}
+
+	return principal
+}
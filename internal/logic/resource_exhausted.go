@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"math"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// NewResourceExhaustedError carries the retry delay as a RetryInfo detail, the http gateway also sends it as
+// the Retry-After header
+func NewResourceExhaustedError(message string, retryDelay time.Duration) error {
+	resourceExhaustedStatus := status.New(codes.ResourceExhausted, message)
+
+	// Rounded up to whole seconds so clients following the delay are not rejected again
+	retryDelay = time.Duration(math.Ceil(retryDelay.Seconds())) * time.Second
+	statusWithDetails, err := resourceExhaustedStatus.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	})
+	if err != nil {
+		return resourceExhaustedStatus.Err()
+	}
+
+	return statusWithDetails.Err()
+}
@@ -0,0 +1,366 @@
+package logic
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	refreshTokenByteCount = 32
+	maxUserAgentLength    = 512
+)
+
+var (
+	errInvalidRefreshToken = status.Error(codes.Unauthenticated, "invalid refresh token")
+)
+
+type StartSessionParams struct {
+	AccountID uint64
+	UserAgent string
+}
+
+type StartSessionOutput struct {
+	Token        string
+	RefreshToken string
+	Session      *go_load.Session
+}
+
+type RefreshSessionParams struct {
+	RefreshToken string
+	UserAgent    string
+}
+
+type RefreshSessionOutput struct {
+	Token string
+	// RefreshToken is empty when the previous refresh token was used during its grace period, the client should
+	// keep the refresh token it got from the request that rotated it
+	RefreshToken string
+	Session      *go_load.Session
+}
+
+type DeleteSessionParams struct {
+	Principal Principal
+}
+
+type ListSessionsParams struct {
+	Principal Principal
+}
+
+type ListSessionsOutput struct {
+	SessionList []*go_load.Session
+}
+
+type RevokeSessionParams struct {
+	Principal Principal
+	SessionID uint64
+}
+
+type RevokeAccountSessionsParams struct {
+	AccountID uint64
+	// ExceptSessionID is kept active, it is 0 to revoke every session of the account
+	ExceptSessionID uint64
+}
+
+type Session interface {
+	// StartSession creates a session for an account that is already authenticated
+	StartSession(ctx context.Context, params StartSessionParams) (StartSessionOutput, error)
+	// RefreshSession rotates the refresh token and extends the session, the previous refresh token only keeps giving
+	// new tokens during auth.refresh_token.reuse_grace_period and cannot be used after that
+	RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error)
+	DeleteSession(ctx context.Context, params DeleteSessionParams) error
+	ListSessions(ctx context.Context, params ListSessionsParams) (ListSessionsOutput, error)
+	RevokeSession(ctx context.Context, params RevokeSessionParams) error
+	// RevokeAccountSessions is used when the credentials of the account change
+	RevokeAccountSessions(ctx context.Context, params RevokeAccountSessionsParams) error
+}
+
+type session struct {
+	goquDatabase                 *goqu.Database
+	accountDataAccessor          database.AccountDataAccessor
+	sessionDataAccessor          database.SessionDataAccessor
+	sessionRevocationCache       cache.SessionRevocation
+	tokenLogic                   Token
+	refreshTokenExpiresIn        time.Duration
+	refreshTokenReuseGracePeriod time.Duration
+	logger                       *zap.Logger
+}
+
+func NewSession(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	sessionDataAccessor database.SessionDataAccessor,
+	sessionRevocationCache cache.SessionRevocation,
+	tokenLogic Token,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (Session, error) {
+	refreshTokenExpiresIn, err := authConfig.RefreshToken.GetExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse refresh_token.expires_in")
+		return nil, err
+	}
+
+	refreshTokenReuseGracePeriod, err := authConfig.RefreshToken.GetReuseGracePeriodDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse refresh_token.reuse_grace_period")
+		return nil, err
+	}
+
+	return &session{
+		goquDatabase:                 goquDatabase,
+		accountDataAccessor:          accountDataAccessor,
+		sessionDataAccessor:          sessionDataAccessor,
+		sessionRevocationCache:       sessionRevocationCache,
+		tokenLogic:                   tokenLogic,
+		refreshTokenExpiresIn:        refreshTokenExpiresIn,
+		refreshTokenReuseGracePeriod: refreshTokenReuseGracePeriod,
+		logger:                       logger,
+	}, nil
+}
+
+func (s session) databaseSessionToProtoSession(session database.Session, currentSessionID uint64) *go_load.Session {
+	return &go_load.Session{
+		Id:              session.ID,
+		UserAgent:       session.UserAgent,
+		CreatedAt:       timestamppb.New(session.CreatedAt),
+		LastRefreshedAt: timestamppb.New(session.LastRefreshedAt),
+		ExpiresAt:       timestamppb.New(session.ExpiresAt),
+		Current:         session.ID == currentSessionID,
+	}
+}
+
+// generateRefreshToken returns a random refresh token and its hash, only the hash is stored
+func (s session) generateRefreshToken() (string, string, error) {
+	refreshTokenBytes := make([]byte, refreshTokenByteCount)
+	if _, err := rand.Read(refreshTokenBytes); err != nil {
+		return "", "", err
+	}
+
+	refreshToken := base64.RawURLEncoding.EncodeToString(refreshTokenBytes)
+	return refreshToken, s.hashRefreshToken(refreshToken), nil
+}
+
+// hashRefreshToken uses sha256 instead of the password hash, refresh tokens are random so they cannot be brute forced
+func (s session) hashRefreshToken(refreshToken string) string {
+	refreshTokenHash := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(refreshTokenHash[:])
+}
+
+func (s session) truncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+
+	return userAgent
+}
+
+func (s session) StartSession(ctx context.Context, params StartSessionParams) (StartSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("account_id", params.AccountID))
+
+	refreshToken, refreshTokenHash, err := s.generateRefreshToken()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate refresh token")
+		return StartSessionOutput{}, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+
+	now := time.Now()
+	session := database.Session{
+		OfAccountID:      params.AccountID,
+		RefreshTokenHash: refreshTokenHash,
+		UserAgent:        s.truncateUserAgent(params.UserAgent),
+		CreatedAt:        now,
+		LastRefreshedAt:  now,
+		ExpiresAt:        now.Add(s.refreshTokenExpiresIn),
+	}
+
+	session.ID, err = s.sessionDataAccessor.CreateSession(ctx, session)
+	if err != nil {
+		return StartSessionOutput{}, err
+	}
+
+	token, _, err := s.tokenLogic.GetToken(ctx, params.AccountID, session.ID)
+	if err != nil {
+		return StartSessionOutput{}, err
+	}
+
+	return StartSessionOutput{
+		Token:        token,
+		RefreshToken: refreshToken,
+		Session:      s.databaseSessionToProtoSession(session, session.ID),
+	}, nil
+}
+
+func (s session) RefreshSession(ctx context.Context, params RefreshSessionParams) (RefreshSessionOutput, error) {
+	logger := utils.LoggerWithContext(ctx, s.logger)
+
+	if params.RefreshToken == "" {
+		return RefreshSessionOutput{}, errInvalidRefreshToken
+	}
+
+	newRefreshToken, newRefreshTokenHash, err := s.generateRefreshToken()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate refresh token")
+		return RefreshSessionOutput{}, status.Error(codes.Internal, "failed to generate refresh token")
+	}
+
+	refreshTokenHash := s.hashRefreshToken(params.RefreshToken)
+	var session database.Session
+	isPreviousRefreshToken := false
+	txErr := s.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		var getSessionErr error
+		session, getSessionErr = s.sessionDataAccessor.
+			WithDatabase(td).
+			GetSessionByRefreshTokenHashWithXLock(ctx, refreshTokenHash)
+		if getSessionErr != nil {
+			if errors.Is(getSessionErr, database.ErrSessionNotFound) {
+				return errInvalidRefreshToken
+			}
+			return getSessionErr
+		}
+
+		now := time.Now()
+		if session.RevokedAt != nil || !session.ExpiresAt.After(now) {
+			return errInvalidRefreshToken
+		}
+
+		// Requests sent at the same time with the same refresh token all wait for the first one to rotate it, the
+		// others get a new token without rotating the refresh token again
+		isPreviousRefreshToken = session.RefreshTokenHash != refreshTokenHash
+		if isPreviousRefreshToken && !session.LastRefreshedAt.Add(s.refreshTokenReuseGracePeriod).After(now) {
+			return errInvalidRefreshToken
+		}
+
+		account, getAccountErr := s.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, session.OfAccountID)
+		if getAccountErr != nil {
+			return getAccountErr
+		}
+
+		if account.DisabledAt != nil {
+			return errAccountDisabled
+		}
+
+		if isPreviousRefreshToken {
+			return nil
+		}
+
+		session.PreviousRefreshTokenHash = lo.ToPtr(session.RefreshTokenHash)
+		session.RefreshTokenHash = newRefreshTokenHash
+		session.LastRefreshedAt = now
+		session.ExpiresAt = now.Add(s.refreshTokenExpiresIn)
+		if params.UserAgent != "" {
+			session.UserAgent = s.truncateUserAgent(params.UserAgent)
+		}
+
+		return s.sessionDataAccessor.WithDatabase(td).UpdateSession(ctx, session)
+	})
+	if txErr != nil {
+		return RefreshSessionOutput{}, txErr
+	}
+
+	token, _, err := s.tokenLogic.GetToken(ctx, session.OfAccountID, session.ID)
+	if err != nil {
+		return RefreshSessionOutput{}, err
+	}
+
+	if isPreviousRefreshToken {
+		newRefreshToken = ""
+	}
+
+	return RefreshSessionOutput{
+		Token:        token,
+		RefreshToken: newRefreshToken,
+		Session:      s.databaseSessionToProtoSession(session, session.ID),
+	}, nil
+}
+
+func (s session) revokeSession(ctx context.Context, session database.Session) error {
+	logger := utils.LoggerWithContext(ctx, s.logger).With(zap.Uint64("session_id", session.ID))
+
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	now := time.Now()
+	session.RevokedAt = &now
+	if err := s.sessionDataAccessor.UpdateSession(ctx, session); err != nil {
+		return err
+	}
+
+	// Tokens of the session are checked against the cache first, so it must not keep the session as active
+	if err := s.sessionRevocationCache.Set(ctx, session.ID, true); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to set session revocation into cache")
+	}
+
+	return nil
+}
+
+func (s session) DeleteSession(ctx context.Context, params DeleteSessionParams) error {
+	session, err := s.sessionDataAccessor.GetSession(ctx, params.Principal.SessionID)
+	if err != nil {
+		return err
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+func (s session) ListSessions(ctx context.Context, params ListSessionsParams) (ListSessionsOutput, error) {
+	sessionList, err := s.sessionDataAccessor.GetActiveSessionListByAccount(ctx, params.Principal.AccountID, time.Now())
+	if err != nil {
+		return ListSessionsOutput{}, err
+	}
+
+	return ListSessionsOutput{
+		SessionList: lo.Map(sessionList, func(item database.Session, _ int) *go_load.Session {
+			return s.databaseSessionToProtoSession(item, params.Principal.SessionID)
+		}),
+	}, nil
+}
+
+func (s session) RevokeSession(ctx context.Context, params RevokeSessionParams) error {
+	session, err := s.sessionDataAccessor.GetSession(ctx, params.SessionID)
+	if err != nil {
+		return err
+	}
+
+	if session.OfAccountID != params.Principal.AccountID {
+		return status.Error(codes.PermissionDenied, "trying to revoke a session the account does not own")
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+func (s session) RevokeAccountSessions(ctx context.Context, params RevokeAccountSessionsParams) error {
+	sessionList, err := s.sessionDataAccessor.GetActiveSessionListByAccount(ctx, params.AccountID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessionList {
+		if session.ID == params.ExceptSessionID {
+			continue
+		}
+
+		if err = s.revokeSession(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,735 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type TeamRole string
+
+const (
+	TeamRoleOwner  TeamRole = "owner"
+	TeamRoleMember TeamRole = "member"
+	TeamRoleViewer TeamRole = "viewer"
+
+	teamInvitationExpiresIn = 7 * 24 * time.Hour
+)
+
+var (
+	teamRoleToProtoTeamRole = map[TeamRole]go_load.TeamRole{
+		TeamRoleOwner:  go_load.TeamRole_TEAM_ROLE_OWNER,
+		TeamRoleMember: go_load.TeamRole_TEAM_ROLE_MEMBER,
+		TeamRoleViewer: go_load.TeamRole_TEAM_ROLE_VIEWER,
+	}
+	protoTeamRoleToTeamRole = lo.Invert(teamRoleToProtoTeamRole)
+
+	// teamRoleRank orders the roles, every role can do what the roles ranked below it can
+	teamRoleRank = map[TeamRole]int{
+		TeamRoleViewer: 1,
+		TeamRoleMember: 2,
+		TeamRoleOwner:  3,
+	}
+
+	errTeamMemberRequired = status.Error(codes.PermissionDenied, "the account is not a member of the team")
+	errTeamOwnerRequired  = status.Error(codes.PermissionDenied, "only owners of the team are allowed to do this")
+	errTeamLastOwner      = status.Error(codes.FailedPrecondition, "a team must keep at least one owner")
+)
+
+// IsAtLeast is false for the empty role of accounts outside of the team
+func (r TeamRole) IsAtLeast(requiredRole TeamRole) bool {
+	return teamRoleRank[r] >= teamRoleRank[requiredRole]
+}
+
+// getTeamRole returns an empty role if the account is not a member of the team
+func getTeamRole(
+	ctx context.Context,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	teamID uint64,
+	accountID uint64,
+) (TeamRole, error) {
+	teamMember, err := teamMemberDataAccessor.GetTeamMember(ctx, teamID, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrTeamMemberNotFound) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return TeamRole(teamMember.Role), nil
+}
+
+// hasOtherTeamOwner is called with the team locked, so two owners cannot both step down at the same time
+func hasOtherTeamOwner(teamMemberList []database.TeamMember, accountID uint64) bool {
+	return lo.ContainsBy(teamMemberList, func(item database.TeamMember) bool {
+		return item.OfAccountID != accountID && TeamRole(item.Role) == TeamRoleOwner
+	})
+}
+
+type CreateTeamParams struct {
+	Principal Principal
+	Name      string
+}
+
+type CreateTeamOutput struct {
+	Team *go_load.Team
+}
+
+type ListTeamsParams struct {
+	Principal Principal
+}
+
+type ListTeamsOutput struct {
+	TeamList []*go_load.Team
+}
+
+type DeleteTeamParams struct {
+	Principal Principal
+	TeamID    uint64
+}
+
+type ListTeamMembersParams struct {
+	Principal Principal
+	TeamID    uint64
+}
+
+type ListTeamMembersOutput struct {
+	TeamMemberList []*go_load.TeamMember
+}
+
+type UpdateTeamMemberRoleParams struct {
+	Principal Principal
+	TeamID    uint64
+	AccountID uint64
+	Role      go_load.TeamRole
+}
+
+type UpdateTeamMemberRoleOutput struct {
+	TeamMember *go_load.TeamMember
+}
+
+type RemoveTeamMemberParams struct {
+	Principal Principal
+	TeamID    uint64
+	AccountID uint64
+}
+
+type CreateTeamInvitationParams struct {
+	Principal          Principal
+	TeamID             uint64
+	InviteeAccountName string
+	Role               go_load.TeamRole
+}
+
+type CreateTeamInvitationOutput struct {
+	TeamInvitation *go_load.TeamInvitation
+}
+
+type ListTeamInvitationsParams struct {
+	Principal Principal
+	// TeamID is 0 to list the invitations received by the principal
+	TeamID uint64
+}
+
+type ListTeamInvitationsOutput struct {
+	TeamInvitationList []*go_load.TeamInvitation
+}
+
+type AcceptTeamInvitationParams struct {
+	Principal        Principal
+	TeamInvitationID uint64
+}
+
+type AcceptTeamInvitationOutput struct {
+	Team *go_load.Team
+}
+
+type DeleteTeamInvitationParams struct {
+	Principal        Principal
+	TeamInvitationID uint64
+}
+
+type Team interface {
+	CreateTeam(ctx context.Context, params CreateTeamParams) (CreateTeamOutput, error)
+	ListTeams(ctx context.Context, params ListTeamsParams) (ListTeamsOutput, error)
+	DeleteTeam(ctx context.Context, params DeleteTeamParams) error
+	ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (ListTeamMembersOutput, error)
+	UpdateTeamMemberRole(ctx context.Context, params UpdateTeamMemberRoleParams) (UpdateTeamMemberRoleOutput, error)
+	// RemoveTeamMember is allowed to owners, and to members removing themselves to leave the team
+	RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error
+	CreateTeamInvitation(ctx context.Context, params CreateTeamInvitationParams) (CreateTeamInvitationOutput, error)
+	ListTeamInvitations(ctx context.Context, params ListTeamInvitationsParams) (ListTeamInvitationsOutput, error)
+	AcceptTeamInvitation(ctx context.Context, params AcceptTeamInvitationParams) (AcceptTeamInvitationOutput, error)
+	// DeleteTeamInvitation lets the invitee decline the invitation and owners of the team revoke it
+	DeleteTeamInvitation(ctx context.Context, params DeleteTeamInvitationParams) error
+}
+
+type team struct {
+	goquDatabase               *goqu.Database
+	teamDataAccessor           database.TeamDataAccessor
+	teamMemberDataAccessor     database.TeamMemberDataAccessor
+	teamInvitationDataAccessor database.TeamInvitationDataAccessor
+	accountDataAccessor        database.AccountDataAccessor
+	downloadTaskDataAccessor   database.DownloadTaskDataAccessor
+	auditLogDataAccessor       database.AuditLogDataAccessor
+	logger                     *zap.Logger
+}
+
+func NewTeam(
+	goquDatabase *goqu.Database,
+	teamDataAccessor database.TeamDataAccessor,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	teamInvitationDataAccessor database.TeamInvitationDataAccessor,
+	accountDataAccessor database.AccountDataAccessor,
+	downloadTaskDataAccessor database.DownloadTaskDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	logger *zap.Logger,
+) Team {
+	return &team{
+		goquDatabase:               goquDatabase,
+		teamDataAccessor:           teamDataAccessor,
+		teamMemberDataAccessor:     teamMemberDataAccessor,
+		teamInvitationDataAccessor: teamInvitationDataAccessor,
+		accountDataAccessor:        accountDataAccessor,
+		downloadTaskDataAccessor:   downloadTaskDataAccessor,
+		auditLogDataAccessor:       auditLogDataAccessor,
+		logger:                     logger,
+	}
+}
+
+func (t team) databaseTeamToProtoTeam(team database.Team, role TeamRole) *go_load.Team {
+	return &go_load.Team{
+		Id:        team.ID,
+		Name:      team.Name,
+		CreatedAt: timestamppb.New(team.CreatedAt),
+		Role:      teamRoleToProtoTeamRole[role],
+	}
+}
+
+func (t team) databaseTeamMemberToProtoTeamMember(teamMember database.TeamMember, account database.Account) *go_load.TeamMember {
+	return &go_load.TeamMember{
+		Account: &go_load.Account{
+			Id:          account.ID,
+			AccountName: account.Name,
+		},
+		Role:      teamRoleToProtoTeamRole[TeamRole(teamMember.Role)],
+		CreatedAt: timestamppb.New(teamMember.CreatedAt),
+	}
+}
+
+func (t team) databaseTeamInvitationToProtoTeamInvitation(
+	teamInvitation database.TeamInvitation,
+	team database.Team,
+	invitee database.Account,
+) *go_load.TeamInvitation {
+	return &go_load.TeamInvitation{
+		Id:       teamInvitation.ID,
+		TeamId:   team.ID,
+		TeamName: team.Name,
+		Invitee: &go_load.Account{
+			Id:          invitee.ID,
+			AccountName: invitee.Name,
+		},
+		Role:      teamRoleToProtoTeamRole[TeamRole(teamInvitation.Role)],
+		CreatedAt: timestamppb.New(teamInvitation.CreatedAt),
+		ExpiresAt: timestamppb.New(teamInvitation.ExpiresAt),
+	}
+}
+
+func (t team) getTeamRoleFromProto(protoRole go_load.TeamRole) (TeamRole, error) {
+	role, ok := protoTeamRoleToTeamRole[protoRole]
+	if !ok {
+		return "", status.Error(codes.InvalidArgument, "unsupported team role")
+	}
+
+	return role, nil
+}
+
+// checkTeamOwner is called inside the transaction, after the team is locked
+func (t team) checkTeamOwner(
+	ctx context.Context,
+	teamMemberDataAccessor database.TeamMemberDataAccessor,
+	teamID uint64,
+	accountID uint64,
+) error {
+	role, err := getTeamRole(ctx, teamMemberDataAccessor, teamID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if role == "" {
+		return errTeamMemberRequired
+	}
+
+	if role != TeamRoleOwner {
+		return errTeamOwnerRequired
+	}
+
+	return nil
+}
+
+func (t team) CreateTeam(ctx context.Context, params CreateTeamParams) (CreateTeamOutput, error) {
+	newTeam := database.Team{
+		Name:      params.Name,
+		CreatedAt: time.Now(),
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		teamID, err := t.teamDataAccessor.WithDatabase(td).CreateTeam(ctx, newTeam)
+		if err != nil {
+			return err
+		}
+
+		newTeam.ID = teamID
+		return t.teamMemberDataAccessor.WithDatabase(td).CreateTeamMember(ctx, database.TeamMember{
+			OfTeamID:    teamID,
+			OfAccountID: params.Principal.AccountID,
+			Role:        string(TeamRoleOwner),
+			CreatedAt:   newTeam.CreatedAt,
+		})
+	})
+	if txErr != nil {
+		return CreateTeamOutput{}, txErr
+	}
+
+	return CreateTeamOutput{
+		Team: t.databaseTeamToProtoTeam(newTeam, TeamRoleOwner),
+	}, nil
+}
+
+func (t team) ListTeams(ctx context.Context, params ListTeamsParams) (ListTeamsOutput, error) {
+	teamMemberList, err := t.teamMemberDataAccessor.GetTeamMemberListByAccount(ctx, params.Principal.AccountID)
+	if err != nil {
+		return ListTeamsOutput{}, err
+	}
+
+	teamList, err := t.teamDataAccessor.GetTeamListByIDList(ctx, lo.Map(teamMemberList, func(item database.TeamMember, _ int) uint64 {
+		return item.OfTeamID
+	}))
+	if err != nil {
+		return ListTeamsOutput{}, err
+	}
+
+	roleByTeamID := lo.SliceToMap(teamMemberList, func(item database.TeamMember) (uint64, TeamRole) {
+		return item.OfTeamID, TeamRole(item.Role)
+	})
+
+	return ListTeamsOutput{
+		TeamList: lo.Map(teamList, func(item database.Team, _ int) *go_load.Team {
+			return t.databaseTeamToProtoTeam(item, roleByTeamID[item.ID])
+		}),
+	}, nil
+}
+
+func (t team) DeleteTeam(ctx context.Context, params DeleteTeamParams) error {
+	return t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		existingTeam, err := t.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, params.TeamID)
+		if err != nil {
+			return err
+		}
+
+		if err = t.checkTeamOwner(ctx, t.teamMemberDataAccessor.WithDatabase(td), params.TeamID, params.Principal.AccountID); err != nil {
+			return err
+		}
+
+		if err = t.downloadTaskDataAccessor.WithDatabase(td).DetachDownloadTaskListFromTeam(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		if err = t.teamInvitationDataAccessor.WithDatabase(td).DeleteTeamInvitationListByTeam(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		if err = t.teamMemberDataAccessor.WithDatabase(td).DeleteTeamMemberListByTeam(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		if err = t.teamDataAccessor.WithDatabase(td).DeleteTeam(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionDeleteTeam,
+			TargetType: auditLogTargetTypeTeam,
+			TargetID:   params.TeamID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameTeamName: existingTeam.Name,
+			},
+		})
+	})
+}
+
+func (t team) ListTeamMembers(ctx context.Context, params ListTeamMembersParams) (ListTeamMembersOutput, error) {
+	role, err := getTeamRole(ctx, t.teamMemberDataAccessor, params.TeamID, params.Principal.AccountID)
+	if err != nil {
+		return ListTeamMembersOutput{}, err
+	}
+
+	if role == "" {
+		return ListTeamMembersOutput{}, errTeamMemberRequired
+	}
+
+	teamMemberList, err := t.teamMemberDataAccessor.GetTeamMemberListByTeam(ctx, params.TeamID)
+	if err != nil {
+		return ListTeamMembersOutput{}, err
+	}
+
+	protoTeamMemberList := make([]*go_load.TeamMember, 0, len(teamMemberList))
+	for _, teamMember := range teamMemberList {
+		account, err := t.accountDataAccessor.GetAccountByID(ctx, teamMember.OfAccountID)
+		if err != nil {
+			return ListTeamMembersOutput{}, err
+		}
+
+		protoTeamMemberList = append(protoTeamMemberList, t.databaseTeamMemberToProtoTeamMember(teamMember, account))
+	}
+
+	return ListTeamMembersOutput{
+		TeamMemberList: protoTeamMemberList,
+	}, nil
+}
+
+func (t team) UpdateTeamMemberRole(
+	ctx context.Context,
+	params UpdateTeamMemberRoleParams,
+) (UpdateTeamMemberRoleOutput, error) {
+	role, err := t.getTeamRoleFromProto(params.Role)
+	if err != nil {
+		return UpdateTeamMemberRoleOutput{}, err
+	}
+
+	var output UpdateTeamMemberRoleOutput
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, err := t.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		teamMemberDataAccessor := t.teamMemberDataAccessor.WithDatabase(td)
+		if err := t.checkTeamOwner(ctx, teamMemberDataAccessor, params.TeamID, params.Principal.AccountID); err != nil {
+			return err
+		}
+
+		teamMemberList, err := teamMemberDataAccessor.GetTeamMemberListByTeam(ctx, params.TeamID)
+		if err != nil {
+			return err
+		}
+
+		teamMember, found := lo.Find(teamMemberList, func(item database.TeamMember) bool {
+			return item.OfAccountID == params.AccountID
+		})
+		if !found {
+			return database.ErrTeamMemberNotFound
+		}
+
+		previousRole := TeamRole(teamMember.Role)
+		if previousRole == TeamRoleOwner && role != TeamRoleOwner && !hasOtherTeamOwner(teamMemberList, params.AccountID) {
+			return errTeamLastOwner
+		}
+
+		account, err := t.accountDataAccessor.WithDatabase(td).GetAccountByID(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+
+		teamMember.Role = string(role)
+		output.TeamMember = t.databaseTeamMemberToProtoTeamMember(teamMember, account)
+		if previousRole == role {
+			return nil
+		}
+
+		if err = teamMemberDataAccessor.UpdateTeamMember(ctx, teamMember); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionUpdateTeamMemberRole,
+			TargetType: auditLogTargetTypeTeam,
+			TargetID:   params.TeamID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountID:    params.AccountID,
+				auditLogMetadataFieldNamePreviousRole: string(previousRole),
+				auditLogMetadataFieldNameRole:         string(role),
+			},
+		})
+	})
+	if txErr != nil {
+		return UpdateTeamMemberRoleOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (t team) RemoveTeamMember(ctx context.Context, params RemoveTeamMemberParams) error {
+	return t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if _, err := t.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, params.TeamID); err != nil {
+			return err
+		}
+
+		teamMemberDataAccessor := t.teamMemberDataAccessor.WithDatabase(td)
+		if params.AccountID != params.Principal.AccountID {
+			if err := t.checkTeamOwner(ctx, teamMemberDataAccessor, params.TeamID, params.Principal.AccountID); err != nil {
+				return err
+			}
+		}
+
+		teamMemberList, err := teamMemberDataAccessor.GetTeamMemberListByTeam(ctx, params.TeamID)
+		if err != nil {
+			return err
+		}
+
+		teamMember, found := lo.Find(teamMemberList, func(item database.TeamMember) bool {
+			return item.OfAccountID == params.AccountID
+		})
+		if !found {
+			return database.ErrTeamMemberNotFound
+		}
+
+		if TeamRole(teamMember.Role) == TeamRoleOwner && !hasOtherTeamOwner(teamMemberList, params.AccountID) {
+			return errTeamLastOwner
+		}
+
+		if err = teamMemberDataAccessor.DeleteTeamMember(ctx, params.TeamID, params.AccountID); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionRemoveTeamMember,
+			TargetType: auditLogTargetTypeTeam,
+			TargetID:   params.TeamID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountID: params.AccountID,
+				auditLogMetadataFieldNameRole:      teamMember.Role,
+			},
+		})
+	})
+}
+
+func (t team) CreateTeamInvitation(
+	ctx context.Context,
+	params CreateTeamInvitationParams,
+) (CreateTeamInvitationOutput, error) {
+	role, err := t.getTeamRoleFromProto(params.Role)
+	if err != nil {
+		return CreateTeamInvitationOutput{}, err
+	}
+
+	var output CreateTeamInvitationOutput
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		existingTeam, err := t.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, params.TeamID)
+		if err != nil {
+			return err
+		}
+
+		teamMemberDataAccessor := t.teamMemberDataAccessor.WithDatabase(td)
+		if err = t.checkTeamOwner(ctx, teamMemberDataAccessor, params.TeamID, params.Principal.AccountID); err != nil {
+			return err
+		}
+
+		invitee, err := t.accountDataAccessor.WithDatabase(td).GetAccountByAccountName(ctx, params.InviteeAccountName)
+		if err != nil {
+			return err
+		}
+
+		inviteeRole, err := getTeamRole(ctx, teamMemberDataAccessor, params.TeamID, invitee.ID)
+		if err != nil {
+			return err
+		}
+
+		if inviteeRole != "" {
+			return status.Error(codes.AlreadyExists, "the account is already a member of the team")
+		}
+
+		teamInvitationDataAccessor := t.teamInvitationDataAccessor.WithDatabase(td)
+		now := time.Now()
+		existingTeamInvitation, err := teamInvitationDataAccessor.GetTeamInvitationByTeamAndInvitee(ctx, params.TeamID, invitee.ID)
+		if err != nil && !errors.Is(err, database.ErrTeamInvitationNotFound) {
+			return err
+		}
+
+		if err == nil {
+			if existingTeamInvitation.ExpiresAt.After(now) {
+				return status.Error(codes.AlreadyExists, "the account already has a pending invitation to the team")
+			}
+
+			// An expired invitation is replaced, so the account can be invited again
+			if err = teamInvitationDataAccessor.DeleteTeamInvitation(ctx, existingTeamInvitation.ID); err != nil {
+				return err
+			}
+		}
+
+		teamInvitation := database.TeamInvitation{
+			OfTeamID:         params.TeamID,
+			InviteeAccountID: invitee.ID,
+			Role:             string(role),
+			CreatedAt:        now,
+			ExpiresAt:        now.Add(teamInvitationExpiresIn),
+		}
+		teamInvitation.ID, err = teamInvitationDataAccessor.CreateTeamInvitation(ctx, teamInvitation)
+		if err != nil {
+			return err
+		}
+
+		output.TeamInvitation = t.databaseTeamInvitationToProtoTeamInvitation(teamInvitation, existingTeam, invitee)
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionCreateTeamInvitation,
+			TargetType: auditLogTargetTypeTeam,
+			TargetID:   params.TeamID,
+			Metadata: map[string]any{
+				auditLogMetadataFieldNameAccountID: invitee.ID,
+				auditLogMetadataFieldNameRole:      string(role),
+			},
+		})
+	})
+	if txErr != nil {
+		return CreateTeamInvitationOutput{}, txErr
+	}
+
+	return output, nil
+}
+
+func (t team) ListTeamInvitations(
+	ctx context.Context,
+	params ListTeamInvitationsParams,
+) (ListTeamInvitationsOutput, error) {
+	var (
+		teamInvitationList []database.TeamInvitation
+		err                error
+	)
+	if params.TeamID != 0 {
+		if err = t.checkTeamOwner(ctx, t.teamMemberDataAccessor, params.TeamID, params.Principal.AccountID); err != nil {
+			return ListTeamInvitationsOutput{}, err
+		}
+
+		teamInvitationList, err = t.teamInvitationDataAccessor.GetUnexpiredTeamInvitationListByTeam(ctx, params.TeamID, time.Now())
+	} else {
+		teamInvitationList, err = t.teamInvitationDataAccessor.GetUnexpiredTeamInvitationListByInvitee(
+			ctx, params.Principal.AccountID, time.Now())
+	}
+	if err != nil {
+		return ListTeamInvitationsOutput{}, err
+	}
+
+	teamMap := make(map[uint64]database.Team)
+	accountMap := make(map[uint64]database.Account)
+	protoTeamInvitationList := make([]*go_load.TeamInvitation, 0, len(teamInvitationList))
+	for _, teamInvitation := range teamInvitationList {
+		invitationTeam, ok := teamMap[teamInvitation.OfTeamID]
+		if !ok {
+			invitationTeam, err = t.teamDataAccessor.GetTeam(ctx, teamInvitation.OfTeamID)
+			if err != nil {
+				return ListTeamInvitationsOutput{}, err
+			}
+			teamMap[teamInvitation.OfTeamID] = invitationTeam
+		}
+
+		invitee, ok := accountMap[teamInvitation.InviteeAccountID]
+		if !ok {
+			invitee, err = t.accountDataAccessor.GetAccountByID(ctx, teamInvitation.InviteeAccountID)
+			if err != nil {
+				return ListTeamInvitationsOutput{}, err
+			}
+			accountMap[teamInvitation.InviteeAccountID] = invitee
+		}
+
+		protoTeamInvitationList = append(protoTeamInvitationList,
+			t.databaseTeamInvitationToProtoTeamInvitation(teamInvitation, invitationTeam, invitee))
+	}
+
+	return ListTeamInvitationsOutput{
+		TeamInvitationList: protoTeamInvitationList,
+	}, nil
+}
+
+func (t team) AcceptTeamInvitation(
+	ctx context.Context,
+	params AcceptTeamInvitationParams,
+) (AcceptTeamInvitationOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).
+		With(zap.Uint64("account_id", params.Principal.AccountID)).
+		With(zap.Uint64("team_invitation_id", params.TeamInvitationID))
+
+	var output AcceptTeamInvitationOutput
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		teamInvitationDataAccessor := t.teamInvitationDataAccessor.WithDatabase(td)
+		teamInvitation, err := teamInvitationDataAccessor.GetTeamInvitationWithXLock(ctx, params.TeamInvitationID)
+		if err != nil {
+			return err
+		}
+
+		// Invitations of other accounts are reported as missing, so their ids cannot be probed
+		if teamInvitation.InviteeAccountID != params.Principal.AccountID {
+			return database.ErrTeamInvitationNotFound
+		}
+
+		if !teamInvitation.ExpiresAt.After(time.Now()) {
+			return status.Error(codes.FailedPrecondition, "team invitation has expired")
+		}
+
+		invitationTeam, err := t.teamDataAccessor.WithDatabase(td).GetTeamWithXLock(ctx, teamInvitation.OfTeamID)
+		if err != nil {
+			return err
+		}
+
+		role := TeamRole(teamInvitation.Role)
+		err = t.teamMemberDataAccessor.WithDatabase(td).CreateTeamMember(ctx, database.TeamMember{
+			OfTeamID:    teamInvitation.OfTeamID,
+			OfAccountID: params.Principal.AccountID,
+			Role:        string(role),
+			CreatedAt:   time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		if err = teamInvitationDataAccessor.DeleteTeamInvitation(ctx, teamInvitation.ID); err != nil {
+			return err
+		}
+
+		output.Team = t.databaseTeamToProtoTeam(invitationTeam, role)
+		return nil
+	})
+	if txErr != nil {
+		return AcceptTeamInvitationOutput{}, txErr
+	}
+
+	logger.Info("team invitation accepted")
+	return output, nil
+}
+
+func (t team) DeleteTeamInvitation(ctx context.Context, params DeleteTeamInvitationParams) error {
+	return t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		teamInvitationDataAccessor := t.teamInvitationDataAccessor.WithDatabase(td)
+		teamInvitation, err := teamInvitationDataAccessor.GetTeamInvitationWithXLock(ctx, params.TeamInvitationID)
+		if err != nil {
+			return err
+		}
+
+		if teamInvitation.InviteeAccountID != params.Principal.AccountID {
+			role, err := getTeamRole(ctx, t.teamMemberDataAccessor.WithDatabase(td), teamInvitation.OfTeamID, params.Principal.AccountID)
+			if err != nil {
+				return err
+			}
+
+			if role == "" {
+				return database.ErrTeamInvitationNotFound
+			}
+
+			if role != TeamRoleOwner {
+				return errTeamOwnerRequired
+			}
+		}
+
+		return teamInvitationDataAccessor.DeleteTeamInvitation(ctx, teamInvitation.ID)
+	})
+}
@@ -2,13 +2,10 @@ package logic
 
 import (
 	"context"
-	"crypto/rand"
 	"crypto/rsa"
-	"crypto/x509"
 	"database/sql"
-	"encoding/pem"
 	"errors"
-
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -21,64 +18,55 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-const (
-	rs512KeyPairBitCount = 2048
-)
-
 var (
 	errUnexpectedSigningMethod = status.Error(codes.Unauthenticated, "unexpected singing method")
 	errCannotGetTokenClaims    = status.Error(codes.Unauthenticated, "cannot get token's claim")
 	errCannotGetTokensKidClaim = status.Error(codes.Unauthenticated, "cannot get token's kid claim")
 	errCannotGetTokensSubClaim = status.Error(codes.Unauthenticated, "cannot get token's sub claim")
 	errCannotGetTokensExpClaim = status.Error(codes.Unauthenticated, "cannot get token's exp claim")
+	errCannotGetTokensSidClaim = status.Error(codes.Unauthenticated, "cannot get token's sid claim")
+	errSessionRevoked          = status.Error(codes.Unauthenticated, "session is revoked")
 	errTokenPublicKeyNotFound  = status.Error(codes.Unauthenticated, "token public key not found")
 	errInvalidToken            = status.Error(codes.Unauthenticated, "invalid token")
+	errInvalidAPIKey           = status.Error(codes.Unauthenticated, "invalid api key")
 	errFailedToSignToken       = status.Error(codes.Internal, "failed to sign token")
 )
 
-type Token interface {
-	GetToken(ctx context.Context, accountID uint64) (string, time.Time, error)
-	GetAccountIDAndExpireTime(ctx context.Context, token string) (uint64, time.Time, error)
+type TokenClaims struct {
+	AccountID  uint64
+	SessionID  uint64
+	ExpireTime time.Time
 }
 
-func generateRSAKeyPair(bits int) (*rsa.PrivateKey, error) {
-	privateKeyPair, err := rsa.GenerateKey(rand.Reader, bits)
-	if err != nil {
-		return nil, err
-	}
-
-	return privateKeyPair, nil
+type Token interface {
+	GetToken(ctx context.Context, accountID uint64, sessionID uint64) (string, time.Time, error)
+	// GetPrincipal accepts both session tokens and api keys
+	GetPrincipal(ctx context.Context, token string) (Principal, error)
+	// GetTokenClaims fails if the token is invalid or its session has been revoked
+	GetTokenClaims(ctx context.Context, token string) (TokenClaims, error)
 }
 
 type token struct {
 	accountDataAccessor        database.AccountDataAccessor
 	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
 	tokenPublicKeyCache        cache.TokenPublicKeyCache
+	sessionDataAccessor        database.SessionDataAccessor
+	sessionRevocationCache     cache.SessionRevocation
+	apiKeyDataAccessor         database.APIKeyDataAccessor
+	tokenSigningKeyLogic       TokenSigningKey
 	expiresIn                  time.Duration
-	privateKey                 *rsa.PrivateKey
-	tokenPublicKeyID           uint64
 	authConfig                 configs.Auth
 	logger                     *zap.Logger
 }
 
-func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
-	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
-	if err != nil {
-		return nil, err
-	}
-
-	block := &pem.Block{
-		Type:  "PUBLIC KEY",
-		Bytes: pubBytes,
-	}
-
-	return pem.EncodeToMemory(block), nil
-}
-
 func NewToken(
 	accountDataAccessor database.AccountDataAccessor,
 	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
 	tokenPublicKeyCache cache.TokenPublicKeyCache,
+	sessionDataAccessor database.SessionDataAccessor,
+	sessionRevocationCache cache.SessionRevocation,
+	apiKeyDataAccessor database.APIKeyDataAccessor,
+	tokenSigningKeyLogic TokenSigningKey,
 	authConfig configs.Auth,
 	logger *zap.Logger,
 ) (Token, error) {
@@ -88,50 +76,40 @@ func NewToken(
 		return nil, err
 	}
 
-	rsaKeyPair, err := generateRSAKeyPair(rs512KeyPairBitCount)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to generate rsa key pair")
-		return nil, err
-	}
-
-	publicKeyBytes, err := pemEncodePublicKey(&rsaKeyPair.PublicKey)
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to encode public key in pem format")
-		return nil, err
-	}
-
-	tokenPublicKeyID, err := tokenPublicKeyDataAccessor.CreatePublicKey(context.Background(), database.TokenPublicKey{
-		PublicKey: string(publicKeyBytes),
-	})
-	if err != nil {
-		logger.With(zap.Error(err)).Error("failed to create public key in database")
-		return nil, err
-	}
-
 	return &token{
 		accountDataAccessor:        accountDataAccessor,
 		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
 		tokenPublicKeyCache:        tokenPublicKeyCache,
+		sessionDataAccessor:        sessionDataAccessor,
+		sessionRevocationCache:     sessionRevocationCache,
+		apiKeyDataAccessor:         apiKeyDataAccessor,
+		tokenSigningKeyLogic:       tokenSigningKeyLogic,
 		authConfig:                 authConfig,
 		expiresIn:                  expiresIn,
 		logger:                     logger,
-		tokenPublicKeyID:           tokenPublicKeyID,
-		privateKey:                 rsaKeyPair,
 	}, nil
 }
 
-func (t *token) GetToken(ctx context.Context, accountID uint64) (string, time.Time, error) {
+func (t *token) GetToken(ctx context.Context, accountID uint64, sessionID uint64) (string, time.Time, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
+	tokenPublicKeyID, privateKey, err := t.tokenSigningKeyLogic.GetSigningKey(ctx)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
 	expireTime := time.Now().Add(t.expiresIn)
 
 	token := jwt.NewWithClaims(jwt.SigningMethodRS512, jwt.MapClaims{
 		"sub": accountID,
 		"exp": expireTime.Unix(),
-		"kid": t.tokenPublicKeyID,
+		"kid": tokenPublicKeyID,
+		"sid": sessionID,
 	})
+	// kid is also set in the header for services verifying tokens with the published json web key set
+	token.Header["kid"] = strconv.FormatUint(tokenPublicKeyID, 10)
 
-	tokenString, err := token.SignedString(t.privateKey)
+	tokenString, err := token.SignedString(privateKey)
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to sign token")
 		return "", time.Time{}, errFailedToSignToken
@@ -157,6 +135,11 @@ func (t *token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey,
 		return nil, err
 	}
 
+	if tokenPublicKey.RetireAt != nil && !tokenPublicKey.RetireAt.After(time.Now()) {
+		logger.Warn("token's public key is retired")
+		return nil, errTokenPublicKeyNotFound
+	}
+
 	err = t.tokenPublicKeyCache.Set(ctx, id, tokenPublicKey.PublicKey)
 	if err != nil {
 		logger.With(zap.Error(err)).Warn("failed to set public key into cache")
@@ -165,7 +148,113 @@ func (t *token) getJWTPublicKey(ctx context.Context, id uint64) (*rsa.PublicKey,
 	return jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
 }
 
-func (t *token) GetAccountIDAndExpireTime(ctx context.Context, tokenString string) (uint64, time.Time, error) {
+func (t *token) isSessionRevoked(ctx context.Context, sessionID uint64) (bool, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("session_id", sessionID))
+
+	revoked, err := t.sessionRevocationCache.Get(ctx, sessionID)
+	if err == nil {
+		return revoked, nil
+	}
+
+	if !errors.Is(err, cache.ErrCacheMiss) {
+		logger.With(zap.Error(err)).Warn("failed to get session revocation from cache, will fall back to database")
+	}
+
+	session, err := t.sessionDataAccessor.GetSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, database.ErrSessionNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	revoked = session.RevokedAt != nil
+	if err = t.sessionRevocationCache.Set(ctx, sessionID, revoked); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to set session revocation into cache")
+	}
+
+	return revoked, nil
+}
+
+func (t *token) getAPIKeyPrincipal(ctx context.Context, key string) (Principal, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	apiKey, err := t.apiKeyDataAccessor.GetAPIKeyByKeyHash(ctx, hashAPIKey(key))
+	if err != nil {
+		if errors.Is(err, database.ErrAPIKeyNotFound) {
+			return Principal{}, errInvalidAPIKey
+		}
+		return Principal{}, err
+	}
+
+	logger = logger.With(zap.Uint64("api_key_id", apiKey.ID))
+
+	now := time.Now()
+	if apiKey.RevokedAt != nil || (apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(now)) {
+		logger.Warn("api key is revoked or expired")
+		return Principal{}, errInvalidAPIKey
+	}
+
+	scopeList, err := getAPIKeyScopeList(apiKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to get api key scope list")
+		return Principal{}, status.Error(codes.Internal, "failed to get api key scope list")
+	}
+
+	// last_used_at is only a hint for users, it does not need to be written on every request
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyLastUsedAtUpdateInterval {
+		if err = t.apiKeyDataAccessor.UpdateAPIKeyLastUsedAt(ctx, apiKey.ID, now); err != nil {
+			logger.With(zap.Error(err)).Warn("failed to update api key last used at")
+		}
+	}
+
+	return Principal{
+		AccountID:       apiKey.OfAccountID,
+		APIKeyID:        apiKey.ID,
+		APIKeyScopeList: scopeList,
+	}, nil
+}
+
+func (t *token) getTokenPrincipal(ctx context.Context, tokenString string) (Principal, error) {
+	if isAPIKey(tokenString) {
+		return t.getAPIKeyPrincipal(ctx, tokenString)
+	}
+
+	tokenClaims, err := t.GetTokenClaims(ctx, tokenString)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{
+		AccountID: tokenClaims.AccountID,
+		SessionID: tokenClaims.SessionID,
+	}, nil
+}
+
+func (t *token) GetPrincipal(ctx context.Context, tokenString string) (Principal, error) {
+	principal, err := t.getTokenPrincipal(ctx, tokenString)
+	if err != nil {
+		return Principal{}, err
+	}
+
+	// The account is read on every request so disabling it or changing its role takes effect immediately
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, principal.AccountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			return Principal{}, errInvalidToken
+		}
+		return Principal{}, err
+	}
+
+	if account.DisabledAt != nil {
+		return Principal{}, errAccountDisabled
+	}
+
+	principal.Admin = account.Role == AccountRoleAdmin
+	return principal, nil
+}
+
+func (t *token) GetTokenClaims(ctx context.Context, tokenString string) (TokenClaims, error) {
 	logger := utils.LoggerWithContext(ctx, t.logger)
 
 	parsedToken, err := jwt.Parse(tokenString, func(parsedToken *jwt.Token) (any, error) {
@@ -192,31 +281,50 @@ func (t *token) GetAccountIDAndExpireTime(ctx context.Context, tokenString strin
 
 	if err != nil {
 		logger.With(zap.Error(err)).Error("failed to parse token")
-		return 0, time.Time{}, errInvalidToken
+		return TokenClaims{}, errInvalidToken
 	}
 
 	if !parsedToken.Valid {
 		logger.Error("invalid token")
-		return 0, time.Time{}, errInvalidToken
+		return TokenClaims{}, errInvalidToken
 	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
 		logger.Error("cannot get token's claims")
-		return 0, time.Time{}, errCannotGetTokenClaims
+		return TokenClaims{}, errCannotGetTokenClaims
 	}
 
 	accountID, ok := claims["sub"].(float64)
 	if !ok {
 		logger.Error("cannot et token's sub claim")
-		return 0, time.Time{}, errCannotGetTokensSubClaim
+		return TokenClaims{}, errCannotGetTokensSubClaim
 	}
 
 	expireTimeUnix, ok := claims["exp"].(float64)
 	if !ok {
 		logger.Error("cannot get token's exp claim")
-		return 0, time.Time{}, errCannotGetTokensExpClaim
+		return TokenClaims{}, errCannotGetTokensExpClaim
+	}
+
+	sessionID, ok := claims["sid"].(float64)
+	if !ok {
+		logger.Error("cannot get token's sid claim")
+		return TokenClaims{}, errCannotGetTokensSidClaim
 	}
 
-	return uint64(accountID), time.Unix(int64(expireTimeUnix), 0), nil
+	revoked, err := t.isSessionRevoked(ctx, uint64(sessionID))
+	if err != nil {
+		return TokenClaims{}, err
+	}
+
+	if revoked {
+		return TokenClaims{}, errSessionRevoked
+	}
+
+	return TokenClaims{
+		AccountID:  uint64(accountID),
+		SessionID:  uint64(sessionID),
+		ExpireTime: time.Unix(int64(expireTimeUnix), 0),
+	}, nil
 }
@@ -0,0 +1,423 @@
+package logic
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	rs512KeyPairBitCount = 2048
+	// tokenSigningKeyEncryptionKeyByteCount selects AES-256
+	tokenSigningKeyEncryptionKeyByteCount = 32
+
+	jsonWebKeyTypeRSA        = "RSA"
+	jsonWebKeyUseSignature   = "sig"
+	jsonWebKeyAlgorithmRS512 = "RS512"
+)
+
+var (
+	errTokenSigningKeyNotLoaded = status.Error(codes.Internal, "token signing key is not loaded")
+)
+
+type TokenSigningKey interface {
+	// GetSigningKey returns the id and the private key tokens must be signed with
+	GetSigningKey(ctx context.Context) (uint64, *rsa.PrivateKey, error)
+	// RotateSigningKey generates a new signing key if the current one is older than the rotation interval, then loads
+	// the latest signing key, which might have been generated by another replica
+	RotateSigningKey(ctx context.Context) error
+	GetJSONWebKeySet(ctx context.Context) ([]*go_load.JSONWebKey, error)
+}
+
+type tokenSigningKey struct {
+	goquDatabase               *goqu.Database
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor
+	// privateKeyAEAD is nil when keys are loaded from files, in that case keys are never generated nor rotated
+	privateKeyAEAD   cipher.AEAD
+	rotationInterval time.Duration
+	// retireAfter is how long a key must still be accepted after it stopped signing tokens
+	retireAfter     time.Duration
+	signingKeyMutex sync.RWMutex
+	signingKeyID    uint64
+	signingKey      *rsa.PrivateKey
+	logger          *zap.Logger
+}
+
+func NewTokenSigningKey(
+	goquDatabase *goqu.Database,
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TokenSigningKey, error) {
+	tokenExpiresIn, err := authConfig.Token.GetExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse expires_in")
+		return nil, err
+	}
+
+	overlap, err := authConfig.Token.SigningKey.GetOverlapDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse signing_key.overlap")
+		return nil, err
+	}
+
+	t := &tokenSigningKey{
+		goquDatabase:               goquDatabase,
+		tokenPublicKeyDataAccessor: tokenPublicKeyDataAccessor,
+		retireAfter:                tokenExpiresIn + overlap,
+		logger:                     logger,
+	}
+
+	if len(authConfig.Token.SigningKey.PrivateKeyFileList) > 0 {
+		if err = t.loadPrivateKeyFileList(context.Background(), authConfig.Token.SigningKey.PrivateKeyFileList); err != nil {
+			logger.With(zap.Error(err)).Error("failed to load signing_key.private_key_file_list")
+			return nil, err
+		}
+
+		return t, nil
+	}
+
+	t.rotationInterval, err = authConfig.Token.SigningKey.GetRotationIntervalDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse signing_key.rotation_interval")
+		return nil, err
+	}
+
+	t.privateKeyAEAD, err = newTokenSigningKeyAEAD(authConfig.Token.SigningKey)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to initialize signing_key.encryption_key")
+		return nil, err
+	}
+
+	if err = t.RotateSigningKey(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func newTokenSigningKeyAEAD(signingKeyConfig configs.TokenSigningKey) (cipher.AEAD, error) {
+	encryptionKey, err := signingKeyConfig.GetEncryptionKeyBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(encryptionKey) != tokenSigningKeyEncryptionKeyByteCount {
+		return nil, fmt.Errorf("encryption key must be %d bytes long", tokenSigningKeyEncryptionKeyByteCount)
+	}
+
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func pemEncodePublicKey(pubKey *rsa.PublicKey) ([]byte, error) {
+	pubBytes, err := x509.MarshalPKIXPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: pubBytes,
+	}
+
+	return pem.EncodeToMemory(block), nil
+}
+
+func getPublicKeyFingerprint(publicKeyPEM []byte) string {
+	fingerprint := sha256.Sum256(publicKeyPEM)
+	return hex.EncodeToString(fingerprint[:])
+}
+
+// encryptPrivateKey seals the private key with the public key as additional data,
+// so an encrypted private key cannot be swapped between rows
+func (t *tokenSigningKey) encryptPrivateKey(privateKey *rsa.PrivateKey, publicKeyPEM []byte) (string, error) {
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, t.privateKeyAEAD.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(t.privateKeyAEAD.Seal(nonce, nonce, privateKeyBytes, publicKeyPEM)), nil
+}
+
+func (t *tokenSigningKey) decryptPrivateKey(encryptedPrivateKey string, publicKeyPEM []byte) (*rsa.PrivateKey, error) {
+	encryptedPrivateKeyBytes, err := base64.StdEncoding.DecodeString(encryptedPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := t.privateKeyAEAD.NonceSize()
+	if len(encryptedPrivateKeyBytes) < nonceSize {
+		return nil, errors.New("encrypted private key is too short")
+	}
+
+	privateKeyBytes, err := t.privateKeyAEAD.Open(
+		nil, encryptedPrivateKeyBytes[:nonceSize], encryptedPrivateKeyBytes[nonceSize:], publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not a rsa private key")
+	}
+
+	return rsaPrivateKey, nil
+}
+
+func (t *tokenSigningKey) setSigningKey(id uint64, privateKey *rsa.PrivateKey) {
+	t.signingKeyMutex.Lock()
+	defer t.signingKeyMutex.Unlock()
+
+	t.signingKeyID = id
+	t.signingKey = privateKey
+}
+
+// registerPublicKey returns the id of the public key, creating it if it was never registered
+func (t *tokenSigningKey) registerPublicKey(ctx context.Context, publicKey *rsa.PublicKey) (uint64, error) {
+	publicKeyPEM, err := pemEncodePublicKey(publicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	fingerprint := getPublicKeyFingerprint(publicKeyPEM)
+	tokenPublicKey, err := t.tokenPublicKeyDataAccessor.GetPublicKeyByFingerprint(ctx, fingerprint)
+	if err == nil {
+		if tokenPublicKey.RetireAt != nil {
+			tokenPublicKey.RetireAt = nil
+			if err = t.tokenPublicKeyDataAccessor.UpdatePublicKey(ctx, tokenPublicKey); err != nil {
+				return 0, err
+			}
+		}
+
+		return tokenPublicKey.ID, nil
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	return t.tokenPublicKeyDataAccessor.CreatePublicKey(ctx, database.TokenPublicKey{
+		PublicKey:   string(publicKeyPEM),
+		Fingerprint: &fingerprint,
+		CreatedAt:   time.Now(),
+	})
+}
+
+// retireTokenPublicKeyList schedules the retirement of every key still in use except the ones in keepIDList
+func (t *tokenSigningKey) retireTokenPublicKeyList(
+	ctx context.Context,
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+	keepIDList []uint64,
+) error {
+	now := time.Now()
+	tokenPublicKeyList, err := tokenPublicKeyDataAccessor.GetUnretiredPublicKeyList(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	retireAt := now.Add(t.retireAfter)
+	for _, tokenPublicKey := range tokenPublicKeyList {
+		if tokenPublicKey.RetireAt != nil || lo.Contains(keepIDList, tokenPublicKey.ID) {
+			continue
+		}
+
+		// The private key is not needed anymore once the key stops signing tokens
+		tokenPublicKey.RetireAt = &retireAt
+		tokenPublicKey.EncryptedPrivateKey = nil
+		if err = tokenPublicKeyDataAccessor.UpdatePublicKey(ctx, tokenPublicKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *tokenSigningKey) loadPrivateKeyFileList(ctx context.Context, privateKeyFileList []string) error {
+	keyIDList := make([]uint64, 0, len(privateKeyFileList))
+	for i, privateKeyFile := range privateKeyFileList {
+		privateKeyPEM, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return err
+		}
+
+		privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyPEM)
+		if err != nil {
+			return fmt.Errorf("failed to parse private key file %s: %w", privateKeyFile, err)
+		}
+
+		keyID, err := t.registerPublicKey(ctx, &privateKey.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			t.setSigningKey(keyID, privateKey)
+		}
+
+		keyIDList = append(keyIDList, keyID)
+	}
+
+	// Keys removed from the configuration stop being accepted once the tokens they signed expired
+	return t.retireTokenPublicKeyList(ctx, t.tokenPublicKeyDataAccessor, keyIDList)
+}
+
+func (t *tokenSigningKey) generateSigningKey(
+	ctx context.Context,
+	tokenPublicKeyDataAccessor database.TokenPublicKeyDataAccessor,
+) (database.TokenPublicKey, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rs512KeyPairBitCount)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	publicKeyPEM, err := pemEncodePublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	encryptedPrivateKey, err := t.encryptPrivateKey(privateKey, publicKeyPEM)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	fingerprint := getPublicKeyFingerprint(publicKeyPEM)
+	tokenPublicKey := database.TokenPublicKey{
+		PublicKey:           string(publicKeyPEM),
+		EncryptedPrivateKey: &encryptedPrivateKey,
+		Fingerprint:         &fingerprint,
+		CreatedAt:           time.Now(),
+	}
+
+	tokenPublicKey.ID, err = tokenPublicKeyDataAccessor.CreatePublicKey(ctx, tokenPublicKey)
+	if err != nil {
+		return database.TokenPublicKey{}, err
+	}
+
+	return tokenPublicKey, nil
+}
+
+func (t *tokenSigningKey) RotateSigningKey(ctx context.Context) error {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	if t.privateKeyAEAD == nil {
+		return nil
+	}
+
+	var signingKey database.TokenPublicKey
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		tokenPublicKeyDataAccessor := t.tokenPublicKeyDataAccessor.WithDatabase(td)
+
+		// The lock makes replicas wait for each other, so only one of them generates the new key
+		latestSigningKey, err := tokenPublicKeyDataAccessor.GetLatestSigningKeyWithXLock(ctx)
+		if err == nil && time.Since(latestSigningKey.CreatedAt) < t.rotationInterval {
+			signingKey = latestSigningKey
+			return nil
+		}
+
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		signingKey, err = t.generateSigningKey(ctx, tokenPublicKeyDataAccessor)
+		if err != nil {
+			return err
+		}
+
+		logger.With(zap.Uint64("id", signingKey.ID)).Info("generated new token signing key")
+		return t.retireTokenPublicKeyList(ctx, tokenPublicKeyDataAccessor, []uint64{signingKey.ID})
+	})
+	if txErr != nil {
+		logger.With(zap.Error(txErr)).Error("failed to rotate token signing key")
+		return txErr
+	}
+
+	privateKey, err := t.decryptPrivateKey(*signingKey.EncryptedPrivateKey, []byte(signingKey.PublicKey))
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to decrypt token signing key")
+		return err
+	}
+
+	t.setSigningKey(signingKey.ID, privateKey)
+	return nil
+}
+
+func (t *tokenSigningKey) GetSigningKey(_ context.Context) (uint64, *rsa.PrivateKey, error) {
+	t.signingKeyMutex.RLock()
+	defer t.signingKeyMutex.RUnlock()
+
+	if t.signingKey == nil {
+		return 0, nil, errTokenSigningKeyNotLoaded
+	}
+
+	return t.signingKeyID, t.signingKey, nil
+}
+
+func (t *tokenSigningKey) GetJSONWebKeySet(ctx context.Context) ([]*go_load.JSONWebKey, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	tokenPublicKeyList, err := t.tokenPublicKeyDataAccessor.GetUnretiredPublicKeyList(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	jsonWebKeyList := make([]*go_load.JSONWebKey, 0, len(tokenPublicKeyList))
+	for _, tokenPublicKey := range tokenPublicKeyList {
+		publicKey, err := jwt.ParseRSAPublicKeyFromPEM([]byte(tokenPublicKey.PublicKey))
+		if err != nil {
+			logger.With(zap.Uint64("id", tokenPublicKey.ID)).With(zap.Error(err)).Error("failed to parse token public key")
+			continue
+		}
+
+		jsonWebKeyList = append(jsonWebKeyList, &go_load.JSONWebKey{
+			Kty: jsonWebKeyTypeRSA,
+			Use: jsonWebKeyUseSignature,
+			Alg: jsonWebKeyAlgorithmRS512,
+			Kid: strconv.FormatUint(tokenPublicKey.ID, 10),
+			N:   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		})
+	}
+
+	return jsonWebKeyList, nil
+}
@@ -0,0 +1,631 @@
+package logic
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 uses HMAC-SHA1, which authenticator apps expect by default
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/doug-martin/goqu/v9"
+	"github.com/nhtuan0700/GoLoad/internal/configs"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
+	"github.com/nhtuan0700/GoLoad/internal/generated/grpc/go_load"
+	"github.com/nhtuan0700/GoLoad/internal/utils"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	totpSecretByteCount = 20
+	totpPeriod          = 30 * time.Second
+	totpDigitCount      = 6
+	// totpAllowedStepSkew accepts the codes of the previous and the next period for clocks that drift
+	totpAllowedStepSkew = 1
+
+	recoveryCodeCount          = 10
+	recoveryCodeCharacterCount = 10
+	recoveryCodeAlphabet       = "abcdefghijklmnopqrstuvwxyz234567"
+
+	loginChallengeTokenByteCount = 32
+)
+
+var (
+	errTOTPNotConfigured     = status.Error(codes.FailedPrecondition, "totp is not configured on this server")
+	errTOTPAlreadyEnabled    = status.Error(codes.FailedPrecondition, "totp is already enabled")
+	errTOTPNotEnrolled       = status.Error(codes.FailedPrecondition, "totp is not enrolled")
+	errTOTPNotEnabled        = status.Error(codes.FailedPrecondition, "totp is not enabled")
+	errIncorrectTOTPCode     = status.Error(codes.PermissionDenied, "incorrect totp or recovery code")
+	errInvalidLoginChallenge = status.Error(codes.Unauthenticated, "invalid or expired challenge token")
+	// errIncorrectLoginCode is Unauthenticated unlike errIncorrectTOTPCode since no session is signed in yet
+	errIncorrectLoginCode = status.Error(codes.Unauthenticated, "incorrect totp or recovery code")
+
+	totpSecretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+type EnrollTOTPParams struct {
+	Principal Principal
+}
+
+type EnrollTOTPOutput struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+type ConfirmTOTPParams struct {
+	Principal Principal
+	TOTPCode  string
+}
+
+type ConfirmTOTPOutput struct {
+	RecoveryCodeList []string
+}
+
+type DisableTOTPParams struct {
+	Principal Principal
+	Password  string
+	// Only one of TOTPCode and RecoveryCode is set
+	TOTPCode     string
+	RecoveryCode string
+}
+
+type VerifySessionChallengeParams struct {
+	ChallengeToken string
+	// Only one of TOTPCode and RecoveryCode is set
+	TOTPCode     string
+	RecoveryCode string
+	UserAgent    string
+	// ClientIP is empty if it is not known, failed codes are then only counted per account name
+	ClientIP string
+}
+
+type VerifySessionChallengeOutput struct {
+	Token        string
+	RefreshToken string
+	Account      *go_load.Account
+}
+
+// TOTP is the second factor of password logins, accounts with a confirmed totp get a challenge token instead of a
+// session when their password is correct and must enter a code to exchange it for a session
+type TOTP interface {
+	// EnrollTOTP generates a new secret, it can be called again to replace a secret that was not confirmed yet
+	EnrollTOTP(ctx context.Context, params EnrollTOTPParams) (EnrollTOTPOutput, error)
+	// ConfirmTOTP enables totp once a code of the enrolled secret is entered and returns new recovery codes
+	ConfirmTOTP(ctx context.Context, params ConfirmTOTPParams) (ConfirmTOTPOutput, error)
+	DisableTOTP(ctx context.Context, params DisableTOTPParams) error
+	// CreateLoginChallenge returns an empty challenge token if the account does not have totp enabled
+	CreateLoginChallenge(ctx context.Context, accountID uint64) (string, error)
+	// VerifySessionChallenge uses up the challenge token and starts a session if the code is correct
+	VerifySessionChallenge(ctx context.Context, params VerifySessionChallengeParams) (VerifySessionChallengeOutput, error)
+}
+
+type totp struct {
+	goquDatabase                    *goqu.Database
+	accountDataAccessor             database.AccountDataAccessor
+	accountPasswordDataAccessor     database.AccountPasswordDataAccessor
+	accountTOTPDataAccessor         database.AccountTOTPDataAccessor
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor
+	auditLogDataAccessor            database.AuditLogDataAccessor
+	loginChallengeCache             cache.LoginChallenge
+	hashLogic                       Hash
+	sessionLogic                    Session
+	loginLockoutLogic               LoginLockout
+	// secretAEAD is nil if no encryption key is configured, totp cannot be enrolled then
+	secretAEAD         cipher.AEAD
+	issuer             string
+	challengeExpiresIn time.Duration
+	logger             *zap.Logger
+}
+
+func NewTOTP(
+	goquDatabase *goqu.Database,
+	accountDataAccessor database.AccountDataAccessor,
+	accountPasswordDataAccessor database.AccountPasswordDataAccessor,
+	accountTOTPDataAccessor database.AccountTOTPDataAccessor,
+	accountRecoveryCodeDataAccessor database.AccountRecoveryCodeDataAccessor,
+	auditLogDataAccessor database.AuditLogDataAccessor,
+	loginChallengeCache cache.LoginChallenge,
+	hashLogic Hash,
+	sessionLogic Session,
+	loginLockoutLogic LoginLockout,
+	authConfig configs.Auth,
+	logger *zap.Logger,
+) (TOTP, error) {
+	challengeExpiresIn, err := authConfig.TOTP.GetChallengeExpiresInDuration()
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to parse totp.challenge_expires_in")
+		return nil, err
+	}
+
+	t := &totp{
+		goquDatabase:                    goquDatabase,
+		accountDataAccessor:             accountDataAccessor,
+		accountPasswordDataAccessor:     accountPasswordDataAccessor,
+		accountTOTPDataAccessor:         accountTOTPDataAccessor,
+		accountRecoveryCodeDataAccessor: accountRecoveryCodeDataAccessor,
+		auditLogDataAccessor:            auditLogDataAccessor,
+		loginChallengeCache:             loginChallengeCache,
+		hashLogic:                       hashLogic,
+		sessionLogic:                    sessionLogic,
+		loginLockoutLogic:               loginLockoutLogic,
+		issuer:                          authConfig.TOTP.Issuer,
+		challengeExpiresIn:              challengeExpiresIn,
+		logger:                          logger,
+	}
+
+	if authConfig.Token.SigningKey.EncryptionKey != "" {
+		t.secretAEAD, err = newTokenSigningKeyAEAD(authConfig.Token.SigningKey)
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to initialize signing_key.encryption_key")
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// getSecretAdditionalData binds an encrypted secret to its account, so it cannot be copied to another account
+func (t totp) getSecretAdditionalData(accountID uint64) []byte {
+	return []byte(strconv.FormatUint(accountID, 10))
+}
+
+func (t totp) encryptSecret(accountID uint64, secret []byte) (string, error) {
+	if t.secretAEAD == nil {
+		return "", errTOTPNotConfigured
+	}
+
+	nonce := make([]byte, t.secretAEAD.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(
+		t.secretAEAD.Seal(nonce, nonce, secret, t.getSecretAdditionalData(accountID))), nil
+}
+
+func (t totp) decryptSecret(accountID uint64, encryptedSecret string) ([]byte, error) {
+	if t.secretAEAD == nil {
+		return nil, errTOTPNotConfigured
+	}
+
+	encryptedSecretBytes, err := base64.StdEncoding.DecodeString(encryptedSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := t.secretAEAD.NonceSize()
+	if len(encryptedSecretBytes) < nonceSize {
+		return nil, errors.New("encrypted totp secret is too short")
+	}
+
+	return t.secretAEAD.Open(
+		nil, encryptedSecretBytes[:nonceSize], encryptedSecretBytes[nonceSize:], t.getSecretAdditionalData(accountID))
+}
+
+// generateTOTPCode implements RFC 6238 with HMAC-SHA1 and 6 digits
+func generateTOTPCode(secret []byte, step uint64) string {
+	stepBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(stepBytes, step)
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(stepBytes)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	truncated := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigitCount, truncated%1_000_000)
+}
+
+// matchTOTPCode returns the step the code was generated for, or 0 if it does not match a step after lastUsedStep
+// within the allowed skew
+func matchTOTPCode(secret []byte, code string, now time.Time, lastUsedStep uint64) uint64 {
+	currentStep := uint64(now.Unix()) / uint64(totpPeriod.Seconds())
+	for step := currentStep - totpAllowedStepSkew; step <= currentStep+totpAllowedStepSkew; step++ {
+		if step <= lastUsedStep {
+			continue
+		}
+
+		if hmac.Equal([]byte(generateTOTPCode(secret, step)), []byte(code)) {
+			return step
+		}
+	}
+
+	return 0
+}
+
+// normalizeRecoveryCode accepts codes typed with different casing or without their separator
+func normalizeRecoveryCode(recoveryCode string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(recoveryCode))
+}
+
+// hashRecoveryCode uses sha256 like reset tokens, recovery codes carry 50 random bits
+func hashRecoveryCode(recoveryCode string) string {
+	recoveryCodeHash := sha256.Sum256([]byte(normalizeRecoveryCode(recoveryCode)))
+	return hex.EncodeToString(recoveryCodeHash[:])
+}
+
+func generateRecoveryCode() (string, error) {
+	randomBytes := make([]byte, recoveryCodeCharacterCount)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", err
+	}
+
+	recoveryCode := make([]byte, recoveryCodeCharacterCount)
+	for i, randomByte := range randomBytes {
+		// 256 is a multiple of the alphabet size, so every character is equally likely
+		recoveryCode[i] = recoveryCodeAlphabet[int(randomByte)%len(recoveryCodeAlphabet)]
+	}
+
+	half := recoveryCodeCharacterCount / 2
+	return string(recoveryCode[:half]) + "-" + string(recoveryCode[half:]), nil
+}
+
+func (t totp) getOTPAuthURI(accountName, secret string) string {
+	otpAuthURI := url.URL{
+		Scheme: "otpauth",
+		Host:   "totp",
+		Path:   "/" + t.issuer + ":" + accountName,
+	}
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", t.issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigitCount))
+	query.Set("period", strconv.Itoa(int(totpPeriod.Seconds())))
+	otpAuthURI.RawQuery = query.Encode()
+
+	return otpAuthURI.String()
+}
+
+func (t totp) EnrollTOTP(ctx context.Context, params EnrollTOTPParams) (EnrollTOTPOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", params.Principal.AccountID))
+
+	if t.secretAEAD == nil {
+		return EnrollTOTPOutput{}, errTOTPNotConfigured
+	}
+
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, params.Principal.AccountID)
+	if err != nil {
+		return EnrollTOTPOutput{}, err
+	}
+
+	secret := make([]byte, totpSecretByteCount)
+	if _, err = rand.Read(secret); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate totp secret")
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "failed to generate totp secret")
+	}
+
+	encryptedSecret, err := t.encryptSecret(account.ID, secret)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to encrypt totp secret")
+		return EnrollTOTPOutput{}, status.Error(codes.Internal, "failed to encrypt totp secret")
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTOTPDataAccessor := t.accountTOTPDataAccessor.WithDatabase(td)
+		newAccountTOTP := database.AccountTOTP{
+			OfAccountID:     account.ID,
+			EncryptedSecret: encryptedSecret,
+			CreatedAt:       time.Now(),
+		}
+
+		existingAccountTOTP, getErr := accountTOTPDataAccessor.GetAccountTOTPWithXLock(ctx, account.ID)
+		if getErr != nil {
+			if errors.Is(getErr, database.ErrAccountTOTPNotFound) {
+				return accountTOTPDataAccessor.CreateAccountTOTP(ctx, newAccountTOTP)
+			}
+			return getErr
+		}
+
+		if existingAccountTOTP.ConfirmedAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+
+		return accountTOTPDataAccessor.UpdateAccountTOTP(ctx, newAccountTOTP)
+	})
+	if txErr != nil {
+		return EnrollTOTPOutput{}, txErr
+	}
+
+	encodedSecret := totpSecretEncoding.EncodeToString(secret)
+	return EnrollTOTPOutput{
+		Secret:     encodedSecret,
+		OTPAuthURI: t.getOTPAuthURI(account.Name, encodedSecret),
+	}, nil
+}
+
+// checkTOTPCode returns the step of the code if it matches the secret of the account, the caller must save it as
+// the last used step so the code cannot be replayed
+func (t totp) checkTOTPCode(ctx context.Context, accountTOTP database.AccountTOTP, code string) (uint64, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountTOTP.OfAccountID))
+
+	secret, err := t.decryptSecret(accountTOTP.OfAccountID, accountTOTP.EncryptedSecret)
+	if err != nil {
+		logger.With(zap.Error(err)).Error("failed to decrypt totp secret")
+		return 0, status.Error(codes.Internal, "failed to decrypt totp secret")
+	}
+
+	step := matchTOTPCode(secret, code, time.Now(), accountTOTP.LastUsedStep)
+	if step == 0 {
+		return 0, errIncorrectTOTPCode
+	}
+
+	return step, nil
+}
+
+func (t totp) ConfirmTOTP(ctx context.Context, params ConfirmTOTPParams) (ConfirmTOTPOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", params.Principal.AccountID))
+	accountID := params.Principal.AccountID
+
+	recoveryCodeList := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		recoveryCode, err := generateRecoveryCode()
+		if err != nil {
+			logger.With(zap.Error(err)).Error("failed to generate recovery code")
+			return ConfirmTOTPOutput{}, status.Error(codes.Internal, "failed to generate recovery code")
+		}
+
+		recoveryCodeList = append(recoveryCodeList, recoveryCode)
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		accountTOTP, err := t.accountTOTPDataAccessor.WithDatabase(td).GetAccountTOTPWithXLock(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, database.ErrAccountTOTPNotFound) {
+				return errTOTPNotEnrolled
+			}
+			return err
+		}
+
+		if accountTOTP.ConfirmedAt != nil {
+			return errTOTPAlreadyEnabled
+		}
+
+		step, err := t.checkTOTPCode(ctx, accountTOTP, params.TOTPCode)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		accountTOTP.ConfirmedAt = &now
+		accountTOTP.LastUsedStep = step
+		if err = t.accountTOTPDataAccessor.WithDatabase(td).UpdateAccountTOTP(ctx, accountTOTP); err != nil {
+			return err
+		}
+
+		accountRecoveryCodeDataAccessor := t.accountRecoveryCodeDataAccessor.WithDatabase(td)
+		if err = accountRecoveryCodeDataAccessor.DeleteAccountRecoveryCodeListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		err = accountRecoveryCodeDataAccessor.CreateAccountRecoveryCodeList(ctx, lo.Map(recoveryCodeList,
+			func(recoveryCode string, _ int) database.AccountRecoveryCode {
+				return database.AccountRecoveryCode{
+					OfAccountID: accountID,
+					CodeHash:    hashRecoveryCode(recoveryCode),
+				}
+			}))
+		if err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionEnableTOTP,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   accountID,
+		})
+	})
+	if txErr != nil {
+		return ConfirmTOTPOutput{}, txErr
+	}
+
+	return ConfirmTOTPOutput{
+		RecoveryCodeList: recoveryCodeList,
+	}, nil
+}
+
+// checkSecondFactor accepts either a totp code or an unused recovery code of an account with totp enabled and uses
+// it up, it must be called within a transaction so a code cannot be used twice concurrently
+func (t totp) checkSecondFactor(
+	ctx context.Context,
+	td *goqu.TxDatabase,
+	accountID uint64,
+	totpCode string,
+	recoveryCode string,
+) error {
+	accountTOTPDataAccessor := t.accountTOTPDataAccessor.WithDatabase(td)
+	accountTOTP, err := accountTOTPDataAccessor.GetAccountTOTPWithXLock(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTOTPNotFound) {
+			return errTOTPNotEnabled
+		}
+		return err
+	}
+
+	if accountTOTP.ConfirmedAt == nil {
+		return errTOTPNotEnabled
+	}
+
+	if totpCode != "" {
+		step, err := t.checkTOTPCode(ctx, accountTOTP, totpCode)
+		if err != nil {
+			return err
+		}
+
+		accountTOTP.LastUsedStep = step
+		return accountTOTPDataAccessor.UpdateAccountTOTP(ctx, accountTOTP)
+	}
+
+	if recoveryCode == "" {
+		return errIncorrectTOTPCode
+	}
+
+	accountRecoveryCodeDataAccessor := t.accountRecoveryCodeDataAccessor.WithDatabase(td)
+	accountRecoveryCode, err := accountRecoveryCodeDataAccessor.
+		GetAccountRecoveryCodeWithXLock(ctx, accountID, hashRecoveryCode(recoveryCode))
+	if err != nil {
+		if errors.Is(err, database.ErrAccountRecoveryCodeNotFound) {
+			return errIncorrectTOTPCode
+		}
+		return err
+	}
+
+	if accountRecoveryCode.UsedAt != nil {
+		return errIncorrectTOTPCode
+	}
+
+	now := time.Now()
+	accountRecoveryCode.UsedAt = &now
+	return accountRecoveryCodeDataAccessor.UpdateAccountRecoveryCode(ctx, accountRecoveryCode)
+}
+
+func (t totp) DisableTOTP(ctx context.Context, params DisableTOTPParams) error {
+	accountID := params.Principal.AccountID
+
+	if err := checkAccountPassword(ctx, t.accountPasswordDataAccessor, t.hashLogic, accountID, params.Password); err != nil {
+		return err
+	}
+
+	return t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		if err := t.checkSecondFactor(ctx, td, accountID, params.TOTPCode, params.RecoveryCode); err != nil {
+			return err
+		}
+
+		if err := t.accountRecoveryCodeDataAccessor.WithDatabase(td).DeleteAccountRecoveryCodeListByAccount(ctx, accountID); err != nil {
+			return err
+		}
+
+		if err := t.accountTOTPDataAccessor.WithDatabase(td).DeleteAccountTOTP(ctx, accountID); err != nil {
+			return err
+		}
+
+		return createAuditLog(ctx, t.auditLogDataAccessor.WithDatabase(td), params.Principal, auditLogEntry{
+			Action:     auditLogActionDisableTOTP,
+			TargetType: auditLogTargetTypeAccount,
+			TargetID:   accountID,
+		})
+	})
+}
+
+// hashLoginChallengeToken uses sha256 like reset tokens, challenge tokens are random so they cannot be brute forced
+func hashLoginChallengeToken(challengeToken string) string {
+	challengeTokenHash := sha256.Sum256([]byte(challengeToken))
+	return hex.EncodeToString(challengeTokenHash[:])
+}
+
+func (t totp) CreateLoginChallenge(ctx context.Context, accountID uint64) (string, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger).With(zap.Uint64("account_id", accountID))
+
+	accountTOTP, err := t.accountTOTPDataAccessor.GetAccountTOTP(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountTOTPNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if accountTOTP.ConfirmedAt == nil {
+		return "", nil
+	}
+
+	challengeTokenBytes := make([]byte, loginChallengeTokenByteCount)
+	if _, err = rand.Read(challengeTokenBytes); err != nil {
+		logger.With(zap.Error(err)).Error("failed to generate challenge token")
+		return "", status.Error(codes.Internal, "failed to generate challenge token")
+	}
+
+	// Unlike lockouts this fails closed, an account with totp cannot sign in without its challenge
+	challengeToken := base64.RawURLEncoding.EncodeToString(challengeTokenBytes)
+	err = t.loginChallengeCache.Set(ctx, hashLoginChallengeToken(challengeToken), accountID, t.challengeExpiresIn)
+	if err != nil {
+		return "", status.Error(codes.Internal, "failed to create challenge token")
+	}
+
+	return challengeToken, nil
+}
+
+func (t totp) VerifySessionChallenge(
+	ctx context.Context,
+	params VerifySessionChallengeParams,
+) (VerifySessionChallengeOutput, error) {
+	logger := utils.LoggerWithContext(ctx, t.logger)
+
+	challengeTokenHash := hashLoginChallengeToken(params.ChallengeToken)
+	accountID, err := t.loginChallengeCache.Get(ctx, challengeTokenHash)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return VerifySessionChallengeOutput{}, errInvalidLoginChallenge
+		}
+		return VerifySessionChallengeOutput{}, status.Error(codes.Internal, "failed to get challenge token")
+	}
+
+	account, err := t.accountDataAccessor.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, database.ErrAccountNotFound) {
+			return VerifySessionChallengeOutput{}, errInvalidLoginChallenge
+		}
+		return VerifySessionChallengeOutput{}, err
+	}
+
+	// Codes are counted with the failed passwords of the account, so the lockout bounds guesses of both
+	loginLockoutParams := LoginLockoutParams{
+		AccountName: account.Name,
+		ClientIP:    params.ClientIP,
+	}
+	if err = t.loginLockoutLogic.Check(ctx, loginLockoutParams); err != nil {
+		return VerifySessionChallengeOutput{}, err
+	}
+
+	txErr := t.goquDatabase.WithTx(func(td *goqu.TxDatabase) error {
+		return t.checkSecondFactor(ctx, td, accountID, params.TOTPCode, params.RecoveryCode)
+	})
+	if txErr != nil {
+		if errors.Is(txErr, errIncorrectTOTPCode) {
+			t.loginLockoutLogic.RecordFailure(ctx, loginLockoutParams)
+			return VerifySessionChallengeOutput{}, errIncorrectLoginCode
+		}
+		if errors.Is(txErr, errTOTPNotEnabled) {
+			return VerifySessionChallengeOutput{}, errInvalidLoginChallenge
+		}
+		return VerifySessionChallengeOutput{}, txErr
+	}
+
+	if err = t.loginChallengeCache.Delete(ctx, challengeTokenHash); err != nil {
+		logger.With(zap.Error(err)).Warn("failed to delete used challenge token from cache")
+	}
+
+	t.loginLockoutLogic.RecordSuccess(ctx, loginLockoutParams)
+
+	if account.DisabledAt != nil {
+		return VerifySessionChallengeOutput{}, errAccountDisabled
+	}
+
+	startSessionOutput, err := t.sessionLogic.StartSession(ctx, StartSessionParams{
+		AccountID: accountID,
+		UserAgent: params.UserAgent,
+	})
+	if err != nil {
+		return VerifySessionChallengeOutput{}, err
+	}
+
+	return VerifySessionChallengeOutput{
+		Token:        startSessionOutput.Token,
+		RefreshToken: startSessionOutput.RefreshToken,
+		Account:      databaseAccountToProtoAccount(account),
+	}, nil
+}
@@ -5,7 +5,27 @@ import "github.com/google/wire"
 var WireSet = wire.NewSet(
 	NewAccount,
 	NewHash,
+	NewPasswordPolicy,
 	NewToken,
+	NewTokenSigningKey,
+	NewSession,
+	NewAPIKey,
+	NewPasswordReset,
+	NewLoginLockout,
+	NewTOTP,
+	NewOIDC,
+	NewTeam,
+	NewAdmin,
+	NewDeadLetterMessage,
 	NewDownloadTask,
+	NewDownloadTaskExecutor,
 	NewDownloader,
+	NewArchiveExtractor,
+	NewPostProcessorCatalog,
+	NewPostProcessingPipeline,
+	NewArchiveExtractionPostProcessor,
+	NewMIMESniffingPostProcessor,
+	NewWebhookPostProcessor,
+	NewMalwareScanningPostProcessor,
+	NewThumbnailPostProcessor,
 )
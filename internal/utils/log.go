@@ -39,8 +39,12 @@ func InitializeLogger(logConfig configs.Log) (*zap.Logger, func(), error) {
 	return logger, cleanup, nil
 }
 
-func LoggerWithContext(_ context.Context, logger *zap.Logger) *zap.Logger {
+func LoggerWithContext(ctx context.Context, logger *zap.Logger) *zap.Logger {
 	// TODO: Add request id to context
 
+	if traceID := TraceIDFromContext(ctx); traceID != "" {
+		return logger.With(zap.String("trace_id", traceID))
+	}
+
 	return logger
 }
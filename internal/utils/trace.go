@@ -0,0 +1,17 @@
+package utils
+
+import "context"
+
+type traceIDContextKey struct{}
+
+// ContextWithTraceID returns a context carrying the trace id, it is added to the logs of LoggerWithContext and to
+// the messages produced with the context, so everything caused by the same event can be followed across components
+func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, traceID)
+}
+
+// TraceIDFromContext returns an empty string if ctx carries no trace id
+func TraceIDFromContext(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDContextKey{}).(string)
+	return traceID
+}
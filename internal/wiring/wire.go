@@ -29,3 +29,27 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (*app.Ser
 
 	return nil, nil, nil
 }
+
+func InitializeAPIServer(configFilePath configs.ConfigFilePath) (*app.APIServer, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeDownloadWorker(configFilePath configs.ConfigFilePath) (*app.DownloadWorker, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeAdminLogic(configFilePath configs.ConfigFilePath) (logic.Admin, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
+
+func InitializeCron(configFilePath configs.ConfigFilePath) (*app.Cron, func(), error) {
+	wire.Build(WireSet)
+
+	return nil, nil, nil
+}
@@ -12,14 +12,18 @@ import (
 	"github.com/nhtuan0700/GoLoad/internal/configs"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/cache"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/clamd"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/database"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/file"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/consumer"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/inmemory"
 	"github.com/nhtuan0700/GoLoad/internal/dataaccess/mq/producer"
+	"github.com/nhtuan0700/GoLoad/internal/dataaccess/notifier"
 	"github.com/nhtuan0700/GoLoad/internal/handler"
 	"github.com/nhtuan0700/GoLoad/internal/handler/consumers"
 	"github.com/nhtuan0700/GoLoad/internal/handler/grpc"
 	"github.com/nhtuan0700/GoLoad/internal/handler/http"
+	"github.com/nhtuan0700/GoLoad/internal/handler/jobs"
 	"github.com/nhtuan0700/GoLoad/internal/logic"
 	"github.com/nhtuan0700/GoLoad/internal/utils"
 )
@@ -45,34 +49,255 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (*app.Ser
 	goquDatabase := database.InitializeGoquDB(db)
 	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
 	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(goquDatabase, logger)
+	accountTOTPDataAccessor := database.NewAccountTOTPDataAccessor(goquDatabase, logger)
+	accountRecoveryCodeDataAccessor := database.NewAccountRecoveryCodeDataAccessor(goquDatabase, logger)
+	accountExternalIdentityDataAccessor := database.NewAccountExternalIdentityDataAccessor(goquDatabase, logger)
+	teamDataAccessor := database.NewTeamDataAccessor(goquDatabase, logger)
+	teamMemberDataAccessor := database.NewTeamMemberDataAccessor(goquDatabase, logger)
+	teamInvitationDataAccessor := database.NewTeamInvitationDataAccessor(goquDatabase, logger)
+	downloadTaskShareDataAccessor := database.NewDownloadTaskShareDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	auditLogDataAccessor := database.NewAuditLogDataAccessor(goquDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(mq)
+	mqMessageDataAccessor := database.NewMQMessageDataAccessor(goquDatabase, logger)
+	client, err := producer.NewClient(mq, broker, mqMessageDataAccessor, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskLifecycleEventProducer, err := producer.NewDownloadTaskLifecycleEventProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	configsCache := config.Cache
-	client, err := cache.NewClient(configsCache, logger)
+	cacheClient, err := cache.NewClient(configsCache, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	takeAccountName := cache.NewTakenAccountName(cacheClient, logger)
+	download := config.Download
+	fileClient, err := file.NewClient(download, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	takeAccountName := cache.NewTakenAccountName(client, logger)
 	auth := config.Auth
-	hash := logic.NewHash(auth)
+	hash, err := logic.NewHash(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordPolicy, err := logic.NewPasswordPolicy(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sessionRevocation := cache.NewSessionRevocation(cacheClient, logger)
 	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyAccessor(goquDatabase, logger)
-	tokenPublicKeyCache := cache.NewTokenPublicKeyCache(client, logger)
-	token, err := logic.NewToken(accountDataAccessor, tokenPublicKeyDataAccessor, tokenPublicKeyCache, auth, logger)
+	tokenPublicKeyCache := cache.NewTokenPublicKeyCache(cacheClient, logger)
+	tokenSigningKey, err := logic.NewTokenSigningKey(goquDatabase, tokenPublicKeyDataAccessor, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	token, err := logic.NewToken(accountDataAccessor, tokenPublicKeyDataAccessor, tokenPublicKeyCache, sessionDataAccessor, sessionRevocation, apiKeyDataAccessor, tokenSigningKey, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	session, err := logic.NewSession(goquDatabase, accountDataAccessor, sessionDataAccessor, sessionRevocation, token, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginAttempt := cache.NewLoginAttempt(cacheClient, logger)
+	loginLockout, err := logic.NewLoginLockout(loginAttempt, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginChallenge := cache.NewLoginChallenge(cacheClient, logger)
+	totp, err := logic.NewTOTP(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, auditLogDataAccessor, loginChallenge, hash, session, loginLockout, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	postProcessorCatalog := logic.NewPostProcessorCatalog(download)
+	account := logic.NewAccount(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, apiKeyDataAccessor, passwordResetTokenDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, accountExternalIdentityDataAccessor, teamDataAccessor, teamMemberDataAccessor, teamInvitationDataAccessor, downloadTaskShareDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, downloadTaskLifecycleEventProducer, takeAccountName, fileClient, hash, passwordPolicy, session, loginLockout, totp, postProcessorCatalog, auth, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, logger)
+	configsNotifier := config.Notifier
+	notifierNotifier, err := notifier.NewNotifier(configsNotifier, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordReset, err := logic.NewPasswordReset(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, passwordResetTokenDataAccessor, auditLogDataAccessor, notifierNotifier, hash, passwordPolicy, session, auth, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	account := logic.NewAccount(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, takeAccountName, hash, token, logger)
+	oidcLogin := cache.NewOIDCLogin(cacheClient, logger)
+	oidc, err := logic.NewOIDC(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, accountExternalIdentityDataAccessor, oidcLogin, takeAccountName, hash, session, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	team := logic.NewTeam(goquDatabase, teamDataAccessor, teamMemberDataAccessor, teamInvitationDataAccessor, accountDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, logger)
+	downloadTaskCreatedProducer, err := producer.NewDownloadTaskCreatedProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTask, err := logic.NewDownloadTask(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, teamMemberDataAccessor, downloadTaskShareDataAccessor, auditLogDataAccessor, downloadTaskCreatedProducer, downloadTaskLifecycleEventProducer, fileClient, postProcessorCatalog, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsGRPC := config.GRPC
+	goLoadServiceServer, err := grpc.NewHandler(account, session, apiKey, passwordReset, totp, oidc, team, tokenSigningKey, downloadTask, configsGRPC)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, logger)
+	deadLetterMessageDataAccessor := database.NewDeadLetterMessageDataAccessor(goquDatabase, logger)
+	deadLetterMessage := logic.NewDeadLetterMessage(goquDatabase, deadLetterMessageDataAccessor, auditLogDataAccessor, client, logger)
+	goLoadAdminServiceServer := grpc.NewAdminHandler(admin, downloadTask, deadLetterMessage)
+	grpcAuth := grpc.NewAuth(token, logger)
+	rateLimiter, err := grpc.NewRateLimiter(configsGRPC, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	server := grpc.NewServer(goLoadServiceServer, goLoadAdminServiceServer, grpcAuth, rateLimiter, config, logger)
+	configsHTTP := config.HTTP
+	httpServer := http.NewServer(configsGRPC, configsHTTP, auth, logger)
+	consumerConsumer, err := consumer.NewConsumer(mq, broker, goquDatabase, mqMessageDataAccessor, deadLetterMessageDataAccessor, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	archiveExtractor, err := logic.NewArchiveExtractor(fileClient, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	archiveExtractionPostProcessor := logic.NewArchiveExtractionPostProcessor(archiveExtractor, logger)
+	mimeSniffingPostProcessor := logic.NewMIMESniffingPostProcessor(fileClient, logger)
+	webhookPostProcessor, err := logic.NewWebhookPostProcessor(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	clamdClient, err := clamd.NewClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	malwareScanningPostProcessor := logic.NewMalwareScanningPostProcessor(clamdClient, fileClient, download, logger)
+	thumbnailPostProcessor := logic.NewThumbnailPostProcessor(fileClient, logger)
+	postProcessingPipeline := logic.NewPostProcessingPipeline(archiveExtractionPostProcessor, mimeSniffingPostProcessor, webhookPostProcessor, malwareScanningPostProcessor, thumbnailPostProcessor, logger)
+	downloadTaskExecutor, err := logic.NewDownloadTaskExecutor(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, downloadTaskCreatedProducer, downloadTaskLifecycleEventProducer, fileClient, postProcessorCatalog, postProcessingPipeline, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTaskExecutor, logger)
+	root := consumers.NewRoot(consumerConsumer, downloadTaskCreated, logger)
+	expireDownloadTasks := jobs.NewExpireDownloadTasks(downloadTask, logger)
+	rotateTokenSigningKey := jobs.NewRotateTokenSigningKey(tokenSigningKey, logger)
+	cron := config.Cron
+	jobsRoot := jobs.NewRoot(expireDownloadTasks, rotateTokenSigningKey, cron, logger)
+	appServer := app.NewServer(server, httpServer, root, jobsRoot, logger)
+	return appServer, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeAPIServer(configFilePath configs.ConfigFilePath) (*app.APIServer, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase := database.InitializeGoquDB(db)
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	accountPasswordDataAccessor := database.NewAccountPasswordDataAccessor(goquDatabase, logger)
+	sessionDataAccessor := database.NewSessionDataAccessor(goquDatabase, logger)
+	apiKeyDataAccessor := database.NewAPIKeyDataAccessor(goquDatabase, logger)
+	passwordResetTokenDataAccessor := database.NewPasswordResetTokenDataAccessor(goquDatabase, logger)
+	accountTOTPDataAccessor := database.NewAccountTOTPDataAccessor(goquDatabase, logger)
+	accountRecoveryCodeDataAccessor := database.NewAccountRecoveryCodeDataAccessor(goquDatabase, logger)
+	accountExternalIdentityDataAccessor := database.NewAccountExternalIdentityDataAccessor(goquDatabase, logger)
+	teamDataAccessor := database.NewTeamDataAccessor(goquDatabase, logger)
+	teamMemberDataAccessor := database.NewTeamMemberDataAccessor(goquDatabase, logger)
+	teamInvitationDataAccessor := database.NewTeamInvitationDataAccessor(goquDatabase, logger)
+	downloadTaskShareDataAccessor := database.NewDownloadTaskShareDataAccessor(goquDatabase, logger)
 	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	auditLogDataAccessor := database.NewAuditLogDataAccessor(goquDatabase, logger)
 	mq := config.MQ
-	producerClient, err := producer.NewClient(mq, logger)
+	broker := inmemory.NewBroker(mq)
+	mqMessageDataAccessor := database.NewMQMessageDataAccessor(goquDatabase, logger)
+	client, err := producer.NewClient(mq, broker, mqMessageDataAccessor, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTaskCreatedProducer := producer.NewDownloadTaskCreatedProducer(producerClient, logger)
+	downloadTaskLifecycleEventProducer, err := producer.NewDownloadTaskLifecycleEventProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	configsCache := config.Cache
+	cacheClient, err := cache.NewClient(configsCache, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	takeAccountName := cache.NewTakenAccountName(cacheClient, logger)
 	download := config.Download
 	fileClient, err := file.NewClient(download, logger)
 	if err != nil {
@@ -80,27 +305,319 @@ func InitializeStandaloneServer(configFilePath configs.ConfigFilePath) (*app.Ser
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTask := logic.NewDownloadTask(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, downloadTaskCreatedProducer, fileClient, token, logger)
+	auth := config.Auth
+	hash, err := logic.NewHash(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordPolicy, err := logic.NewPasswordPolicy(auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sessionRevocation := cache.NewSessionRevocation(cacheClient, logger)
+	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyAccessor(goquDatabase, logger)
+	tokenPublicKeyCache := cache.NewTokenPublicKeyCache(cacheClient, logger)
+	tokenSigningKey, err := logic.NewTokenSigningKey(goquDatabase, tokenPublicKeyDataAccessor, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	token, err := logic.NewToken(accountDataAccessor, tokenPublicKeyDataAccessor, tokenPublicKeyCache, sessionDataAccessor, sessionRevocation, apiKeyDataAccessor, tokenSigningKey, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	session, err := logic.NewSession(goquDatabase, accountDataAccessor, sessionDataAccessor, sessionRevocation, token, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginAttempt := cache.NewLoginAttempt(cacheClient, logger)
+	loginLockout, err := logic.NewLoginLockout(loginAttempt, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	loginChallenge := cache.NewLoginChallenge(cacheClient, logger)
+	totp, err := logic.NewTOTP(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, auditLogDataAccessor, loginChallenge, hash, session, loginLockout, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	postProcessorCatalog := logic.NewPostProcessorCatalog(download)
+	account := logic.NewAccount(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, sessionDataAccessor, apiKeyDataAccessor, passwordResetTokenDataAccessor, accountTOTPDataAccessor, accountRecoveryCodeDataAccessor, accountExternalIdentityDataAccessor, teamDataAccessor, teamMemberDataAccessor, teamInvitationDataAccessor, downloadTaskShareDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, downloadTaskLifecycleEventProducer, takeAccountName, fileClient, hash, passwordPolicy, session, loginLockout, totp, postProcessorCatalog, auth, logger)
+	apiKey := logic.NewAPIKey(apiKeyDataAccessor, logger)
+	configsNotifier := config.Notifier
+	notifierNotifier, err := notifier.NewNotifier(configsNotifier, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	passwordReset, err := logic.NewPasswordReset(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, passwordResetTokenDataAccessor, auditLogDataAccessor, notifierNotifier, hash, passwordPolicy, session, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	oidcLogin := cache.NewOIDCLogin(cacheClient, logger)
+	oidc, err := logic.NewOIDC(goquDatabase, accountDataAccessor, accountPasswordDataAccessor, accountExternalIdentityDataAccessor, oidcLogin, takeAccountName, hash, session, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	team := logic.NewTeam(goquDatabase, teamDataAccessor, teamMemberDataAccessor, teamInvitationDataAccessor, accountDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, logger)
+	downloadTaskCreatedProducer, err := producer.NewDownloadTaskCreatedProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTask, err := logic.NewDownloadTask(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, teamMemberDataAccessor, downloadTaskShareDataAccessor, auditLogDataAccessor, downloadTaskCreatedProducer, downloadTaskLifecycleEventProducer, fileClient, postProcessorCatalog, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	configsGRPC := config.GRPC
-	goLoadServiceServer, err := grpc.NewHandler(account, downloadTask, configsGRPC)
+	goLoadServiceServer, err := grpc.NewHandler(account, session, apiKey, passwordReset, totp, oidc, team, tokenSigningKey, downloadTask, configsGRPC)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	server := grpc.NewServer(goLoadServiceServer, config, logger)
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, logger)
+	deadLetterMessageDataAccessor := database.NewDeadLetterMessageDataAccessor(goquDatabase, logger)
+	deadLetterMessage := logic.NewDeadLetterMessage(goquDatabase, deadLetterMessageDataAccessor, auditLogDataAccessor, client, logger)
+	goLoadAdminServiceServer := grpc.NewAdminHandler(admin, downloadTask, deadLetterMessage)
+	grpcAuth := grpc.NewAuth(token, logger)
+	rateLimiter, err := grpc.NewRateLimiter(configsGRPC, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	server := grpc.NewServer(goLoadServiceServer, goLoadAdminServiceServer, grpcAuth, rateLimiter, config, logger)
 	configsHTTP := config.HTTP
 	httpServer := http.NewServer(configsGRPC, configsHTTP, auth, logger)
-	consumerConsumer, err := consumer.NewConsumer(mq, logger)
+	apiServer, err := app.NewAPIServer(server, httpServer, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	return apiServer, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeDownloadWorker(configFilePath configs.ConfigFilePath) (*app.DownloadWorker, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	mq := config.MQ
+	broker := inmemory.NewBroker(mq)
+	configsDatabase := config.Database
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase := database.InitializeGoquDB(db)
+	mqMessageDataAccessor := database.NewMQMessageDataAccessor(goquDatabase, logger)
+	deadLetterMessageDataAccessor := database.NewDeadLetterMessageDataAccessor(goquDatabase, logger)
+	consumerConsumer, err := consumer.NewConsumer(mq, broker, goquDatabase, mqMessageDataAccessor, deadLetterMessageDataAccessor, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	client, err := producer.NewClient(mq, broker, mqMessageDataAccessor, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreatedProducer, err := producer.NewDownloadTaskCreatedProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskLifecycleEventProducer, err := producer.NewDownloadTaskLifecycleEventProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	download := config.Download
+	fileClient, err := file.NewClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	postProcessorCatalog := logic.NewPostProcessorCatalog(download)
+	archiveExtractor, err := logic.NewArchiveExtractor(fileClient, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	archiveExtractionPostProcessor := logic.NewArchiveExtractionPostProcessor(archiveExtractor, logger)
+	mimeSniffingPostProcessor := logic.NewMIMESniffingPostProcessor(fileClient, logger)
+	webhookPostProcessor, err := logic.NewWebhookPostProcessor(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	clamdClient, err := clamd.NewClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	malwareScanningPostProcessor := logic.NewMalwareScanningPostProcessor(clamdClient, fileClient, download, logger)
+	thumbnailPostProcessor := logic.NewThumbnailPostProcessor(fileClient, logger)
+	postProcessingPipeline := logic.NewPostProcessingPipeline(archiveExtractionPostProcessor, mimeSniffingPostProcessor, webhookPostProcessor, malwareScanningPostProcessor, thumbnailPostProcessor, logger)
+	downloadTaskExecutor, err := logic.NewDownloadTaskExecutor(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, downloadTaskCreatedProducer, downloadTaskLifecycleEventProducer, fileClient, postProcessorCatalog, postProcessingPipeline, download, logger)
 	if err != nil {
 		cleanup2()
 		cleanup()
 		return nil, nil, err
 	}
-	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTask, logger)
+	downloadTaskCreated := consumers.NewDownloadTaskCreated(downloadTaskExecutor, logger)
 	root := consumers.NewRoot(consumerConsumer, downloadTaskCreated, logger)
-	appServer := app.NewServer(server, httpServer, root, logger)
-	return appServer, func() {
+	downloadWorker, err := app.NewDownloadWorker(root, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	return downloadWorker, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeAdminLogic(configFilePath configs.ConfigFilePath) (logic.Admin, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase := database.InitializeGoquDB(db)
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	auditLogDataAccessor := database.NewAuditLogDataAccessor(goquDatabase, logger)
+	admin := logic.NewAdmin(goquDatabase, accountDataAccessor, downloadTaskDataAccessor, auditLogDataAccessor, logger)
+	return admin, func() {
+		cleanup2()
+		cleanup()
+	}, nil
+}
+
+func InitializeCron(configFilePath configs.ConfigFilePath) (*app.Cron, func(), error) {
+	config, err := configs.NewConfig(configFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	configsDatabase := config.Database
+	log := config.Log
+	logger, cleanup, err := utils.InitializeLogger(log)
+	if err != nil {
+		return nil, nil, err
+	}
+	db, cleanup2, err := database.InitializeAndMigrateUpDB(configsDatabase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	goquDatabase := database.InitializeGoquDB(db)
+	downloadTaskDataAccessor := database.NewDownloadTaskDataAccessor(goquDatabase, logger)
+	accountDataAccessor := database.NewAccountDataAccessor(goquDatabase, logger)
+	teamMemberDataAccessor := database.NewTeamMemberDataAccessor(goquDatabase, logger)
+	downloadTaskShareDataAccessor := database.NewDownloadTaskShareDataAccessor(goquDatabase, logger)
+	auditLogDataAccessor := database.NewAuditLogDataAccessor(goquDatabase, logger)
+	mq := config.MQ
+	broker := inmemory.NewBroker(mq)
+	mqMessageDataAccessor := database.NewMQMessageDataAccessor(goquDatabase, logger)
+	client, err := producer.NewClient(mq, broker, mqMessageDataAccessor, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskCreatedProducer, err := producer.NewDownloadTaskCreatedProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	downloadTaskLifecycleEventProducer, err := producer.NewDownloadTaskLifecycleEventProducer(client, mq, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	download := config.Download
+	fileClient, err := file.NewClient(download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	postProcessorCatalog := logic.NewPostProcessorCatalog(download)
+	downloadTask, err := logic.NewDownloadTask(goquDatabase, downloadTaskDataAccessor, accountDataAccessor, teamMemberDataAccessor, downloadTaskShareDataAccessor, auditLogDataAccessor, downloadTaskCreatedProducer, downloadTaskLifecycleEventProducer, fileClient, postProcessorCatalog, download, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	expireDownloadTasks := jobs.NewExpireDownloadTasks(downloadTask, logger)
+	tokenPublicKeyDataAccessor := database.NewTokenPublicKeyAccessor(goquDatabase, logger)
+	auth := config.Auth
+	tokenSigningKey, err := logic.NewTokenSigningKey(goquDatabase, tokenPublicKeyDataAccessor, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	rotateTokenSigningKey := jobs.NewRotateTokenSigningKey(tokenSigningKey, logger)
+	cron := config.Cron
+	root := jobs.NewRoot(expireDownloadTasks, rotateTokenSigningKey, cron, logger)
+	appCron := app.NewCron(root, logger)
+	return appCron, func() {
 		cleanup2()
 		cleanup()
 	}, nil